@@ -17,12 +17,18 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
-	AuthConfig *auth.Credentials
-	Features   features.UserFeatures
+	AuthConfig  *auth.Credentials
+	DefaultTags tags.DefaultTags
+	Features    features.UserFeatures
+
+	// NamingConvention is the naming convention defined in the `naming` block of the Provider, if any
+	NamingConvention *naming.Convention
 
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
@@ -118,7 +124,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	client := Client{
-		Account: account,
+		Account:          account,
+		DefaultTags:      builder.DefaultTags,
+		NamingConvention: builder.NamingConvention,
 	}
 
 	o := &common.ClientOptions{
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Convention is the naming convention configured within the `naming` block in the Provider
+type Convention struct {
+	// Prefix is the prefix which the name of each resource must start with
+	Prefix string
+
+	// Suffix is the suffix which the name of each resource must end with
+	Suffix string
+
+	// ResourceTypes is the list of resource types the Convention applies to - when empty this
+	// applies to each resource type which has naming rules defined
+	ResourceTypes []string
+}
+
+// ExpandConvention expands the `naming` block from the Provider configuration
+func ExpandConvention(input []interface{}) *Convention {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := Convention{
+		Prefix:        raw["prefix"].(string),
+		Suffix:        raw["suffix"].(string),
+		ResourceTypes: make([]string, 0),
+	}
+
+	for _, v := range raw["resource_types"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		output.ResourceTypes = append(output.ResourceTypes, v.(string))
+	}
+
+	return &output
+}
+
+// AppliesTo returns whether the Convention should be enforced for the specified resource type
+func (c Convention) AppliesTo(resourceType string) bool {
+	if len(c.ResourceTypes) == 0 {
+		_, ok := rules[resourceType]
+		return ok
+	}
+
+	for _, v := range c.ResourceTypes {
+		if strings.EqualFold(v, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns any errors where the name for the specified resource type doesn't meet
+// the Convention, or the naming Rule registered for that resource type
+func (c Convention) Validate(resourceType, name string) []error {
+	errors := make([]error, 0)
+
+	if c.Prefix != "" && !strings.HasPrefix(name, c.Prefix) {
+		errors = append(errors, fmt.Errorf("must start with the prefix %q", c.Prefix))
+	}
+
+	if c.Suffix != "" && !strings.HasSuffix(name, c.Suffix) {
+		errors = append(errors, fmt.Errorf("must end with the suffix %q", c.Suffix))
+	}
+
+	if rule, ok := RuleForResourceType(resourceType); ok {
+		errors = append(errors, rule.Validate(name)...)
+	}
+
+	return errors
+}
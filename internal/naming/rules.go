@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	appServiceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	batchValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	cosmosValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	mssqlValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	postgresValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	serviceBusValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Scope defines the scope within which the name of a resource must be unique
+type Scope string
+
+const (
+	// ScopeGlobal means that the name must be globally unique across Azure, typically since it forms part of a DNS name
+	ScopeGlobal Scope = "global"
+
+	// ScopeParent means that the name must be unique within the parent resource (e.g. a Subnet within a Virtual Network)
+	ScopeParent Scope = "parent"
+
+	// ScopeRegion means that the name must be unique within the Azure Region
+	ScopeRegion Scope = "region"
+
+	// ScopeResourceGroup means that the name must be unique within the Resource Group
+	ScopeResourceGroup Scope = "resource_group"
+
+	// ScopeSubscription means that the name must be unique within the Subscription
+	ScopeSubscription Scope = "subscription"
+)
+
+// Rule defines the naming rules for a given resource type
+type Rule struct {
+	// MinLength is the minimum length of the name
+	MinLength int
+
+	// MaxLength is the maximum length of the name
+	MaxLength int
+
+	// Pattern is a regular expression describing the characters permitted within the name
+	Pattern string
+
+	// Scope is the scope within which the name must be unique
+	Scope Scope
+
+	// ValidateFunc is the function used to validate the `name` field of the resource, which is shared with
+	// the schema of the resource so that the rules only need to be defined once. When this isn't set the
+	// name is validated using the MinLength, MaxLength and Pattern instead.
+	ValidateFunc pluginsdk.SchemaValidateFunc
+}
+
+// Validate returns any errors where the name doesn't meet this Rule
+func (r Rule) Validate(name string) []error {
+	validateFunc := r.ValidateFunc
+	if validateFunc == nil {
+		validateFunc = validation.All(
+			validation.StringLenBetween(r.MinLength, r.MaxLength),
+			validation.StringMatch(regexp.MustCompile(r.Pattern), fmt.Sprintf("must match the pattern %q", r.Pattern)),
+		)
+	}
+
+	_, errors := validateFunc(name, "name")
+	if errors == nil {
+		return []error{}
+	}
+
+	return errors
+}
+
+const (
+	// networkResourceName is the pattern used for the names of most Network resources, which must begin with
+	// a letter or number and end with a letter, number or underscore
+	networkResourceName = `^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9_])?$`
+
+	// namespaceName is the pattern used for the names of Event Hub and Service Bus Namespaces
+	namespaceName = `^[a-zA-Z][-a-zA-Z0-9]*[a-zA-Z0-9]$`
+)
+
+// rules contains the naming rules for each supported resource type, keyed by the resource type
+var rules = map[string]Rule{
+	"azurerm_batch_account": {
+		MinLength:    3,
+		MaxLength:    24,
+		Pattern:      `^[a-z0-9]+$`,
+		Scope:        ScopeRegion,
+		ValidateFunc: batchValidate.AccountName,
+	},
+	"azurerm_container_registry": {
+		MinLength:    5,
+		MaxLength:    50,
+		Pattern:      `^[a-zA-Z0-9]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: containerValidate.ContainerRegistryName,
+	},
+	"azurerm_cosmosdb_account": {
+		MinLength:    3,
+		MaxLength:    50,
+		Pattern:      `^[-a-z0-9]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: cosmosValidate.AccountName,
+	},
+	"azurerm_eventhub_namespace": {
+		MinLength:    6,
+		MaxLength:    50,
+		Pattern:      namespaceName,
+		Scope:        ScopeGlobal,
+		ValidateFunc: eventhubValidate.ValidateEventHubNamespaceName(),
+	},
+	"azurerm_key_vault": {
+		MinLength:    3,
+		MaxLength:    24,
+		Pattern:      `^[a-zA-Z0-9-]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: keyVaultValidate.VaultName,
+	},
+	"azurerm_kubernetes_cluster": {
+		MinLength: 1,
+		MaxLength: 63,
+		Pattern:   `^[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?$`,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_linux_function_app": {
+		MinLength:    1,
+		MaxLength:    60,
+		Pattern:      `^[0-9a-zA-Z-]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: appServiceValidate.WebAppName,
+	},
+	"azurerm_linux_virtual_machine": {
+		MinLength:    1,
+		MaxLength:    80,
+		Pattern:      `^[a-zA-Z0-9]([a-zA-Z0-9._-]*\w)?$`,
+		Scope:        ScopeResourceGroup,
+		ValidateFunc: computeValidate.VirtualMachineName,
+	},
+	"azurerm_linux_web_app": {
+		MinLength:    1,
+		MaxLength:    60,
+		Pattern:      `^[0-9a-zA-Z-]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: appServiceValidate.WebAppName,
+	},
+	"azurerm_log_analytics_workspace": {
+		MinLength:    4,
+		MaxLength:    63,
+		Pattern:      `^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$`,
+		Scope:        ScopeResourceGroup,
+		ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceName,
+	},
+	"azurerm_mssql_server": {
+		MinLength:    1,
+		MaxLength:    63,
+		Pattern:      `^[0-9a-z]([-0-9a-z]*[0-9a-z])?$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: mssqlValidate.ValidateMsSqlServerName,
+	},
+	"azurerm_network_interface": {
+		MinLength: 1,
+		MaxLength: 80,
+		Pattern:   networkResourceName,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_network_security_group": {
+		MinLength: 1,
+		MaxLength: 80,
+		Pattern:   networkResourceName,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_postgresql_flexible_server": {
+		MinLength:    1,
+		MaxLength:    63,
+		Pattern:      `^[a-z0-9]([a-z0-9-]+[a-z0-9])?$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: postgresValidate.FlexibleServerName,
+	},
+	"azurerm_public_ip": {
+		MinLength: 1,
+		MaxLength: 80,
+		Pattern:   networkResourceName,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_resource_group": {
+		MinLength:    1,
+		MaxLength:    90,
+		Pattern:      `^[-\w._()]*[-\w_()]$`,
+		Scope:        ScopeSubscription,
+		ValidateFunc: resourcegroups.ValidateName,
+	},
+	"azurerm_service_plan": {
+		MinLength:    1,
+		MaxLength:    60,
+		Pattern:      `^[0-9a-zA-Z-_]+$`,
+		Scope:        ScopeResourceGroup,
+		ValidateFunc: appServiceValidate.ServicePlanName,
+	},
+	"azurerm_servicebus_namespace": {
+		MinLength:    6,
+		MaxLength:    50,
+		Pattern:      namespaceName,
+		Scope:        ScopeGlobal,
+		ValidateFunc: serviceBusValidate.NamespaceName,
+	},
+	"azurerm_storage_account": {
+		MinLength:    3,
+		MaxLength:    24,
+		Pattern:      `^[a-z0-9]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: storageValidate.StorageAccountName,
+	},
+	"azurerm_subnet": {
+		MinLength: 1,
+		MaxLength: 80,
+		Pattern:   networkResourceName,
+		Scope:     ScopeParent,
+	},
+	"azurerm_user_assigned_identity": {
+		MinLength: 3,
+		MaxLength: 128,
+		Pattern:   `^[a-zA-Z0-9][a-zA-Z0-9_-]*$`,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_virtual_network": {
+		MinLength: 2,
+		MaxLength: 64,
+		Pattern:   networkResourceName,
+		Scope:     ScopeResourceGroup,
+	},
+	"azurerm_windows_function_app": {
+		MinLength:    1,
+		MaxLength:    60,
+		Pattern:      `^[0-9a-zA-Z-]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: appServiceValidate.WebAppName,
+	},
+	"azurerm_windows_virtual_machine": {
+		MinLength:    1,
+		MaxLength:    80,
+		Pattern:      `^[a-zA-Z0-9]([a-zA-Z0-9._-]*\w)?$`,
+		Scope:        ScopeResourceGroup,
+		ValidateFunc: computeValidate.VirtualMachineName,
+	},
+	"azurerm_windows_web_app": {
+		MinLength:    1,
+		MaxLength:    60,
+		Pattern:      `^[0-9a-zA-Z-]+$`,
+		Scope:        ScopeGlobal,
+		ValidateFunc: appServiceValidate.WebAppName,
+	},
+}
+
+// RuleForResourceType returns the naming Rule for the specified resource type, if one exists
+func RuleForResourceType(resourceType string) (*Rule, bool) {
+	rule, ok := rules[resourceType]
+	if !ok {
+		return nil, false
+	}
+
+	return &rule, true
+}
+
+// ResourceTypes returns a sorted list of the resource types which have naming rules defined
+func ResourceTypes() []string {
+	output := make([]string, 0, len(rules))
+	for resourceType := range rules {
+		output = append(output, resourceType)
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+// ValidateName validates the name for the specified resource type against the registered naming Rule
+func ValidateName(resourceType, name string) ([]error, error) {
+	rule, ok := RuleForResourceType(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("no naming rules are defined for the resource type %q", resourceType)
+	}
+
+	return rule.Validate(name), nil
+}
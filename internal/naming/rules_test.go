@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestValidateName(t *testing.T) {
+	testData := []struct {
+		ResourceType string
+		Name         string
+		Valid        bool
+	}{
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "examplestorage01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "Example-Storage",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         strings.Repeat("a", 25),
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Name:         "example-kv",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Name:         "kv",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Name:         "example-resources_(1).prod",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Name:         "example-resources.",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_cosmosdb_account",
+			Name:         "example-cosmos-01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_cosmosdb_account",
+			Name:         "Example_Cosmos",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_servicebus_namespace",
+			Name:         "1example",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_mssql_server",
+			Name:         "example-sql-",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Name:         "example-network_01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Name:         "example-network-",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Name:         "v",
+			Valid:        false,
+		},
+		{
+			ResourceType: "azurerm_user_assigned_identity",
+			Name:         strings.Repeat("a", 129),
+			Valid:        false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.Name, v.ResourceType)
+
+		errs, err := ValidateName(v.ResourceType, v.Name)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if actual := len(errs) == 0; actual != v.Valid {
+			t.Fatalf("expected %t but got %t: %+v", v.Valid, actual, errs)
+		}
+	}
+}
+
+func TestRules(t *testing.T) {
+	for _, resourceType := range ResourceTypes() {
+		t.Logf("[DEBUG] Testing %q", resourceType)
+
+		rule, _ := RuleForResourceType(resourceType)
+		if rule.MinLength < 1 || rule.MinLength > rule.MaxLength {
+			t.Fatalf("expected a valid length range for %q but got %d to %d", resourceType, rule.MinLength, rule.MaxLength)
+		}
+		if rule.Scope == "" {
+			t.Fatalf("expected a Scope for %q", resourceType)
+		}
+
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			t.Fatalf("expected a valid Pattern for %q but got: %+v", resourceType, err)
+		}
+		if !pattern.MatchString(strings.Repeat("a", rule.MinLength)) {
+			t.Fatalf("expected the Pattern %q for %q to match a name consisting of letters", rule.Pattern, resourceType)
+		}
+
+		// the length range must agree with the ValidateFunc shared with the resource
+		if errs := rule.Validate(strings.Repeat("a", rule.MaxLength+1)); len(errs) == 0 {
+			t.Fatalf("expected a name longer than %d characters to be invalid for %q", rule.MaxLength, resourceType)
+		}
+		if rule.MinLength > 1 {
+			if errs := rule.Validate(strings.Repeat("a", rule.MinLength-1)); len(errs) == 0 {
+				t.Fatalf("expected a name shorter than %d characters to be invalid for %q", rule.MinLength, resourceType)
+			}
+		}
+	}
+}
+
+func TestValidateNameUnsupportedResourceType(t *testing.T) {
+	if _, err := ValidateName("azurerm_unsupported", "example"); err == nil {
+		t.Fatalf("expected an error for an unsupported resource type but didn't get one")
+	}
+}
+
+func TestConventionValidate(t *testing.T) {
+	convention := Convention{
+		Prefix: "contoso",
+		Suffix: "prod",
+	}
+
+	testData := []struct {
+		ResourceType string
+		Name         string
+		Errors       int
+	}{
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "contosologsprod",
+			Errors:       0,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "logsprod",
+			Errors:       1,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "logs",
+			Errors:       2,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "contoso-logs-prod",
+			Errors:       1,
+		},
+		{
+			ResourceType: "azurerm_example",
+			Name:         "contoso-example-prod",
+			Errors:       0,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.Name, v.ResourceType)
+
+		if actual := convention.Validate(v.ResourceType, v.Name); len(actual) != v.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", v.Errors, len(actual), actual)
+		}
+	}
+}
+
+func TestConventionAppliesTo(t *testing.T) {
+	if !(Convention{}).AppliesTo("azurerm_storage_account") {
+		t.Fatalf("expected the Convention to apply to a resource type with naming rules")
+	}
+
+	if (Convention{}).AppliesTo("azurerm_role_assignment") {
+		t.Fatalf("expected the Convention not to apply to a resource type without naming rules")
+	}
+
+	convention := Convention{
+		ResourceTypes: []string{"azurerm_role_assignment"},
+	}
+	if !convention.AppliesTo("azurerm_role_assignment") {
+		t.Fatalf("expected the Convention to apply to an explicitly listed resource type")
+	}
+	if convention.AppliesTo("azurerm_storage_account") {
+		t.Fatalf("expected the Convention not to apply to a resource type which isn't listed")
+	}
+}
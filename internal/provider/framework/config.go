@@ -14,8 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ProviderConfig struct {
@@ -506,6 +508,56 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	}
 
 	p.clientBuilder.Features = f
+
+	defaultTags := tags.DefaultTags{
+		Tags:       map[string]string{},
+		IgnoreKeys: []string{},
+	}
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		var defaultTagsList []DefaultTags
+		d := data.DefaultTags.ElementsAs(ctx, &defaultTagsList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(defaultTagsList) > 0 {
+			if v := defaultTagsList[0].Tags; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &defaultTags.Tags, false)...)
+			}
+			if v := defaultTagsList[0].IgnoreKeys; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &defaultTags.IgnoreKeys, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+	p.clientBuilder.DefaultTags = defaultTags
+
+	if !data.Naming.IsNull() && !data.Naming.IsUnknown() {
+		var namingList []Naming
+		d := data.Naming.ElementsAs(ctx, &namingList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(namingList) > 0 {
+			convention := naming.Convention{
+				Prefix:        namingList[0].Prefix.ValueString(),
+				Suffix:        namingList[0].Suffix.ValueString(),
+				ResourceTypes: make([]string, 0),
+			}
+			if v := namingList[0].ResourceTypes; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &convention.ResourceTypes, false)...)
+				if diags.HasError() {
+					return
+				}
+			}
+			p.clientBuilder.NamingConvention = &convention
+		}
+	}
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
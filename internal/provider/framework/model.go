@@ -35,11 +35,24 @@ type ProviderModel struct {
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                      types.List   `tfsdk:"features"`
+	DefaultTags                   types.List   `tfsdk:"default_tags"`
+	Naming                        types.List   `tfsdk:"naming"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type DefaultTags struct {
+	Tags       types.Map  `tfsdk:"tags"`
+	IgnoreKeys types.List `tfsdk:"ignore_keys"`
+}
+
+type Naming struct {
+	Prefix        types.String `tfsdk:"prefix"`
+	Suffix        types.String `tfsdk:"suffix"`
+	ResourceTypes types.List   `tfsdk:"resource_types"`
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
@@ -36,8 +36,10 @@ var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewNamingRuleFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewValidateNameFunction,
 	}
 }
 
@@ -229,6 +231,52 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A mapping of tags which should be assigned to all resources which support tags.",
+						},
+
+						"ignore_keys": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored when reading the tags for a resource.",
+						},
+					},
+				},
+			},
+
+			"naming": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The prefix which the name of each resource must start with.",
+						},
+
+						"suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The suffix which the name of each resource must end with.",
+						},
+
+						"resource_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of resource types which the naming convention applies to. Defaults to the resource types which have naming rules defined.",
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
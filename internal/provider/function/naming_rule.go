@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
+)
+
+type NamingRuleFunction struct{}
+
+var _ function.Function = NamingRuleFunction{}
+
+var namingRuleResultTypes = map[string]attr.Type{
+	"min_length": types.Int64Type,
+	"max_length": types.Int64Type,
+	"pattern":    types.StringType,
+	"scope":      types.StringType,
+}
+
+func NewNamingRuleFunction() function.Function {
+	return &NamingRuleFunction{}
+}
+
+func (a NamingRuleFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "naming_rule"
+}
+
+func (a NamingRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "naming_rule",
+		Description:         "Returns the naming rules (length, allowed characters and uniqueness scope) for the specified Azure Resource Type",
+		MarkdownDescription: "Returns the naming rules (length, allowed characters and uniqueness scope) for the specified Azure Resource Type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				Description:         "The Terraform Resource Type, for example `azurerm_storage_account`",
+				MarkdownDescription: "The Terraform Resource Type, for example `azurerm_storage_account`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: namingRuleResultTypes,
+		},
+	}
+}
+
+func (a NamingRuleFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType))
+
+	if response.Error != nil {
+		return
+	}
+
+	rule, ok := naming.RuleForResourceType(resourceType)
+	if !ok {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("no naming rules are defined for the resource type %q", resourceType))
+		return
+	}
+
+	result, diags := types.ObjectValue(namingRuleResultTypes, map[string]attr.Value{
+		"min_length": types.Int64Value(int64(rule.MinLength)),
+		"max_length": types.Int64Value(int64(rule.MaxLength)),
+		"pattern":    types.StringValue(rule.Pattern),
+		"scope":      types.StringValue(string(rule.Scope)),
+	})
+	if diags.HasError() {
+		response.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
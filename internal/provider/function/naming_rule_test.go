@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionNamingRule_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamingRuleOutput("azurerm_storage_account"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("min_length", "3"),
+					acceptance.TestCheckOutput("max_length", "24"),
+					acceptance.TestCheckOutput("pattern", "^[a-z0-9]+$"),
+					acceptance.TestCheckOutput("scope", "global"),
+				),
+			},
+			{
+				Config: testNamingRuleOutput("azurerm_subnet"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("max_length", "80"),
+					acceptance.TestCheckOutput("scope", "parent"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionNamingRule_unsupportedResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testNamingRuleOutput("azurerm_unsupported"),
+				ExpectError: regexp.MustCompile("no naming rules are defined"),
+			},
+		},
+	})
+}
+
+func testNamingRuleOutput(resourceType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  rule = provider::azurerm::naming_rule("%s")
+}
+
+output "min_length" {
+  value = local.rule.min_length
+}
+
+output "max_length" {
+  value = local.rule.max_length
+}
+
+output "pattern" {
+  value = local.rule.pattern
+}
+
+output "scope" {
+  value = local.rule.scope
+}
+`, resourceType)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
+)
+
+type ValidateNameFunction struct{}
+
+var _ function.Function = ValidateNameFunction{}
+
+func NewValidateNameFunction() function.Function {
+	return &ValidateNameFunction{}
+}
+
+func (a ValidateNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "validate_name"
+}
+
+func (a ValidateNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "validate_name",
+		Description:         "Validates a name against the naming rules (length and allowed characters) for the specified Azure Resource Type, returning a list of the rules which the name violates",
+		MarkdownDescription: "Validates a name against the naming rules (length and allowed characters) for the specified Azure Resource Type, returning a list of the rules which the name violates",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				Description:         "The Terraform Resource Type, for example `azurerm_storage_account`",
+				MarkdownDescription: "The Terraform Resource Type, for example `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name to validate",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (a ValidateNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	errs, err := naming.ValidateName(resourceType, name)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	violations := make([]string, 0, len(errs))
+	for _, v := range errs {
+		violations = append(violations, v.Error())
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, violations))
+}
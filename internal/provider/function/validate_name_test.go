@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionValidateName_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateNameOutput("azurerm_storage_account", "examplestorage"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("test", "[]"),
+				),
+			},
+			{
+				Config: testValidateNameOutput("azurerm_storage_account", "Example-Storage"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestMatchOutput("test", regexp.MustCompile("can only consist of lowercase letters and numbers")),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateName_unsupportedResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testValidateNameOutput("azurerm_unsupported", "example"),
+				ExpectError: regexp.MustCompile("no naming rules are defined"),
+			},
+		},
+	})
+}
+
+func testValidateNameOutput(resourceType, name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = jsonencode(provider::azurerm::validate_name("%s", "%s"))
+}
+`, resourceType, name)
+}
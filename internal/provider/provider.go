@@ -18,8 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -135,6 +137,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			sdk.ApplyDefaultTags(v)
+			sdk.ApplyNamingConvention(k, v)
 			resources[k] = v
 		}
 	}
@@ -321,6 +325,63 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "A mapping of tags which should be assigned to all resources which support tags.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"ignore_keys": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored when reading the tags for a resource.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"naming": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix which the name of each resource must start with.",
+						},
+
+						"suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The suffix which the name of each resource must end with.",
+						},
+
+						"resource_types": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of resource types which the naming convention applies to. Defaults to the resource types which have naming rules defined.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -518,6 +579,8 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		DefaultTags:                 tags.ExpandDefaultTags(d.Get("default_tags").([]interface{})),
+		NamingConvention:            naming.ExpandConvention(d.Get("naming").([]interface{})),
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
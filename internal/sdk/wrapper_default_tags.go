@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+type resourceContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// ApplyDefaultTags merges any Default Tags defined in the Provider block into the Tags for the resource
+// at plan time, exposing the result in the computed `tags_all` field - which is added to the Schema for
+// each resource with a configurable `tags` field.
+//
+// Since the Default Tags are applied by updating the Tags for the resource in-place, resources where
+// `tags` is ForceNew are left as-is.
+func ApplyDefaultTags(resource *schema.Resource) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	tagsSchema, ok := resource.Schema["tags"]
+	if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional || tagsSchema.Computed || tagsSchema.ForceNew {
+		return
+	}
+	if tagsAllSchema, ok := resource.Schema["tags_all"]; ok {
+		if tagsAllSchema.Type != schema.TypeMap || !tagsAllSchema.Computed || tagsAllSchema.Optional {
+			return
+		}
+	} else {
+		resource.Schema["tags_all"] = tags.SchemaTagsAll()
+	}
+
+	existingCustomizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if existingCustomizeDiff != nil {
+			if err := existingCustomizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return customizeDiffForDefaultTags(d, meta)
+	}
+
+	if create := contextFuncFor(resource.CreateContext, resource.Create); create != nil { //nolint:staticcheck
+		resource.Create = nil //nolint:staticcheck
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return applyDefaultTags(ctx, d, meta, create)
+		}
+	}
+
+	if read := contextFuncFor(resource.ReadContext, resource.Read); read != nil { //nolint:staticcheck
+		resource.Read = nil //nolint:staticcheck
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			defaultTags := defaultTagsFromMeta(meta)
+			// when refreshing the configuration isn't available, so fall back to the Tags from the previous state
+			configured := configuredTags(d, d.Get("tags").(map[string]interface{}))
+
+			diags := read(ctx, d, meta)
+			if diags.HasError() || d.Id() == "" {
+				return diags
+			}
+
+			return append(diags, setDefaultTagsIntoState(d, defaultTags, configured)...)
+		}
+	}
+
+	if update := contextFuncFor(resource.UpdateContext, resource.Update); update != nil { //nolint:staticcheck
+		resource.Update = nil //nolint:staticcheck
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return applyDefaultTags(ctx, d, meta, update)
+		}
+	}
+}
+
+// applyDefaultTags sets the Tags which should be sent to Azure into the `tags` field before calling the
+// Create/Update function for the resource - that is the Tags defined on the resource merged with the
+// Default Tags, along with any Tags using an ignored key which are already assigned to the resource
+// (since otherwise these would be removed when the Tags for the resource are replaced)
+func applyDefaultTags(ctx context.Context, d *schema.ResourceData, meta interface{}, f resourceContextFunc) diag.Diagnostics {
+	defaultTags := defaultTagsFromMeta(meta)
+	configured := configuredTags(d, nil)
+
+	existing, _ := d.GetChange("tags_all")
+	if err := d.Set("tags", mergeIgnoredTags(defaultTags, defaultTags.Merge(configured), existing.(map[string]interface{}))); err != nil {
+		return diag.Errorf("setting `tags`: %+v", err)
+	}
+
+	diags := f(ctx, d, meta)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return append(diags, setDefaultTagsIntoState(d, defaultTags, configured)...)
+}
+
+func contextFuncFor(withContext resourceContextFunc, withoutContext func(*schema.ResourceData, interface{}) error) resourceContextFunc {
+	if withContext != nil {
+		return withContext
+	}
+
+	if withoutContext != nil {
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(withoutContext(d, meta))
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffForDefaultTags(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	defaultTags := defaultTagsFromMeta(meta)
+	existing, _ := d.GetChange("tags_all")
+	existingTags := existing.(map[string]interface{})
+	tagsAll := mergeIgnoredTags(defaultTags, defaultTags.Merge(d.Get("tags").(map[string]interface{})), existingTags)
+
+	if d.Id() != "" && reflect.DeepEqual(existingTags, tagsAll) {
+		return nil
+	}
+
+	if err := d.SetNew("tags_all", tagsAll); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+// mergeIgnoredTags returns the Tags along with any Tags using an ignored key from the existing Tags, which
+// aren't managed by Terraform and so should be retained as-is
+func mergeIgnoredTags(defaultTags tags.DefaultTags, input map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for key, value := range input {
+		output[key] = value
+	}
+
+	for key, value := range defaultTags.IgnoredTags(existing) {
+		if _, ok := output[key]; !ok {
+			output[key] = value
+		}
+	}
+
+	return output
+}
+
+func defaultTagsFromMeta(meta interface{}) tags.DefaultTags {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTags
+	}
+
+	return tags.DefaultTags{}
+}
+
+// configuredTags returns the Tags defined on the resource in the configuration, or the fallback
+// when the configuration isn't available (for example when refreshing or importing)
+func configuredTags(d *schema.ResourceData, fallback map[string]interface{}) map[string]interface{} {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute("tags") {
+		if fallback != nil {
+			return fallback
+		}
+		return d.Get("tags").(map[string]interface{})
+	}
+
+	output := make(map[string]interface{})
+
+	v := raw.GetAttr("tags")
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return output
+	}
+
+	for it := v.ElementIterator(); it.Next(); {
+		key, value := it.Element()
+		if !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+			continue
+		}
+		output[key.AsString()] = value.AsString()
+	}
+
+	return output
+}
+
+// setDefaultTagsIntoState splits the Tags read from Azure into the Tags defined on the resource and `tags_all`,
+// which contains all of the Tags assigned to the resource (including those using an ignored key)
+func setDefaultTagsIntoState(d *schema.ResourceData, defaultTags tags.DefaultTags, configured map[string]interface{}) diag.Diagnostics {
+	remote := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags_all", remote); err != nil {
+		return diag.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", defaultTags.ResourceTags(remote, configured)); err != nil {
+		return diag.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+// defaultTagsTestResource is a resource supporting Default Tags, whose Tags are stored in `remote`
+type defaultTagsTestResource struct {
+	remote map[string]interface{}
+}
+
+func (r *defaultTagsTestResource) resource() *schema.Resource {
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			r.remote = d.Get("tags").(map[string]interface{})
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return d.Set("tags", r.remote)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", r.remote)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			r.remote = d.Get("tags").(map[string]interface{})
+			return d.Set("tags", r.remote)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+	ApplyDefaultTags(resource)
+	return resource
+}
+
+func defaultTagsTestClient() *clients.Client {
+	return &clients.Client{
+		DefaultTags: tags.DefaultTags{
+			Tags: map[string]string{
+				"environment": "production",
+				"team":        "platform",
+			},
+			IgnoreKeys: []string{"CreatedOnDate"},
+		},
+	}
+}
+
+func defaultTagsTestState(resourceTags map[string]string, tagsAll map[string]string) *terraform.InstanceState {
+	attributes := map[string]string{
+		"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		"name": "example",
+	}
+	for field, values := range map[string]map[string]string{"tags": resourceTags, "tags_all": tagsAll} {
+		attributes[field+".%"] = strconv.Itoa(len(values))
+		for key, value := range values {
+			attributes[field+"."+key] = value
+		}
+	}
+
+	return &terraform.InstanceState{
+		ID:         attributes["id"],
+		Attributes: attributes,
+	}
+}
+
+// flatmapFor returns the values for the map `field` from the flatmapped attributes within the state
+func flatmapFor(state *terraform.InstanceState, field string) map[string]string {
+	output := make(map[string]string)
+	for key, value := range state.Attributes {
+		if !strings.HasPrefix(key, field+".") || key == field+".%" {
+			continue
+		}
+		output[strings.TrimPrefix(key, field+".")] = value
+	}
+	return output
+}
+
+func TestApplyDefaultTags_schema(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+	}
+	ApplyDefaultTags(resource)
+
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected a CustomizeDiff for a resource with a configurable `tags` field")
+	}
+	tagsAll, ok := resource.Schema["tags_all"]
+	if !ok {
+		t.Fatalf("expected `tags_all` to be added to a resource with a configurable `tags` field")
+	}
+	if err := schema.InternalMap(resource.Schema).InternalValidate(nil); err != nil {
+		t.Fatalf("expected the Schema to be valid but got: %+v", err)
+	}
+	if !tagsAll.Computed || tagsAll.Optional {
+		t.Fatalf("expected `tags_all` to be Computed only")
+	}
+
+	resource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.ForceNewSchema(),
+		},
+	}
+	ApplyDefaultTags(resource)
+
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected no CustomizeDiff for a resource where `tags` is ForceNew")
+	}
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("expected `tags_all` not to be added to a resource where `tags` is ForceNew")
+	}
+
+	resource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tags.Schema(),
+			"tags_all": tags.Schema(),
+		},
+	}
+	ApplyDefaultTags(resource)
+
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected no CustomizeDiff for a resource with a configurable `tags_all` field")
+	}
+}
+
+func TestCustomizeDiffForDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		State    *terraform.InstanceState
+		Tags     map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name: "new resource",
+			Tags: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "world",
+			},
+			Expected: map[string]string{
+				"environment": "staging",
+				"hello":       "world",
+				"team":        "platform",
+			},
+		},
+		{
+			Name: "existing resource with an ignored key",
+			State: defaultTagsTestState(map[string]string{
+				"hello": "world",
+			}, map[string]string{
+				"CreatedOnDate": "2024-01-01",
+				"environment":   "production",
+				"hello":         "world",
+				"team":          "platform",
+			}),
+			Tags: map[string]interface{}{
+				"hello": "there",
+			},
+			Expected: map[string]string{
+				"CreatedOnDate": "2024-01-01",
+				"environment":   "production",
+				"hello":         "there",
+				"team":          "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "example",
+			"tags": v.Tags,
+		})
+		diff, err := (&defaultTagsTestResource{}).resource().Diff(context.TODO(), v.State, config, defaultTagsTestClient())
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		state := v.State
+		if state == nil {
+			state = &terraform.InstanceState{}
+		}
+		actual := flatmapFor(state.MergeDiff(diff), "tags_all")
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected `tags_all` to be %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestCustomizeDiffForDefaultTags_noChanges(t *testing.T) {
+	state := defaultTagsTestState(map[string]string{
+		"hello": "world",
+	}, map[string]string{
+		"CreatedOnDate": "2024-01-01",
+		"environment":   "production",
+		"hello":         "world",
+		"team":          "platform",
+	})
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+
+	diff, err := (&defaultTagsTestResource{}).resource().Diff(context.TODO(), state, config, defaultTagsTestClient())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no changes but got: %+v", diff.Attributes)
+	}
+}
+
+func TestApplyDefaultTags_readSplitsTags(t *testing.T) {
+	r := &defaultTagsTestResource{
+		remote: map[string]interface{}{
+			"CreatedOnDate": "2024-01-01",
+			"environment":   "production",
+			"hello":         "world",
+			"team":          "platform",
+		},
+	}
+	state := defaultTagsTestState(map[string]string{
+		"hello": "world",
+	}, map[string]string{})
+
+	actual, diags := r.resource().RefreshWithoutUpgrade(context.TODO(), state, defaultTagsTestClient())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags)
+	}
+
+	expectedTags := map[string]string{
+		"hello": "world",
+	}
+	if v := flatmapFor(actual, "tags"); !reflect.DeepEqual(v, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, v)
+	}
+
+	expectedTagsAll := map[string]string{
+		"CreatedOnDate": "2024-01-01",
+		"environment":   "production",
+		"hello":         "world",
+		"team":          "platform",
+	}
+	if v := flatmapFor(actual, "tags_all"); !reflect.DeepEqual(v, expectedTagsAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedTagsAll, v)
+	}
+}
+
+func TestApplyDefaultTags_updateRetainsIgnoredKeys(t *testing.T) {
+	r := &defaultTagsTestResource{
+		remote: map[string]interface{}{
+			"CreatedOnDate": "2024-01-01",
+			"environment":   "production",
+			"hello":         "world",
+			"team":          "platform",
+		},
+	}
+	resource := r.resource()
+	state := defaultTagsTestState(map[string]string{
+		"hello": "world",
+	}, map[string]string{
+		"CreatedOnDate": "2024-01-01",
+		"environment":   "production",
+		"hello":         "world",
+		"team":          "platform",
+	})
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "there",
+		},
+	})
+
+	client := defaultTagsTestClient()
+	diff, err := resource.Diff(context.TODO(), state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	actual, diags := resource.Apply(context.TODO(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"CreatedOnDate": "2024-01-01",
+		"environment":   "production",
+		"hello":         "there",
+		"team":          "platform",
+	}
+	if !reflect.DeepEqual(r.remote, expectedRemote) {
+		t.Fatalf("expected the Tags sent to Azure to be %+v but got %+v", expectedRemote, r.remote)
+	}
+
+	expectedTags := map[string]string{
+		"hello": "there",
+	}
+	if v := flatmapFor(actual, "tags"); !reflect.DeepEqual(v, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, v)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// ApplyNamingConvention validates the `name` of the resource against the naming convention defined
+// in the `naming` block of the Provider (and the naming rules for the resource type) at plan time.
+//
+// Resources which don't expose a configurable top-level `name` field are left as-is.
+func ApplyNamingConvention(resourceType string, resource *schema.Resource) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	nameSchema, ok := resource.Schema["name"]
+	if !ok || nameSchema.Type != schema.TypeString || (!nameSchema.Required && !nameSchema.Optional) {
+		return
+	}
+
+	existingCustomizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if existingCustomizeDiff != nil {
+			if err := existingCustomizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return customizeDiffForNamingConvention(resourceType, d, meta)
+	}
+}
+
+func customizeDiffForNamingConvention(resourceType string, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.NamingConvention == nil || !client.NamingConvention.AppliesTo(resourceType) {
+		return nil
+	}
+
+	// only new or renamed resources are validated, so that existing resources don't prevent a plan
+	if d.Id() != "" && !d.HasChange("name") {
+		return nil
+	}
+
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	if errs := client.NamingConvention.Validate(resourceType, name); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, fmt.Sprintf("  - %s", err))
+		}
+		return fmt.Errorf("the name %q for the %s resource doesn't meet the naming convention:\n%s", name, resourceType, strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
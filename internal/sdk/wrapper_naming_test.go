@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/naming"
+)
+
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func namingTestResource() *schema.Resource {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+	ApplyNamingConvention("azurerm_storage_account", resource)
+	return resource
+}
+
+func namingTestClient(convention *naming.Convention) *clients.Client {
+	return &clients.Client{
+		NamingConvention: convention,
+	}
+}
+
+func TestApplyNamingConvention_noNameField(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+	ApplyNamingConvention("azurerm_storage_account", resource)
+
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected no CustomizeDiff for a resource without a configurable `name` field")
+	}
+}
+
+func TestApplyNamingConvention_existingCustomizeDiff(t *testing.T) {
+	called := false
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			called = true
+			return nil
+		},
+	}
+	ApplyNamingConvention("azurerm_storage_account", resource)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "examplestorage",
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, namingTestClient(nil)); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if !called {
+		t.Fatalf("expected the existing CustomizeDiff to be called")
+	}
+}
+
+func TestCustomizeDiffForNamingConvention(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Convention    *naming.Convention
+		State         *terraform.InstanceState
+		ResourceName  string
+		ExpectedError string
+	}{
+		{
+			Name:         "no naming convention",
+			Convention:   nil,
+			ResourceName: "Invalid-Name",
+		},
+		{
+			Name: "valid name",
+			Convention: &naming.Convention{
+				Prefix: "contoso",
+			},
+			ResourceName: "contosologs",
+		},
+		{
+			Name: "missing prefix",
+			Convention: &naming.Convention{
+				Prefix: "contoso",
+			},
+			ResourceName:  "logs",
+			ExpectedError: `must start with the prefix "contoso"`,
+		},
+		{
+			Name: "missing suffix",
+			Convention: &naming.Convention{
+				Suffix: "prod",
+			},
+			ResourceName:  "logsdev",
+			ExpectedError: `must end with the suffix "prod"`,
+		},
+		{
+			Name:          "naming rule for the resource type",
+			Convention:    &naming.Convention{},
+			ResourceName:  "Contoso-Logs",
+			ExpectedError: "can only consist of lowercase letters and numbers",
+		},
+		{
+			Name: "resource type not in the convention",
+			Convention: &naming.Convention{
+				Prefix:        "contoso",
+				ResourceTypes: []string{"azurerm_key_vault"},
+			},
+			ResourceName: "logs",
+		},
+		{
+			Name: "unknown name",
+			Convention: &naming.Convention{
+				Prefix: "contoso",
+			},
+			ResourceName: unknownVariableValue,
+		},
+		{
+			Name: "existing resource which isn't renamed",
+			Convention: &naming.Convention{
+				Prefix: "contoso",
+			},
+			State: &terraform.InstanceState{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/logs",
+				Attributes: map[string]string{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/logs",
+					"name": "logs",
+				},
+			},
+			ResourceName: "logs",
+		},
+		{
+			Name: "existing resource which is renamed",
+			Convention: &naming.Convention{
+				Prefix: "contoso",
+			},
+			State: &terraform.InstanceState{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/logs",
+				Attributes: map[string]string{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/logs",
+					"name": "logs",
+				},
+			},
+			ResourceName:  "newlogs",
+			ExpectedError: `must start with the prefix "contoso"`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": v.ResourceName,
+		})
+		_, err := namingTestResource().Diff(context.TODO(), v.State, config, namingTestClient(v.Convention))
+
+		if v.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error containing %q but didn't get one", v.ExpectedError)
+		}
+		if !strings.Contains(err.Error(), v.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got: %+v", v.ExpectedError, err)
+		}
+	}
+}
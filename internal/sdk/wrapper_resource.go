@@ -180,6 +180,9 @@ and we recommend using the %[2]q resource instead.
 	}
 	// TODO: State Migrations
 
+	ApplyDefaultTags(&resource)
+	ApplyNamingConvention(rw.resource.ResourceType(), &resource)
+
 	return &resource, nil
 }
 
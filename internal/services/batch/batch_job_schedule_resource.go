@@ -0,0 +1,1111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/pool"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	batchDataplane "github.com/jackofallops/kermit/sdk/batch/2022-01.15.0/batch"
+)
+
+type BatchJobScheduleResource struct{}
+
+var _ sdk.ResourceWithUpdate = BatchJobScheduleResource{}
+
+type BatchJobScheduleModel struct {
+	Name                        string                        `tfschema:"name"`
+	BatchPoolId                 string                        `tfschema:"batch_pool_id"`
+	DisplayName                 string                        `tfschema:"display_name"`
+	Schedule                    []BatchJobScheduleSchedule    `tfschema:"schedule"`
+	Priority                    int64                         `tfschema:"priority"`
+	TaskRetryMaximum            int64                         `tfschema:"task_retry_maximum"`
+	MaxWallClockTime            string                        `tfschema:"max_wall_clock_time"`
+	MaxParallelTasks            int64                         `tfschema:"max_parallel_tasks"`
+	UsesTaskDependencies        bool                          `tfschema:"uses_task_dependencies"`
+	OnAllTasksComplete          string                        `tfschema:"on_all_tasks_complete"`
+	OnTaskFailure               string                        `tfschema:"on_task_failure"`
+	CommonEnvironmentProperties map[string]string             `tfschema:"common_environment_properties"`
+	JobManagerTask              []BatchJobScheduleManagerTask `tfschema:"job_manager_task"`
+	JobPreparationTask          []BatchJobSchedulePrepTask    `tfschema:"job_preparation_task"`
+	JobReleaseTask              []BatchJobScheduleReleaseTask `tfschema:"job_release_task"`
+	Metadata                    map[string]string             `tfschema:"metadata"`
+	State                       string                        `tfschema:"state"`
+	NextRunTime                 string                        `tfschema:"next_run_time"`
+}
+
+type BatchJobScheduleSchedule struct {
+	RecurrenceInterval string `tfschema:"recurrence_interval"`
+	StartWindow        string `tfschema:"start_window"`
+	DoNotRunUntil      string `tfschema:"do_not_run_until"`
+	DoNotRunAfter      string `tfschema:"do_not_run_after"`
+}
+
+type BatchJobScheduleManagerTask struct {
+	Id                    string                     `tfschema:"id"`
+	DisplayName           string                     `tfschema:"display_name"`
+	CommandLine           string                     `tfschema:"command_line"`
+	EnvironmentProperties map[string]string          `tfschema:"environment_properties"`
+	ResourceFile          []BatchJobTaskResourceFile `tfschema:"resource_file"`
+	UserIdentity          []BatchJobTaskUserIdentity `tfschema:"user_identity"`
+	MaxWallClockTime      string                     `tfschema:"max_wall_clock_time"`
+	RetentionTime         string                     `tfschema:"retention_time"`
+	TaskRetryMaximum      int64                      `tfschema:"task_retry_maximum"`
+	KillJobOnCompletion   bool                       `tfschema:"kill_job_on_completion"`
+	RunExclusive          bool                       `tfschema:"run_exclusive"`
+}
+
+type BatchJobSchedulePrepTask struct {
+	Id                            string                     `tfschema:"id"`
+	CommandLine                   string                     `tfschema:"command_line"`
+	EnvironmentProperties         map[string]string          `tfschema:"environment_properties"`
+	ResourceFile                  []BatchJobTaskResourceFile `tfschema:"resource_file"`
+	UserIdentity                  []BatchJobTaskUserIdentity `tfschema:"user_identity"`
+	MaxWallClockTime              string                     `tfschema:"max_wall_clock_time"`
+	RetentionTime                 string                     `tfschema:"retention_time"`
+	TaskRetryMaximum              int64                      `tfschema:"task_retry_maximum"`
+	WaitForSuccess                bool                       `tfschema:"wait_for_success"`
+	RerunOnNodeRebootAfterSuccess bool                       `tfschema:"rerun_on_node_reboot_after_success"`
+}
+
+type BatchJobScheduleReleaseTask struct {
+	Id                    string                     `tfschema:"id"`
+	CommandLine           string                     `tfschema:"command_line"`
+	EnvironmentProperties map[string]string          `tfschema:"environment_properties"`
+	ResourceFile          []BatchJobTaskResourceFile `tfschema:"resource_file"`
+	UserIdentity          []BatchJobTaskUserIdentity `tfschema:"user_identity"`
+	MaxWallClockTime      string                     `tfschema:"max_wall_clock_time"`
+	RetentionTime         string                     `tfschema:"retention_time"`
+}
+
+type BatchJobTaskResourceFile struct {
+	AutoStorageContainerName string `tfschema:"auto_storage_container_name"`
+	StorageContainerUrl      string `tfschema:"storage_container_url"`
+	HttpUrl                  string `tfschema:"http_url"`
+	BlobPrefix               string `tfschema:"blob_prefix"`
+	FilePath                 string `tfschema:"file_path"`
+	FileMode                 string `tfschema:"file_mode"`
+	UserAssignedIdentityId   string `tfschema:"user_assigned_identity_id"`
+}
+
+type BatchJobTaskUserIdentity struct {
+	UserName string                 `tfschema:"user_name"`
+	AutoUser []BatchJobTaskAutoUser `tfschema:"auto_user"`
+}
+
+type BatchJobTaskAutoUser struct {
+	ElevationLevel string `tfschema:"elevation_level"`
+	Scope          string `tfschema:"scope"`
+}
+
+func (r BatchJobScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.JobName,
+		},
+
+		"batch_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: pool.ValidatePoolID,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"recurrence_interval": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"start_window": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"do_not_run_until": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.IsRFC3339Time,
+						DiffSuppressFunc: suppress.RFC3339Time,
+					},
+
+					"do_not_run_after": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.IsRFC3339Time,
+						DiffSuppressFunc: suppress.RFC3339Time,
+					},
+				},
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(-1000, 1000),
+		},
+
+		"task_retry_maximum": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"max_wall_clock_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: azValidate.ISO8601Duration,
+		},
+
+		"max_parallel_tasks": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"uses_task_dependencies": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"on_all_tasks_complete": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(batchDataplane.OnAllTasksCompleteNoAction),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(batchDataplane.OnAllTasksCompleteNoAction),
+				string(batchDataplane.OnAllTasksCompleteTerminateJob),
+			}, false),
+		},
+
+		"on_task_failure": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(batchDataplane.OnTaskFailureNoAction),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(batchDataplane.OnTaskFailureNoAction),
+				string(batchDataplane.OnTaskFailurePerformExitOptionsJobAction),
+			}, false),
+		},
+
+		"common_environment_properties": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"job_manager_task": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: r.jobManagerTaskSchema(),
+			},
+		},
+
+		"job_preparation_task": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: r.jobPreparationTaskSchema(),
+			},
+		},
+
+		"job_release_task": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"job_preparation_task"},
+			Elem: &pluginsdk.Resource{
+				Schema: r.jobReleaseTaskSchema(),
+			},
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_run_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) ResourceType() string {
+	return "azurerm_batch_job_schedule"
+}
+
+func (r BatchJobScheduleResource) ModelObject() interface{} {
+	return &BatchJobScheduleModel{}
+}
+
+func (r BatchJobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.JobScheduleID
+}
+
+func (r BatchJobScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model BatchJobScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := pool.ParsePoolID(model.BatchPoolId)
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewJobScheduleID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.BatchAccountName, poolId.PoolName, model.Name)
+
+			existing, err := r.getJobSchedule(ctx, client, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			schedule, err := expandBatchJobScheduleSchedule(model.Schedule)
+			if err != nil {
+				return err
+			}
+
+			params := batchDataplane.JobScheduleAddParameter{
+				ID:               pointer.To(model.Name),
+				Schedule:         schedule,
+				JobSpecification: r.expandJobSpecification(model, poolId.PoolName),
+				Metadata:         expandBatchJobScheduleMetadata(model.Metadata),
+			}
+
+			if model.DisplayName != "" {
+				params.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if err := r.addJobSchedule(ctx, client, id, params); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := r.getJobSchedule(ctx, client, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := BatchJobScheduleModel{
+				Name:        id.Name,
+				BatchPoolId: pool.NewPoolID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.PoolName).ID(),
+				DisplayName: pointer.From(resp.DisplayName),
+				Schedule:    flattenBatchJobScheduleSchedule(resp.Schedule),
+				Metadata:    flattenBatchJobScheduleMetadata(resp.Metadata),
+				State:       string(resp.State),
+			}
+
+			if info := resp.ExecutionInfo; info != nil && info.NextRunTime != nil {
+				model.NextRunTime = info.NextRunTime.Format(time.RFC3339)
+			}
+
+			if spec := resp.JobSpecification; spec != nil {
+				model.Priority = int64(pointer.From(spec.Priority))
+				model.MaxParallelTasks = int64(pointer.From(spec.MaxParallelTasks))
+				model.UsesTaskDependencies = pointer.From(spec.UsesTaskDependencies)
+				model.OnAllTasksComplete = string(spec.OnAllTasksComplete)
+				model.OnTaskFailure = string(spec.OnTaskFailure)
+				model.CommonEnvironmentProperties = BatchJobResource{}.flattenEnvironmentSettings(spec.CommonEnvironmentSettings)
+
+				if constraints := spec.Constraints; constraints != nil {
+					model.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+					model.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+				}
+
+				model.JobManagerTask = flattenBatchJobManagerTask(spec.JobManagerTask)
+				model.JobPreparationTask = flattenBatchJobPreparationTask(spec.JobPreparationTask)
+				model.JobReleaseTask = flattenBatchJobReleaseTask(spec.JobReleaseTask)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model BatchJobScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			schedule, err := expandBatchJobScheduleSchedule(model.Schedule)
+			if err != nil {
+				return err
+			}
+
+			// the Update operation replaces the schedule, job specification and metadata of the job schedule in full,
+			// the changes only apply to jobs created after the update
+			params := batchDataplane.JobScheduleUpdateParameter{
+				Schedule:         schedule,
+				JobSpecification: r.expandJobSpecification(model, id.PoolName),
+				Metadata:         expandBatchJobScheduleMetadata(model.Metadata),
+			}
+			if params.Metadata == nil {
+				params.Metadata = &[]batchDataplane.MetadataItem{}
+			}
+
+			if err := r.updateJobSchedule(ctx, client, *id, params); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			if err := r.deleteJobSchedule(ctx, client, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) jobManagerTaskSchema() map[string]*pluginsdk.Schema {
+	s := batchJobTaskSchema("job_manager_task")
+
+	s["id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: validate.JobName,
+	}
+
+	s["display_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	s["task_retry_maximum"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(-1),
+	}
+
+	s["kill_job_on_completion"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	s["run_exclusive"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+func (r BatchJobScheduleResource) jobPreparationTaskSchema() map[string]*pluginsdk.Schema {
+	s := batchJobTaskSchema("job_preparation_task")
+
+	s["id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.JobName,
+	}
+
+	s["task_retry_maximum"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(-1),
+	}
+
+	s["wait_for_success"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	s["rerun_on_node_reboot_after_success"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+func (r BatchJobScheduleResource) jobReleaseTaskSchema() map[string]*pluginsdk.Schema {
+	s := batchJobTaskSchema("job_release_task")
+
+	s["id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.JobName,
+	}
+
+	return s
+}
+
+// batchJobTaskSchema returns the properties shared by the job manager, job preparation and job release tasks
+func batchJobTaskSchema(parent string) map[string]*pluginsdk.Schema {
+	userIdentityKeys := []string{
+		fmt.Sprintf("%s.0.user_identity.0.user_name", parent),
+		fmt.Sprintf("%s.0.user_identity.0.auto_user", parent),
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"command_line": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_properties": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"max_wall_clock_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: azValidate.ISO8601Duration,
+		},
+
+		"retention_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: azValidate.ISO8601Duration,
+		},
+
+		"user_identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"user_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: userIdentityKeys,
+					},
+
+					"auto_user": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"elevation_level": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(batchDataplane.ElevationLevelNonAdmin),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(batchDataplane.ElevationLevelNonAdmin),
+										string(batchDataplane.ElevationLevelAdmin),
+									}, false),
+								},
+
+								"scope": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  string(batchDataplane.AutoUserScopeTask),
+									ValidateFunc: validation.StringInSlice([]string{
+										string(batchDataplane.AutoUserScopeTask),
+										string(batchDataplane.AutoUserScopePool),
+									}, false),
+								},
+							},
+						},
+						ExactlyOneOf: userIdentityKeys,
+					},
+				},
+			},
+		},
+
+		"resource_file": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"auto_storage_container_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"blob_prefix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"file_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"file_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"http_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"storage_container_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) expandJobSpecification(model BatchJobScheduleModel, poolName string) *batchDataplane.JobSpecification {
+	spec := &batchDataplane.JobSpecification{
+		Priority:             pointer.To(int32(model.Priority)),
+		MaxParallelTasks:     pointer.To(int32(model.MaxParallelTasks)),
+		UsesTaskDependencies: pointer.To(model.UsesTaskDependencies),
+		OnAllTasksComplete:   batchDataplane.OnAllTasksComplete(model.OnAllTasksComplete),
+		OnTaskFailure:        batchDataplane.OnTaskFailure(model.OnTaskFailure),
+		Constraints: &batchDataplane.JobConstraints{
+			MaxTaskRetryCount: pointer.To(int32(model.TaskRetryMaximum)),
+		},
+		CommonEnvironmentSettings: BatchJobResource{}.expandEnvironmentSettings(model.CommonEnvironmentProperties),
+		JobManagerTask:            expandBatchJobManagerTask(model.JobManagerTask),
+		JobPreparationTask:        expandBatchJobPreparationTask(model.JobPreparationTask),
+		JobReleaseTask:            expandBatchJobReleaseTask(model.JobReleaseTask),
+		PoolInfo: &batchDataplane.PoolInformation{
+			PoolID: pointer.To(poolName),
+		},
+	}
+
+	if model.DisplayName != "" {
+		spec.DisplayName = pointer.To(model.DisplayName)
+	}
+
+	if model.MaxWallClockTime != "" {
+		spec.Constraints.MaxWallClockTime = pointer.To(model.MaxWallClockTime)
+	}
+
+	return spec
+}
+
+func (r BatchJobScheduleResource) addJobSchedule(ctx context.Context, client *batchDataplane.JobScheduleClient, id parse.JobScheduleId, jobSchedule batchDataplane.JobScheduleAddParameter) error {
+	deadline, _ := ctx.Deadline()
+	now := time.Now()
+	timeout := deadline.Sub(now)
+	_, err := client.Add(ctx, jobSchedule, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now})
+	if err != nil {
+		return fmt.Errorf("creating %s: %v", id, err)
+	}
+	return nil
+}
+
+func (r BatchJobScheduleResource) getJobSchedule(ctx context.Context, client *batchDataplane.JobScheduleClient, id parse.JobScheduleId) (batchDataplane.CloudJobSchedule, error) {
+	deadline, _ := ctx.Deadline()
+	now := time.Now()
+	timeout := deadline.Sub(now)
+	return client.Get(ctx, id.Name, "", "", utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil)
+}
+
+func (r BatchJobScheduleResource) updateJobSchedule(ctx context.Context, client *batchDataplane.JobScheduleClient, id parse.JobScheduleId, jobSchedule batchDataplane.JobScheduleUpdateParameter) error {
+	deadline, _ := ctx.Deadline()
+	now := time.Now()
+	timeout := deadline.Sub(now)
+	_, err := client.Update(ctx, id.Name, jobSchedule, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil)
+	return err
+}
+
+func (r BatchJobScheduleResource) deleteJobSchedule(ctx context.Context, client *batchDataplane.JobScheduleClient, id parse.JobScheduleId) error {
+	deadline, _ := ctx.Deadline()
+	now := time.Now()
+	timeout := deadline.Sub(now)
+	_, err := client.Delete(ctx, id.Name, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil)
+	return err
+}
+
+func expandBatchJobScheduleSchedule(input []BatchJobScheduleSchedule) (*batchDataplane.Schedule, error) {
+	result := &batchDataplane.Schedule{}
+	if len(input) == 0 {
+		return result, nil
+	}
+
+	v := input[0]
+	if v.RecurrenceInterval != "" {
+		result.RecurrenceInterval = pointer.To(v.RecurrenceInterval)
+	}
+
+	if v.StartWindow != "" {
+		result.StartWindow = pointer.To(v.StartWindow)
+	}
+
+	if v.DoNotRunUntil != "" {
+		t, err := time.Parse(time.RFC3339, v.DoNotRunUntil)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `do_not_run_until`: %+v", err)
+		}
+		result.DoNotRunUntil = &date.Time{Time: t}
+	}
+
+	if v.DoNotRunAfter != "" {
+		t, err := time.Parse(time.RFC3339, v.DoNotRunAfter)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `do_not_run_after`: %+v", err)
+		}
+		result.DoNotRunAfter = &date.Time{Time: t}
+	}
+
+	return result, nil
+}
+
+func flattenBatchJobScheduleSchedule(input *batchDataplane.Schedule) []BatchJobScheduleSchedule {
+	result := BatchJobScheduleSchedule{}
+	if input == nil {
+		return []BatchJobScheduleSchedule{result}
+	}
+
+	result.RecurrenceInterval = pointer.From(input.RecurrenceInterval)
+	result.StartWindow = pointer.From(input.StartWindow)
+
+	if input.DoNotRunUntil != nil {
+		result.DoNotRunUntil = input.DoNotRunUntil.Format(time.RFC3339)
+	}
+
+	if input.DoNotRunAfter != nil {
+		result.DoNotRunAfter = input.DoNotRunAfter.Format(time.RFC3339)
+	}
+
+	return []BatchJobScheduleSchedule{result}
+}
+
+func expandBatchJobScheduleMetadata(input map[string]string) *[]batchDataplane.MetadataItem {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]batchDataplane.MetadataItem, 0, len(input))
+	for k, v := range input {
+		result = append(result, batchDataplane.MetadataItem{
+			Name:  pointer.To(k),
+			Value: pointer.To(v),
+		})
+	}
+	return &result
+}
+
+func flattenBatchJobScheduleMetadata(input *[]batchDataplane.MetadataItem) map[string]string {
+	if input == nil {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for _, item := range *input {
+		if item.Name == nil || item.Value == nil {
+			continue
+		}
+		result[*item.Name] = *item.Value
+	}
+	return result
+}
+
+func expandBatchJobManagerTask(input []BatchJobScheduleManagerTask) *batchDataplane.JobManagerTask {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := &batchDataplane.JobManagerTask{
+		ID:                  pointer.To(v.Id),
+		CommandLine:         pointer.To(v.CommandLine),
+		EnvironmentSettings: BatchJobResource{}.expandEnvironmentSettings(v.EnvironmentProperties),
+		ResourceFiles:       expandBatchJobTaskResourceFiles(v.ResourceFile),
+		UserIdentity:        expandBatchJobTaskUserIdentity(v.UserIdentity),
+		Constraints:         expandBatchJobTaskConstraints(v.MaxWallClockTime, v.RetentionTime, v.TaskRetryMaximum),
+		KillJobOnCompletion: pointer.To(v.KillJobOnCompletion),
+		RunExclusive:        pointer.To(v.RunExclusive),
+	}
+
+	if v.DisplayName != "" {
+		result.DisplayName = pointer.To(v.DisplayName)
+	}
+
+	return result
+}
+
+func flattenBatchJobManagerTask(input *batchDataplane.JobManagerTask) []BatchJobScheduleManagerTask {
+	if input == nil {
+		return []BatchJobScheduleManagerTask{}
+	}
+
+	result := BatchJobScheduleManagerTask{
+		Id:                    pointer.From(input.ID),
+		DisplayName:           pointer.From(input.DisplayName),
+		CommandLine:           pointer.From(input.CommandLine),
+		EnvironmentProperties: BatchJobResource{}.flattenEnvironmentSettings(input.EnvironmentSettings),
+		ResourceFile:          flattenBatchJobTaskResourceFiles(input.ResourceFiles),
+		UserIdentity:          flattenBatchJobTaskUserIdentity(input.UserIdentity),
+		KillJobOnCompletion:   pointer.From(input.KillJobOnCompletion),
+		RunExclusive:          pointer.From(input.RunExclusive),
+	}
+
+	if constraints := input.Constraints; constraints != nil {
+		result.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+		result.RetentionTime = pointer.From(constraints.RetentionTime)
+		result.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+	}
+
+	return []BatchJobScheduleManagerTask{result}
+}
+
+func expandBatchJobPreparationTask(input []BatchJobSchedulePrepTask) *batchDataplane.JobPreparationTask {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := &batchDataplane.JobPreparationTask{
+		CommandLine:                   pointer.To(v.CommandLine),
+		EnvironmentSettings:           BatchJobResource{}.expandEnvironmentSettings(v.EnvironmentProperties),
+		ResourceFiles:                 expandBatchJobTaskResourceFiles(v.ResourceFile),
+		UserIdentity:                  expandBatchJobTaskUserIdentity(v.UserIdentity),
+		Constraints:                   expandBatchJobTaskConstraints(v.MaxWallClockTime, v.RetentionTime, v.TaskRetryMaximum),
+		WaitForSuccess:                pointer.To(v.WaitForSuccess),
+		RerunOnNodeRebootAfterSuccess: pointer.To(v.RerunOnNodeRebootAfterSuccess),
+	}
+
+	if v.Id != "" {
+		result.ID = pointer.To(v.Id)
+	}
+
+	return result
+}
+
+func flattenBatchJobPreparationTask(input *batchDataplane.JobPreparationTask) []BatchJobSchedulePrepTask {
+	if input == nil {
+		return []BatchJobSchedulePrepTask{}
+	}
+
+	result := BatchJobSchedulePrepTask{
+		Id:                            pointer.From(input.ID),
+		CommandLine:                   pointer.From(input.CommandLine),
+		EnvironmentProperties:         BatchJobResource{}.flattenEnvironmentSettings(input.EnvironmentSettings),
+		ResourceFile:                  flattenBatchJobTaskResourceFiles(input.ResourceFiles),
+		UserIdentity:                  flattenBatchJobTaskUserIdentity(input.UserIdentity),
+		WaitForSuccess:                pointer.From(input.WaitForSuccess),
+		RerunOnNodeRebootAfterSuccess: pointer.From(input.RerunOnNodeRebootAfterSuccess),
+	}
+
+	if constraints := input.Constraints; constraints != nil {
+		result.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+		result.RetentionTime = pointer.From(constraints.RetentionTime)
+		result.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+	}
+
+	return []BatchJobSchedulePrepTask{result}
+}
+
+func expandBatchJobReleaseTask(input []BatchJobScheduleReleaseTask) *batchDataplane.JobReleaseTask {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := &batchDataplane.JobReleaseTask{
+		CommandLine:         pointer.To(v.CommandLine),
+		EnvironmentSettings: BatchJobResource{}.expandEnvironmentSettings(v.EnvironmentProperties),
+		ResourceFiles:       expandBatchJobTaskResourceFiles(v.ResourceFile),
+		UserIdentity:        expandBatchJobTaskUserIdentity(v.UserIdentity),
+	}
+
+	if v.Id != "" {
+		result.ID = pointer.To(v.Id)
+	}
+
+	if v.MaxWallClockTime != "" {
+		result.MaxWallClockTime = pointer.To(v.MaxWallClockTime)
+	}
+
+	if v.RetentionTime != "" {
+		result.RetentionTime = pointer.To(v.RetentionTime)
+	}
+
+	return result
+}
+
+func flattenBatchJobReleaseTask(input *batchDataplane.JobReleaseTask) []BatchJobScheduleReleaseTask {
+	if input == nil {
+		return []BatchJobScheduleReleaseTask{}
+	}
+
+	return []BatchJobScheduleReleaseTask{
+		{
+			Id:                    pointer.From(input.ID),
+			CommandLine:           pointer.From(input.CommandLine),
+			EnvironmentProperties: BatchJobResource{}.flattenEnvironmentSettings(input.EnvironmentSettings),
+			ResourceFile:          flattenBatchJobTaskResourceFiles(input.ResourceFiles),
+			UserIdentity:          flattenBatchJobTaskUserIdentity(input.UserIdentity),
+			MaxWallClockTime:      pointer.From(input.MaxWallClockTime),
+			RetentionTime:         pointer.From(input.RetentionTime),
+		},
+	}
+}
+
+func expandBatchJobTaskConstraints(maxWallClockTime, retentionTime string, taskRetryMaximum int64) *batchDataplane.TaskConstraints {
+	result := &batchDataplane.TaskConstraints{
+		MaxTaskRetryCount: pointer.To(int32(taskRetryMaximum)),
+	}
+
+	if maxWallClockTime != "" {
+		result.MaxWallClockTime = pointer.To(maxWallClockTime)
+	}
+
+	if retentionTime != "" {
+		result.RetentionTime = pointer.To(retentionTime)
+	}
+
+	return result
+}
+
+func expandBatchJobTaskResourceFiles(input []BatchJobTaskResourceFile) *[]batchDataplane.ResourceFile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]batchDataplane.ResourceFile, 0, len(input))
+	for _, v := range input {
+		file := batchDataplane.ResourceFile{}
+
+		if v.AutoStorageContainerName != "" {
+			file.AutoStorageContainerName = pointer.To(v.AutoStorageContainerName)
+		}
+		if v.StorageContainerUrl != "" {
+			file.StorageContainerURL = pointer.To(v.StorageContainerUrl)
+		}
+		if v.HttpUrl != "" {
+			file.HTTPURL = pointer.To(v.HttpUrl)
+		}
+		if v.BlobPrefix != "" {
+			file.BlobPrefix = pointer.To(v.BlobPrefix)
+		}
+		if v.FilePath != "" {
+			file.FilePath = pointer.To(v.FilePath)
+		}
+		if v.FileMode != "" {
+			file.FileMode = pointer.To(v.FileMode)
+		}
+		if v.UserAssignedIdentityId != "" {
+			file.IdentityReference = &batchDataplane.ComputeNodeIdentityReference{
+				ResourceID: pointer.To(v.UserAssignedIdentityId),
+			}
+		}
+
+		result = append(result, file)
+	}
+
+	return &result
+}
+
+func flattenBatchJobTaskResourceFiles(input *[]batchDataplane.ResourceFile) []BatchJobTaskResourceFile {
+	result := make([]BatchJobTaskResourceFile, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		file := BatchJobTaskResourceFile{
+			AutoStorageContainerName: pointer.From(v.AutoStorageContainerName),
+			StorageContainerUrl:      pointer.From(v.StorageContainerURL),
+			HttpUrl:                  pointer.From(v.HTTPURL),
+			BlobPrefix:               pointer.From(v.BlobPrefix),
+			FilePath:                 pointer.From(v.FilePath),
+			FileMode:                 pointer.From(v.FileMode),
+		}
+
+		if v.IdentityReference != nil {
+			file.UserAssignedIdentityId = pointer.From(v.IdentityReference.ResourceID)
+		}
+
+		result = append(result, file)
+	}
+
+	return result
+}
+
+func expandBatchJobTaskUserIdentity(input []BatchJobTaskUserIdentity) *batchDataplane.UserIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := &batchDataplane.UserIdentity{}
+
+	if v.UserName != "" {
+		result.UserName = pointer.To(v.UserName)
+	}
+
+	if len(v.AutoUser) > 0 {
+		result.AutoUser = &batchDataplane.AutoUserSpecification{
+			ElevationLevel: batchDataplane.ElevationLevel(v.AutoUser[0].ElevationLevel),
+			Scope:          batchDataplane.AutoUserScope(v.AutoUser[0].Scope),
+		}
+	}
+
+	return result
+}
+
+func flattenBatchJobTaskUserIdentity(input *batchDataplane.UserIdentity) []BatchJobTaskUserIdentity {
+	if input == nil || (input.UserName == nil && input.AutoUser == nil) {
+		return []BatchJobTaskUserIdentity{}
+	}
+
+	result := BatchJobTaskUserIdentity{
+		UserName: pointer.From(input.UserName),
+		AutoUser: []BatchJobTaskAutoUser{},
+	}
+
+	if input.AutoUser != nil {
+		result.AutoUser = []BatchJobTaskAutoUser{
+			{
+				ElevationLevel: string(input.AutoUser.ElevationLevel),
+				Scope:          string(input.AutoUser.Scope),
+			},
+		}
+	}
+
+	return []BatchJobTaskUserIdentity{result}
+}
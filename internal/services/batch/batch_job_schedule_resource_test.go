@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BatchJobScheduleResource struct{}
+
+func TestAccBatchJobSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchJobSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccBatchJobSchedule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchJobSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r BatchJobScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Batch.JobScheduleClient(ctx, batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.Name, "", "", nil, nil, nil, nil, "", "", nil, nil); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r BatchJobScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "test" {
+  name          = "testaccbjs-%d"
+  batch_pool_id = azurerm_batch_pool.test.id
+
+  schedule {
+    recurrence_interval = "P1D"
+  }
+
+  job_manager_task {
+    id           = "manager"
+    command_line = "/bin/bash -c 'echo hello'"
+  }
+}
+`, BatchJobResource{}.template(data), data.RandomInteger)
+}
+
+func (r BatchJobScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "import" {
+  name          = azurerm_batch_job_schedule.test.name
+  batch_pool_id = azurerm_batch_job_schedule.test.batch_pool_id
+
+  schedule {
+    recurrence_interval = "P1D"
+  }
+
+  job_manager_task {
+    id           = "manager"
+    command_line = "/bin/bash -c 'echo hello'"
+  }
+}
+`, r.basic(data))
+}
+
+func (r BatchJobScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "test" {
+  name                   = "testaccbjs-%d"
+  batch_pool_id          = azurerm_batch_pool.test.id
+  priority               = 10
+  task_retry_maximum     = 2
+  max_wall_clock_time    = "PT2H"
+  max_parallel_tasks     = 4
+  uses_task_dependencies = true
+  on_all_tasks_complete  = "terminatejob"
+
+  schedule {
+    recurrence_interval = "PT12H"
+    start_window        = "PT1H"
+    do_not_run_until    = "2099-01-01T00:00:00Z"
+  }
+
+  common_environment_properties = {
+    env = "test"
+  }
+
+  job_manager_task {
+    id                     = "manager"
+    display_name           = "Job Manager"
+    command_line           = "/bin/bash -c 'echo $MESSAGE'"
+    max_wall_clock_time    = "PT1H"
+    retention_time         = "P1D"
+    task_retry_maximum     = 1
+    kill_job_on_completion = false
+    run_exclusive          = false
+
+    environment_properties = {
+      MESSAGE = "hello"
+    }
+
+    resource_file {
+      http_url  = "https://raw.githubusercontent.com/hashicorp/terraform-provider-azurerm/main/README.md"
+      file_path = "README.md"
+    }
+
+    user_identity {
+      auto_user {
+        elevation_level = "admin"
+        scope           = "task"
+      }
+    }
+  }
+
+  job_preparation_task {
+    id                                 = "prepare"
+    command_line                       = "/bin/bash -c 'mkdir -p $AZ_BATCH_NODE_SHARED_DIR/work'"
+    wait_for_success                   = true
+    rerun_on_node_reboot_after_success = false
+
+    user_identity {
+      auto_user {
+        scope = "pool"
+      }
+    }
+  }
+
+  job_release_task {
+    id                  = "release"
+    command_line        = "/bin/bash -c 'rm -rf $AZ_BATCH_NODE_SHARED_DIR/work'"
+    max_wall_clock_time = "PT10M"
+    retention_time      = "PT12H"
+  }
+
+  metadata = {
+    owner = "terraform"
+  }
+}
+`, BatchJobResource{}.template(data), data.RandomInteger)
+}
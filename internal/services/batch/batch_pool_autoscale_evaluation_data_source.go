@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/pool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	batchDataplane "github.com/jackofallops/kermit/sdk/batch/2022-01.15.0/batch"
+)
+
+type BatchPoolAutoScaleEvaluationDataSource struct{}
+
+var _ sdk.DataSource = BatchPoolAutoScaleEvaluationDataSource{}
+
+type BatchPoolAutoScaleEvaluationModel struct {
+	BatchPoolId string                              `tfschema:"batch_pool_id"`
+	Formula     string                              `tfschema:"formula"`
+	Results     string                              `tfschema:"results"`
+	Variables   map[string]string                   `tfschema:"variables"`
+	Error       []BatchPoolAutoScaleEvaluationError `tfschema:"error"`
+	Timestamp   string                              `tfschema:"timestamp"`
+}
+
+type BatchPoolAutoScaleEvaluationError struct {
+	Code    string            `tfschema:"code"`
+	Message string            `tfschema:"message"`
+	Values  map[string]string `tfschema:"values"`
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"batch_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: pool.ValidatePoolID,
+		},
+
+		"formula": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"results": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"variables": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"error": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"timestamp": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) ModelObject() interface{} {
+	return &BatchPoolAutoScaleEvaluationModel{}
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) ResourceType() string {
+	return "azurerm_batch_pool_autoscale_evaluation"
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model BatchPoolAutoScaleEvaluationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := pool.ParsePoolID(model.BatchPoolId)
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.BatchAccountName)
+			client, err := metadata.Client.Batch.PoolDataPlaneClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			deadline, _ := ctx.Deadline()
+			now := time.Now()
+			timeout := deadline.Sub(now)
+			params := batchDataplane.PoolEvaluateAutoScaleParameter{
+				AutoScaleFormula: pointer.To(model.Formula),
+			}
+			resp, err := client.EvaluateAutoScale(ctx, poolId.PoolName, params, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now})
+			if err != nil {
+				return fmt.Errorf("evaluating the autoscale formula for %s: %+v", poolId, err)
+			}
+
+			model.Results = pointer.From(resp.Results)
+			model.Variables = parseBatchPoolAutoScaleResults(model.Results)
+			model.Error = flattenBatchPoolAutoScaleRunError(resp.Error)
+			if resp.Timestamp != nil {
+				model.Timestamp = resp.Timestamp.Format(time.RFC3339)
+			}
+
+			metadata.SetID(poolId)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// parseBatchPoolAutoScaleResults splits the evaluation results, returned in the form `$variable1=value1;$variable2=value2`,
+// into a map keyed by variable name
+func parseBatchPoolAutoScaleResults(input string) map[string]string {
+	result := make(map[string]string)
+	for _, assignment := range strings.Split(input, ";") {
+		name, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			continue
+		}
+		result[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return result
+}
+
+func flattenBatchPoolAutoScaleRunError(input *batchDataplane.AutoScaleRunError) []BatchPoolAutoScaleEvaluationError {
+	if input == nil {
+		return []BatchPoolAutoScaleEvaluationError{}
+	}
+
+	values := make(map[string]string)
+	if input.Values != nil {
+		for _, v := range *input.Values {
+			if v.Name == nil {
+				continue
+			}
+			values[*v.Name] = pointer.From(v.Value)
+		}
+	}
+
+	return []BatchPoolAutoScaleEvaluationError{
+		{
+			Code:    pointer.From(input.Code),
+			Message: pointer.From(input.Message),
+			Values:  values,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type BatchPoolAutoScaleEvaluationDataSource struct{}
+
+func TestAccBatchPoolAutoScaleEvaluationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_batch_pool_autoscale_evaluation", "test")
+	r := BatchPoolAutoScaleEvaluationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results").Exists(),
+				check.That(data.ResourceName).Key("variables.$TargetDedicatedNodes").HasValue("2"),
+				check.That(data.ResourceName).Key("error.#").HasValue("0"),
+				check.That(data.ResourceName).Key("timestamp").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccBatchPoolAutoScaleEvaluationDataSource_invalidFormula(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_batch_pool_autoscale_evaluation", "test")
+	r := BatchPoolAutoScaleEvaluationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.invalidFormula(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("error.#").HasValue("1"),
+				check.That(data.ResourceName).Key("error.0.code").Exists(),
+				check.That(data.ResourceName).Key("error.0.message").Exists(),
+			),
+		},
+	})
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_batch_pool_autoscale_evaluation" "test" {
+  batch_pool_id = azurerm_batch_pool.test.id
+  formula       = "$TargetDedicatedNodes = max(1, 2); $NodeDeallocationOption = taskcompletion;"
+}
+`, r.template(data))
+}
+
+func (r BatchPoolAutoScaleEvaluationDataSource) invalidFormula(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_batch_pool_autoscale_evaluation" "test" {
+  batch_pool_id = azurerm_batch_pool.test.id
+  formula       = "$TargetDedicatedNodes = undefinedVariable * 2;"
+}
+`, r.template(data))
+}
+
+func (BatchPoolAutoScaleEvaluationDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-batch-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  vm_size             = "Standard_A1"
+  node_agent_sku_id   = "batch.node.ubuntu 22.04"
+
+  auto_scale {
+    evaluation_interval = "PT15M"
+    formula             = "$TargetDedicatedNodes = 0;"
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -60,23 +60,55 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 }
 
 func (r *Client) JobClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.JobClient, error) {
+	endpoint, err := r.accountEndpoint(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the client since we'll manipulate its BatchURL
+	c := batchDataplane.NewJobClient(endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
+
+func (r *Client) JobScheduleClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.JobScheduleClient, error) {
+	endpoint, err := r.accountEndpoint(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	c := batchDataplane.NewJobScheduleClient(endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
+
+// PoolDataPlaneClient returns a client for the Batch data plane pool operations (e.g. evaluating an autoscale formula),
+// as opposed to PoolClient which manages pools through Resource Manager.
+func (r *Client) PoolDataPlaneClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.PoolClient, error) {
+	endpoint, err := r.accountEndpoint(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	c := batchDataplane.NewPoolClient(endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
+
+func (r *Client) accountEndpoint(ctx context.Context, accountId batchaccount.BatchAccountId) (string, error) {
 	// Retrieve the batch account to find the batch account endpoint
-	accountClient := r.AccountClient
-	account, err := accountClient.Get(ctx, accountId)
+	account, err := r.AccountClient.Get(ctx, accountId)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %s: %v", accountId, err)
+		return "", fmt.Errorf("retrieving %s: %v", accountId, err)
 	}
 
 	endpoint := ""
-	if account.Model != nil && account.Model.Properties != nil {
+	if account.Model != nil && account.Model.Properties != nil && account.Model.Properties.AccountEndpoint != nil {
 		endpoint = "https://" + *account.Model.Properties.AccountEndpoint
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("retrieving %s: `properties.AccountEndpoint` was empty", accountId)
+		return "", fmt.Errorf("retrieving %s: `properties.AccountEndpoint` was empty", accountId)
 	}
 
-	// Copy the client since we'll manipulate its BatchURL
-	c := batchDataplane.NewJobClient(endpoint)
-	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
-	return &c, nil
+	return endpoint, nil
 }
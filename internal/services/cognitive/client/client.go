@@ -8,12 +8,16 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountsClient    *cognitiveservicesaccounts.CognitiveServicesAccountsClient
-	DeploymentsClient *deployments.DeploymentsClient
+	AccountsClient      *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient   *deployments.DeploymentsClient
+	RaiBlocklistsClient *raiblocklists.RaiBlocklistsClient
+	RaiPoliciesClient   *raipolicies.RaiPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -28,8 +32,23 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building Deployments client: %+v", err)
 	}
 	o.Configure(deploymentsClient.Client, o.Authorizers.ResourceManager)
+
+	raiBlocklistsClient, err := raiblocklists.NewRaiBlocklistsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RaiBlocklists client: %+v", err)
+	}
+	o.Configure(raiBlocklistsClient.Client, o.Authorizers.ResourceManager)
+
+	raiPoliciesClient, err := raipolicies.NewRaiPoliciesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RaiPolicies client: %+v", err)
+	}
+	o.Configure(raiPoliciesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AccountsClient:    accountsClient,
-		DeploymentsClient: deploymentsClient,
+		AccountsClient:      accountsClient,
+		DeploymentsClient:   deploymentsClient,
+		RaiBlocklistsClient: raiBlocklistsClient,
+		RaiPoliciesClient:   raiPoliciesClient,
 	}, nil
 }
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveRaiBlocklistModel struct {
+	Name               string                           `tfschema:"name"`
+	CognitiveAccountId string                           `tfschema:"cognitive_account_id"`
+	Description        string                           `tfschema:"description"`
+	Item               []cognitiveRaiBlocklistItemModel `tfschema:"item"`
+}
+
+type cognitiveRaiBlocklistItemModel struct {
+	Name         string `tfschema:"name"`
+	Pattern      string `tfschema:"pattern"`
+	RegexEnabled bool   `tfschema:"regex_enabled"`
+}
+
+type CognitiveRaiBlocklistResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveRaiBlocklistResource{}
+
+func (r CognitiveRaiBlocklistResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_blocklist"
+}
+
+func (r CognitiveRaiBlocklistResource) ModelObject() interface{} {
+	return &cognitiveRaiBlocklistModel{}
+}
+
+func (r CognitiveRaiBlocklistResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raiblocklists.ValidateRaiBlocklistID
+}
+
+func (r CognitiveRaiBlocklistResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"item": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"pattern": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+
+					"regex_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveRaiBlocklistResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveRaiBlocklistResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiBlocklistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			id := raiblocklists.NewRaiBlocklistID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := raiblocklists.RaiBlocklist{
+				Properties: &raiblocklists.RaiBlocklistProperties{},
+			}
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			for _, item := range model.Item {
+				if err := createOrUpdateCognitiveRaiBlocklistItem(ctx, client, id, item); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveRaiBlocklistResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := cognitiveRaiBlocklistModel{
+				Name:               id.RaiBlocklistName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Description = pointer.From(model.Properties.Description)
+			}
+
+			items, err := client.RaiBlocklistItemsListComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing items for %s: %+v", *id, err)
+			}
+
+			state.Item = make([]cognitiveRaiBlocklistItemModel, 0)
+			for _, item := range items.Items {
+				v := cognitiveRaiBlocklistItemModel{
+					Name: pointer.From(item.Name),
+				}
+				if props := item.Properties; props != nil {
+					v.Pattern = pointer.From(props.Pattern)
+					v.RegexEnabled = pointer.From(props.IsRegex)
+				}
+				state.Item = append(state.Item, v)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveRaiBlocklistResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiBlocklistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if metadata.ResourceData.HasChange("description") {
+				resp, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if resp.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+
+				payload := *resp.Model
+				if payload.Properties == nil {
+					payload.Properties = &raiblocklists.RaiBlocklistProperties{}
+				}
+				payload.Properties.Description = pointer.To(model.Description)
+
+				if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("item") {
+				oldRaw, _ := metadata.ResourceData.GetChange("item")
+				existing := make(map[string]cognitiveRaiBlocklistItemModel)
+				for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+					v := raw.(map[string]interface{})
+					existing[v["name"].(string)] = cognitiveRaiBlocklistItemModel{
+						Name:         v["name"].(string),
+						Pattern:      v["pattern"].(string),
+						RegexEnabled: v["regex_enabled"].(bool),
+					}
+				}
+
+				for _, item := range model.Item {
+					if current, ok := existing[item.Name]; !ok || current != item {
+						if err := createOrUpdateCognitiveRaiBlocklistItem(ctx, client, *id, item); err != nil {
+							return err
+						}
+					}
+					delete(existing, item.Name)
+				}
+
+				for name := range existing {
+					itemId := raiblocklists.NewRaiBlocklistItemID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.RaiBlocklistName, name)
+					if err := client.RaiBlocklistItemsDeleteThenPoll(ctx, itemId); err != nil {
+						return fmt.Errorf("deleting %s: %+v", itemId, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveRaiBlocklistResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func createOrUpdateCognitiveRaiBlocklistItem(ctx context.Context, client *raiblocklists.RaiBlocklistsClient, blocklistId raiblocklists.RaiBlocklistId, item cognitiveRaiBlocklistItemModel) error {
+	itemId := raiblocklists.NewRaiBlocklistItemID(blocklistId.SubscriptionId, blocklistId.ResourceGroupName, blocklistId.AccountName, blocklistId.RaiBlocklistName, item.Name)
+	payload := raiblocklists.RaiBlocklistItem{
+		Properties: &raiblocklists.RaiBlocklistItemProperties{
+			Pattern: pointer.To(item.Pattern),
+			IsRegex: pointer.To(item.RegexEnabled),
+		},
+	}
+
+	if _, err := client.RaiBlocklistItemsCreateOrUpdate(ctx, itemId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", itemId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveRaiPolicyModel struct {
+	Name               string                                 `tfschema:"name"`
+	CognitiveAccountId string                                 `tfschema:"cognitive_account_id"`
+	BasePolicyName     string                                 `tfschema:"base_policy_name"`
+	ContentFilter      []cognitiveRaiPolicyContentFilterModel `tfschema:"content_filter"`
+	CustomBlockList    []cognitiveRaiPolicyBlockListModel     `tfschema:"custom_block_list"`
+	Mode               string                                 `tfschema:"mode"`
+	Tags               map[string]string                      `tfschema:"tags"`
+}
+
+type cognitiveRaiPolicyContentFilterModel struct {
+	Name              string `tfschema:"name"`
+	Source            string `tfschema:"source"`
+	FilterEnabled     bool   `tfschema:"filter_enabled"`
+	BlockEnabled      bool   `tfschema:"block_enabled"`
+	SeverityThreshold string `tfschema:"severity_threshold"`
+}
+
+type cognitiveRaiPolicyBlockListModel struct {
+	RaiBlocklistId string `tfschema:"rai_blocklist_id"`
+	Source         string `tfschema:"source"`
+	BlockEnabled   bool   `tfschema:"block_enabled"`
+}
+
+type CognitiveRaiPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveRaiPolicyResource{}
+
+func (r CognitiveRaiPolicyResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_policy"
+}
+
+func (r CognitiveRaiPolicyResource) ModelObject() interface{} {
+	return &cognitiveRaiPolicyModel{}
+}
+
+func (r CognitiveRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raipolicies.ValidateRaiPolicyID
+}
+
+func (r CognitiveRaiPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"base_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Hate",
+							"Sexual",
+							"Violence",
+							"SelfHarm",
+							"Profanity",
+							"Jailbreak",
+							"Indirect Attack",
+							"Protected Material Text",
+							"Protected Material Code",
+						}, false),
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+
+					"filter_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"severity_threshold": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForContentLevel(), false),
+					},
+				},
+			},
+		},
+
+		"custom_block_list": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"rai_blocklist_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: raiblocklists.ValidateRaiBlocklistID,
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(raipolicies.RaiPolicyModeDefault),
+			ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyMode(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CognitiveRaiPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveRaiPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandCognitiveRaiPolicy(*accountId, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveRaiPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := cognitiveRaiPolicyModel{
+				Name:               id.RaiPolicyName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.BasePolicyName = pointer.From(props.BasePolicyName)
+					state.Mode = string(pointer.From(props.Mode))
+					state.ContentFilter = flattenCognitiveRaiPolicyContentFilters(props.ContentFilters)
+					state.CustomBlockList = flattenCognitiveRaiPolicyBlockLists(*id, props.CustomBlocklists)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveRaiPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			// the API only supports replacing the whole policy
+			payload, err := expandCognitiveRaiPolicy(accountId, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveRaiPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCognitiveRaiPolicy(accountId cognitiveservicesaccounts.AccountId, model cognitiveRaiPolicyModel) (*raipolicies.RaiPolicy, error) {
+	contentFilters := make([]raipolicies.RaiPolicyContentFilter, 0)
+	for _, v := range model.ContentFilter {
+		filter := raipolicies.RaiPolicyContentFilter{
+			Name:     pointer.To(v.Name),
+			Source:   pointer.To(raipolicies.RaiPolicyContentSource(v.Source)),
+			Enabled:  pointer.To(v.FilterEnabled),
+			Blocking: pointer.To(v.BlockEnabled),
+		}
+		if v.SeverityThreshold != "" {
+			filter.SeverityThreshold = pointer.To(raipolicies.ContentLevel(v.SeverityThreshold))
+		}
+		contentFilters = append(contentFilters, filter)
+	}
+
+	blockLists := make([]raipolicies.CustomBlocklistConfig, 0)
+	for _, v := range model.CustomBlockList {
+		blocklistId, err := raiblocklists.ParseRaiBlocklistID(v.RaiBlocklistId)
+		if err != nil {
+			return nil, err
+		}
+		if blocklistId.SubscriptionId != accountId.SubscriptionId || blocklistId.ResourceGroupName != accountId.ResourceGroupName || blocklistId.AccountName != accountId.AccountName {
+			return nil, fmt.Errorf("the `rai_blocklist_id` %q must be within the %s", v.RaiBlocklistId, accountId)
+		}
+
+		blockLists = append(blockLists, raipolicies.CustomBlocklistConfig{
+			BlocklistName: pointer.To(blocklistId.RaiBlocklistName),
+			Source:        pointer.To(raipolicies.RaiPolicyContentSource(v.Source)),
+			Blocking:      pointer.To(v.BlockEnabled),
+		})
+	}
+
+	return &raipolicies.RaiPolicy{
+		Properties: &raipolicies.RaiPolicyProperties{
+			BasePolicyName:   pointer.To(model.BasePolicyName),
+			ContentFilters:   pointer.To(contentFilters),
+			CustomBlocklists: pointer.To(blockLists),
+			Mode:             pointer.To(raipolicies.RaiPolicyMode(model.Mode)),
+		},
+		Tags: pointer.To(model.Tags),
+	}, nil
+}
+
+func flattenCognitiveRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []cognitiveRaiPolicyContentFilterModel {
+	output := make([]cognitiveRaiPolicyContentFilterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, cognitiveRaiPolicyContentFilterModel{
+			Name:              pointer.From(v.Name),
+			Source:            string(pointer.From(v.Source)),
+			FilterEnabled:     pointer.From(v.Enabled),
+			BlockEnabled:      pointer.From(v.Blocking),
+			SeverityThreshold: string(pointer.From(v.SeverityThreshold)),
+		})
+	}
+	return output
+}
+
+func flattenCognitiveRaiPolicyBlockLists(id raipolicies.RaiPolicyId, input *[]raipolicies.CustomBlocklistConfig) []cognitiveRaiPolicyBlockListModel {
+	output := make([]cognitiveRaiPolicyBlockListModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, cognitiveRaiPolicyBlockListModel{
+			RaiBlocklistId: raiblocklists.NewRaiBlocklistID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, pointer.From(v.BlocklistName)).ID(),
+			Source:         string(pointer.From(v.Source)),
+			BlockEnabled:   pointer.From(v.Blocking),
+		})
+	}
+	return output
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CognitiveRaiPolicyTestResource struct{}
+
+func TestAccCognitiveRaiPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCognitiveRaiPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCognitiveRaiPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CognitiveRaiPolicyTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := raipolicies.ParseRaiPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cognitive.RaiPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r CognitiveRaiPolicyTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_policy" "test" {
+  name                 = "acctest-crp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  base_policy_name     = "Microsoft.Default"
+
+%s
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger, r.contentFilters("Medium"))
+}
+
+func (r CognitiveRaiPolicyTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_policy" "import" {
+  name                 = azurerm_cognitive_account_rai_policy.test.name
+  cognitive_account_id = azurerm_cognitive_account_rai_policy.test.cognitive_account_id
+  base_policy_name     = azurerm_cognitive_account_rai_policy.test.base_policy_name
+
+%s
+}
+`, r.basic(data), r.contentFilters("Medium"))
+}
+
+func (r CognitiveRaiPolicyTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_blocklist" "test" {
+  name                 = "acctest-crb-%[2]d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+
+  item {
+    name    = "competitor"
+    pattern = "contoso"
+  }
+}
+
+resource "azurerm_cognitive_account_rai_policy" "test" {
+  name                 = "acctest-crp-%[2]d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  base_policy_name     = "Microsoft.Default"
+  mode                 = "Asynchronous_filter"
+
+%[3]s
+
+  content_filter {
+    name           = "Jailbreak"
+    source         = "Prompt"
+    filter_enabled = true
+    block_enabled  = true
+  }
+
+  content_filter {
+    name           = "Protected Material Text"
+    source         = "Completion"
+    filter_enabled = true
+    block_enabled  = true
+  }
+
+  content_filter {
+    name           = "Protected Material Code"
+    source         = "Completion"
+    filter_enabled = true
+    block_enabled  = false
+  }
+
+  custom_block_list {
+    rai_blocklist_id = azurerm_cognitive_account_rai_blocklist.test.id
+    source           = "Prompt"
+    block_enabled    = true
+  }
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger, r.contentFilters("High"))
+}
+
+// contentFilters returns the severity based content filters for both prompts and completions, which are required by the API
+func (CognitiveRaiPolicyTestResource) contentFilters(severityThreshold string) string {
+	config := ""
+	for _, source := range []string{"Prompt", "Completion"} {
+		for _, name := range []string{"Hate", "Sexual", "Violence", "SelfHarm"} {
+			config += fmt.Sprintf(`
+  content_filter {
+    name               = %q
+    source             = %q
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = %q
+  }
+`, name, source, severityThreshold)
+		}
+	}
+	return config
+}
@@ -56,5 +56,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AzureAIServicesResource{},
 		CognitiveDeploymentResource{},
+		CognitiveRaiBlocklistResource{},
+		CognitiveRaiPolicyResource{},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/containerapps"
+	managedenvironments_v2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
@@ -60,9 +61,20 @@ func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Sche
 			ValidateFunc: managedenvironments.ValidateCertificateID,
 		},
 
+		"container_app_environment_managed_certificate_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"container_app_environment_certificate_id"},
+			ValidateFunc:  managedenvironments.ValidateManagedCertificateID,
+			Description:   "The ID of a Container App Environment Managed Certificate to bind to this Custom Domain.",
+		},
+
 		"certificate_binding_type": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForBindingType(), false),
 			Description:  "The Binding type. Possible values include `Disabled` and `SniEnabled`.",
@@ -71,12 +83,7 @@ func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Sche
 }
 
 func (a ContainerAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{
-		"container_app_environment_managed_certificate_id": {
-			Type:     pluginsdk.TypeString,
-			Computed: true,
-		},
-	}
+	return map[string]*pluginsdk.Schema{}
 }
 
 func (a ContainerAppCustomDomainResource) ModelObject() interface{} {
@@ -108,6 +115,21 @@ func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			var managedCertificateId *managedenvironments.ManagedCertificateId
+			if model.ManagedCertificateId != "" {
+				managedCertificateId, err = managedenvironments.ParseManagedCertificateID(model.ManagedCertificateId)
+				if err != nil {
+					return err
+				}
+
+				// a Managed Certificate validated over TXT is only issued once the `validation_token` has been published,
+				// which can happen after the certificate has been created - so we wait for it to be issued before binding it
+				issuedId := managedenvironments_v2024_03_01.NewManagedCertificateID(managedCertificateId.SubscriptionId, managedCertificateId.ResourceGroupName, managedCertificateId.ManagedEnvironmentName, managedCertificateId.ManagedCertificateName)
+				if err := waitForManagedCertificateToBeIssued(ctx, metadata.Client.ContainerApps.ManagedEnvironmentClient, issuedId); err != nil {
+					return err
+				}
+			}
+
 			locks.ByID(containerAppId.ID())
 			defer locks.UnlockByID(containerAppId.ID())
 
@@ -169,6 +191,14 @@ func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 				customDomain.BindingType = pointer.To(containerapps.BindingType(model.BindingType))
 			}
 
+			if managedCertificateId != nil {
+				customDomain.CertificateId = pointer.To(managedCertificateId.ID())
+				customDomain.BindingType = pointer.To(containerapps.BindingTypeSniEnabled)
+				if model.BindingType != "" {
+					customDomain.BindingType = pointer.To(containerapps.BindingType(model.BindingType))
+				}
+			}
+
 			customDomains = append(customDomains, customDomain)
 
 			containerApp.Model.Properties.Configuration.Ingress.CustomDomains = pointer.To(customDomains)
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+type ContainerAppEnvironmentManagedCertificateModel struct {
+	Name                    string                 `tfschema:"name"`
+	ManagedEnvironmentId    string                 `tfschema:"container_app_environment_id"`
+	SubjectName             string                 `tfschema:"subject_name"`
+	DomainControlValidation string                 `tfschema:"domain_control_validation"`
+	Tags                    map[string]interface{} `tfschema:"tags"`
+
+	// Read Only
+	ValidationToken   string `tfschema:"validation_token"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentManagedCertificateResource{}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentManagedCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_managed_certificate"
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedenvironments.ValidateManagedCertificateID
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName,
+			Description:  "The name of the Container Apps Environment Managed Certificate.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to configure this Managed Certificate on.",
+		},
+
+		"subject_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The hostname to issue the Managed Certificate for.",
+		},
+
+		"domain_control_validation": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(managedenvironments.ManagedCertificateDomainControlValidationHTTP),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(managedenvironments.ManagedCertificateDomainControlValidationHTTP),
+				string(managedenvironments.ManagedCertificateDomainControlValidationTXT),
+			}, false),
+			Description: "The method used to validate ownership of the domain. Possible values are `HTTP` and `TXT`.",
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"validation_token": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The token to publish in the `_dnsauth` TXT record when using `TXT` domain control validation.",
+		},
+
+		"provisioning_state": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The provisioning state of the Managed Certificate.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var cert ContainerAppEnvironmentManagedCertificateModel
+
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(cert.ManagedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := managedenvironments.NewManagedCertificateID(metadata.Client.Account.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, cert.Name)
+
+			existing, err := client.ManagedCertificatesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			env, err := client.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+
+			if env.Model == nil {
+				return fmt.Errorf("reading %s for %s: model was nil", *envId, id)
+			}
+
+			validationMethod := managedenvironments.ManagedCertificateDomainControlValidation(cert.DomainControlValidation)
+
+			model := managedenvironments.ManagedCertificate{
+				Location: env.Model.Location,
+				Name:     pointer.To(id.ManagedCertificateName),
+				Properties: &managedenvironments.ManagedCertificateProperties{
+					SubjectName:             pointer.To(cert.SubjectName),
+					DomainControlValidation: pointer.To(validationMethod),
+				},
+				Tags: tags.Expand(cert.Tags),
+			}
+
+			if err := client.ManagedCertificatesCreateOrUpdateThenPoll(ctx, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			// A TXT validated certificate can only be issued once the `validation_token` has been published in DNS,
+			// which requires the token exported by this resource, so we only wait for issuance when validating over HTTP.
+			if validationMethod == managedenvironments.ManagedCertificateDomainControlValidationHTTP {
+				if err := waitForManagedCertificateToBeIssued(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			id, err := managedenvironments.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ManagedCertificatesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			var state ContainerAppEnvironmentManagedCertificateModel
+
+			state.Name = id.ManagedCertificateName
+			state.ManagedEnvironmentId = managedenvironments.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID()
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = pointer.From(props.SubjectName)
+					state.DomainControlValidation = string(pointer.From(props.DomainControlValidation))
+					state.ValidationToken = pointer.From(props.ValidationToken)
+					state.ProvisioningState = string(pointer.From(props.ProvisioningState))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			id, err := managedenvironments.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ManagedCertificatesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var cert ContainerAppEnvironmentManagedCertificateModel
+
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			id, err := managedenvironments.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				patch := managedenvironments.ManagedCertificatePatch{
+					Tags: tags.Expand(cert.Tags),
+				}
+
+				if _, err = client.ManagedCertificatesUpdate(ctx, *id, patch); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// waitForManagedCertificateToBeIssued waits for the Managed Certificate to be issued, returning an error should this fail
+func waitForManagedCertificateToBeIssued(ctx context.Context, client *managedenvironments.ManagedEnvironmentsClient, id managedenvironments.ManagedCertificateId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(managedenvironments.CertificateProvisioningStatePending)},
+		Target:     []string{string(managedenvironments.CertificateProvisioningStateSucceeded)},
+		Refresh:    managedCertificateProvisioningStateRefreshFunc(ctx, client, id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be issued: %+v", id, err)
+	}
+
+	return nil
+}
+
+func managedCertificateProvisioningStateRefreshFunc(ctx context.Context, client *managedenvironments.ManagedEnvironmentsClient, id managedenvironments.ManagedCertificateId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ManagedCertificatesGet(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, "", fmt.Errorf("polling for %s: `properties` was nil", id)
+		}
+
+		props := resp.Model.Properties
+		state := pointer.From(props.ProvisioningState)
+		if state == managedenvironments.CertificateProvisioningStateFailed {
+			return resp, string(state), fmt.Errorf("issuing %s failed: %s", id, pointer.From(props.Error))
+		}
+
+		return resp, string(state), nil
+	}
+}
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+func TestAccContainerAppEnvironmentManagedCertificate_txt(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_updateTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.txtTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_http(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.http(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_customDomainBinding(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customDomainBinding(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_container_app_custom_domain.test").Key("certificate_binding_type").HasValue("SniEnabled"),
+				check.That("azurerm_container_app_custom_domain.test").Key("container_app_environment_managed_certificate_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the binding type defaulted by the API mustn't cause the Custom Domain to be replaced
+			Config:   r.customDomainBinding(data),
+			PlanOnly: true,
+		},
+		{
+			// Custom Domains created before `container_app_environment_managed_certificate_id` could be specified
+			// have this value in the state but not in the configuration, which mustn't cause these to be replaced
+			Config:   r.customDomainBindingExisting(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedenvironments.ParseManagedCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ManagedEnvironmentClient.ManagedCertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) txt(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = "acctest%[2]d.example.com"
+  domain_control_validation    = "TXT"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_managed_certificate" "import" {
+  name                         = azurerm_container_app_environment_managed_certificate.test.name
+  container_app_environment_id = azurerm_container_app_environment_managed_certificate.test.container_app_environment_id
+  subject_name                 = azurerm_container_app_environment_managed_certificate.test.subject_name
+  domain_control_validation    = azurerm_container_app_environment_managed_certificate.test.domain_control_validation
+}
+`, r.txt(data))
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) txtTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = "acctest%[2]d.example.com"
+  domain_control_validation    = "TXT"
+
+  tags = {
+    env = "testAcc"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) http(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+
+  ingress {
+    allow_insecure_connections = false
+    external_enabled           = true
+    target_port                = 5000
+    transport                  = "http"
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "asuid.containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app.test.custom_domain_verification_id
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_container_app.test.ingress[0].fqdn
+}
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name             = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  container_app_id = azurerm_container_app.test.id
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = azurerm_container_app_custom_domain.test.name
+  domain_control_validation    = "HTTP"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DNS_ZONE"), os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP"))
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) customDomainBinding(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name                                             = azurerm_container_app_environment_managed_certificate.test.subject_name
+  container_app_id                                 = azurerm_container_app.test.id
+  container_app_environment_managed_certificate_id = azurerm_container_app_environment_managed_certificate.test.id
+
+  depends_on = [
+    azurerm_dns_txt_record.test,
+    azurerm_dns_txt_record.validation,
+  ]
+}
+`, r.customDomainBindingTemplate(data))
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) customDomainBindingExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name             = azurerm_container_app_environment_managed_certificate.test.subject_name
+  container_app_id = azurerm_container_app.test.id
+
+  depends_on = [
+    azurerm_dns_txt_record.test,
+    azurerm_dns_txt_record.validation,
+  ]
+}
+`, r.customDomainBindingTemplate(data))
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) customDomainBindingTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+
+  ingress {
+    allow_insecure_connections = false
+    external_enabled           = true
+    target_port                = 5000
+    transport                  = "http"
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "asuid.containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app.test.custom_domain_verification_id
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_container_app.test.ingress[0].fqdn
+}
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  domain_control_validation    = "TXT"
+}
+
+resource "azurerm_dns_txt_record" "validation" {
+  name                = "_dnsauth.containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app_environment_managed_certificate.test.validation_token
+  }
+}
+
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DNS_ZONE"), os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP"))
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CAEnv-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestCAEnv-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_container_app_environment" "test" {
+  name                       = "acctest-CAEnv%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2025-03-02-preview/managednamespaces"
 )
 
 type Client struct {
@@ -36,6 +37,7 @@ type Client struct {
 	KubernetesClustersClient                    *managedclusters.ManagedClustersClient
 	KubernetesExtensionsClient                  *extensions.ExtensionsClient
 	KubernetesFluxConfigurationClient           *fluxconfiguration.FluxConfigurationClient
+	KubernetesManagedNamespacesClient           *managednamespaces.ManagedNamespacesClient
 	MaintenanceConfigurationsClient             *maintenanceconfigurations.MaintenanceConfigurationsClient
 	ServicesClient                              *containerservices.ContainerServicesClient
 	SnapshotClient                              *snapshots.SnapshotsClient
@@ -100,6 +102,12 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(fluxConfigurationClient.Client, o.Authorizers.ResourceManager)
 
+	managedNamespacesClient, err := managednamespaces.NewManagedNamespacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Namespaces Client: %+v", err)
+	}
+	o.Configure(managedNamespacesClient.Client, o.Authorizers.ResourceManager)
+
 	agentPoolsClient, err := agentpools.NewAgentPoolsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Agent Pools Client: %+v", err)
@@ -135,6 +143,7 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		KubernetesClustersClient:                    kubernetesClustersClient,
 		KubernetesExtensionsClient:                  kubernetesExtensionsClient,
 		KubernetesFluxConfigurationClient:           fluxConfigurationClient,
+		KubernetesManagedNamespacesClient:           managedNamespacesClient,
 		MaintenanceConfigurationsClient:             maintenanceConfigurationsClient,
 		ServicesClient:                              servicesClient,
 		SnapshotClient:                              snapshotClient,
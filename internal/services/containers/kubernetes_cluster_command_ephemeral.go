@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-05-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &KubernetesClusterCommandEphemeralResource{}
+
+func NewKubernetesClusterCommandEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterCommandEphemeralResource{}
+}
+
+type KubernetesClusterCommandEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KubernetesClusterCommandEphemeralResourceModel struct {
+	KubernetesClusterID types.String `tfsdk:"kubernetes_cluster_id"`
+	Command             types.String `tfsdk:"command"`
+	ContextBase64       types.String `tfsdk:"context_base64"`
+	ClusterToken        types.String `tfsdk:"cluster_token"`
+	ExitCode            types.Int64  `tfsdk:"exit_code"`
+	Logs                types.String `tfsdk:"logs"`
+	ProvisioningState   types.String `tfsdk:"provisioning_state"`
+	Reason              types.String `tfsdk:"reason"`
+	StartedAt           types.String `tfsdk:"started_at"`
+	FinishedAt          types.String `tfsdk:"finished_at"`
+}
+
+func (e *KubernetesClusterCommandEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_command"
+}
+
+func (e *KubernetesClusterCommandEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KubernetesClusterCommandEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"command": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"context_base64": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsBase64,
+					},
+				},
+			},
+
+			"cluster_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"exit_code": schema.Int64Attribute{
+				Computed: true,
+			},
+
+			"logs": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"provisioning_state": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"reason": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"started_at": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"finished_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KubernetesClusterCommandEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.KubernetesClustersClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	var data KubernetesClusterCommandEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	payload := managedclusters.RunCommandRequest{
+		Command: data.Command.ValueString(),
+	}
+
+	if v := data.ContextBase64.ValueString(); v != "" {
+		payload.Context = pointer.To(v)
+	}
+
+	if v := data.ClusterToken.ValueString(); v != "" {
+		payload.ClusterToken = pointer.To(v)
+	}
+
+	result, err := client.RunCommand(ctx, *id, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("running command on %s", id), err)
+		return
+	}
+
+	// the command is executed asynchronously in a pod on the cluster, the final poll returns the command result
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("waiting for command to complete on %s", id), err)
+		return
+	}
+
+	var commandResult managedclusters.RunCommandResult
+	if err := result.Poller.FinalResult(&commandResult); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving command result from %s", id), err)
+		return
+	}
+
+	props := commandResult.Properties
+	if props == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving command result from %s", id), fmt.Errorf("`properties` was nil"))
+		return
+	}
+
+	data.ExitCode = types.Int64Value(pointer.From(props.ExitCode))
+	data.Logs = types.StringValue(pointer.From(props.Logs))
+	data.ProvisioningState = types.StringValue(pointer.From(props.ProvisioningState))
+	data.Reason = types.StringValue(pointer.From(props.Reason))
+	data.StartedAt = types.StringValue(pointer.From(props.StartedAt))
+	data.FinishedAt = types.StringValue(pointer.From(props.FinishedAt))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterCommandEphemeral struct{}
+
+func TestAccEphemeralKubernetesClusterCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_command", "test")
+	r := KubernetesClusterCommandEphemeral{}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("exit_code"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("provisioning_state"), knownvalue.StringExact("Succeeded")),
+				},
+			},
+		},
+	})
+}
+
+func (KubernetesClusterCommandEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_kubernetes_cluster_command" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  command               = "kubectl get nodes"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_kubernetes_cluster_command.test
+}
+
+resource "echo" "test" {}
+`, KubernetesClusterExtensionResource{}.template(data))
+}
@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2025-03-02-preview/managednamespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = KubernetesClusterManagedNamespaceResource{}
+	_ sdk.ResourceWithUpdate = KubernetesClusterManagedNamespaceResource{}
+)
+
+type KubernetesClusterManagedNamespaceResource struct{}
+
+type KubernetesClusterManagedNamespaceModel struct {
+	Name                 string                                     `tfschema:"name"`
+	KubernetesClusterId  string                                     `tfschema:"kubernetes_cluster_id"`
+	AdoptionPolicy       string                                     `tfschema:"adoption_policy"`
+	Annotations          map[string]string                          `tfschema:"annotations"`
+	DefaultNetworkPolicy []KubernetesClusterManagedNamespaceNetwork `tfschema:"default_network_policy"`
+	DefaultResourceQuota []KubernetesClusterManagedNamespaceQuota   `tfschema:"default_resource_quota"`
+	DeletePolicy         string                                     `tfschema:"delete_policy"`
+	Labels               map[string]string                          `tfschema:"labels"`
+	Tags                 map[string]interface{}                     `tfschema:"tags"`
+	PortalFqdn           string                                     `tfschema:"portal_fqdn"`
+}
+
+type KubernetesClusterManagedNamespaceNetwork struct {
+	Ingress string `tfschema:"ingress"`
+	Egress  string `tfschema:"egress"`
+}
+
+type KubernetesClusterManagedNamespaceQuota struct {
+	CpuRequest    string `tfschema:"cpu_request"`
+	CpuLimit      string `tfschema:"cpu_limit"`
+	MemoryRequest string `tfschema:"memory_request"`
+	MemoryLimit   string `tfschema:"memory_limit"`
+}
+
+func (r KubernetesClusterManagedNamespaceResource) ModelObject() interface{} {
+	return &KubernetesClusterManagedNamespaceModel{}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_managed_namespace"
+}
+
+func (r KubernetesClusterManagedNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managednamespaces.ValidateManagedNamespaceID
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesManagedNamespaceName,
+		},
+
+		"kubernetes_cluster_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KubernetesClusterId{}),
+
+		"adoption_policy": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(managednamespaces.AdoptionPolicyNever),
+			ValidateFunc: validation.StringInSlice(managednamespaces.PossibleValuesForAdoptionPolicy(), false),
+		},
+
+		"annotations": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"default_network_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ingress": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(managednamespaces.PolicyRuleAllowSameNamespace),
+						ValidateFunc: validation.StringInSlice(managednamespaces.PossibleValuesForPolicyRule(), false),
+					},
+
+					"egress": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(managednamespaces.PolicyRuleAllowAll),
+						ValidateFunc: validation.StringInSlice(managednamespaces.PossibleValuesForPolicyRule(), false),
+					},
+				},
+			},
+		},
+
+		"default_resource_quota": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"cpu_request": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"cpu_limit": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"memory_request": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"memory_limit": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"delete_policy": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(managednamespaces.DeletePolicyKeep),
+			ValidateFunc: validation.StringInSlice(managednamespaces.PossibleValuesForDeletePolicy(), false),
+		},
+
+		"labels": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"portal_fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesManagedNamespacesClient
+			clustersClient := metadata.Client.Containers.KubernetesClustersClient
+
+			var config KubernetesClusterManagedNamespaceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterID(config.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := managednamespaces.NewManagedNamespaceID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			cluster, err := clustersClient.Get(ctx, *clusterId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *clusterId, err)
+			}
+			if cluster.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *clusterId)
+			}
+
+			payload := managednamespaces.ManagedNamespace{
+				Location:   pointer.To(cluster.Model.Location),
+				Properties: expandKubernetesClusterManagedNamespaceProperties(config),
+				Tags:       tags.Expand(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesManagedNamespacesClient
+
+			id, err := managednamespaces.ParseManagedNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterManagedNamespaceModel{
+				Name:                id.ManagedNamespaceName,
+				KubernetesClusterId: commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.AdoptionPolicy = string(pointer.From(props.AdoptionPolicy))
+					state.Annotations = pointer.From(props.Annotations)
+					state.DeletePolicy = string(pointer.From(props.DeletePolicy))
+					state.Labels = pointer.From(props.Labels)
+					state.PortalFqdn = pointer.From(props.PortalFqdn)
+					state.DefaultNetworkPolicy = flattenKubernetesClusterManagedNamespaceNetworkPolicy(props.DefaultNetworkPolicy)
+					state.DefaultResourceQuota = flattenKubernetesClusterManagedNamespaceResourceQuota(props.DefaultResourceQuota)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesManagedNamespacesClient
+
+			id, err := managednamespaces.ParseManagedNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesClusterManagedNamespaceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving existing %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving existing %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			payload.Properties = expandKubernetesClusterManagedNamespaceProperties(config)
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterManagedNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesManagedNamespacesClient
+
+			id, err := managednamespaces.ParseManagedNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesClusterManagedNamespaceProperties(input KubernetesClusterManagedNamespaceModel) *managednamespaces.NamespaceProperties {
+	props := &managednamespaces.NamespaceProperties{
+		AdoptionPolicy: pointer.To(managednamespaces.AdoptionPolicy(input.AdoptionPolicy)),
+		Annotations:    pointer.To(input.Annotations),
+		DeletePolicy:   pointer.To(managednamespaces.DeletePolicy(input.DeletePolicy)),
+		Labels:         pointer.To(input.Labels),
+	}
+
+	if len(input.DefaultNetworkPolicy) > 0 {
+		policy := input.DefaultNetworkPolicy[0]
+		props.DefaultNetworkPolicy = &managednamespaces.NetworkPolicies{
+			Ingress: pointer.To(managednamespaces.PolicyRule(policy.Ingress)),
+			Egress:  pointer.To(managednamespaces.PolicyRule(policy.Egress)),
+		}
+	}
+
+	if len(input.DefaultResourceQuota) > 0 {
+		quota := input.DefaultResourceQuota[0]
+		props.DefaultResourceQuota = &managednamespaces.ResourceQuota{}
+		if quota.CpuRequest != "" {
+			props.DefaultResourceQuota.CpuRequest = pointer.To(quota.CpuRequest)
+		}
+		if quota.CpuLimit != "" {
+			props.DefaultResourceQuota.CpuLimit = pointer.To(quota.CpuLimit)
+		}
+		if quota.MemoryRequest != "" {
+			props.DefaultResourceQuota.MemoryRequest = pointer.To(quota.MemoryRequest)
+		}
+		if quota.MemoryLimit != "" {
+			props.DefaultResourceQuota.MemoryLimit = pointer.To(quota.MemoryLimit)
+		}
+	}
+
+	return props
+}
+
+func flattenKubernetesClusterManagedNamespaceNetworkPolicy(input *managednamespaces.NetworkPolicies) []KubernetesClusterManagedNamespaceNetwork {
+	if input == nil {
+		return []KubernetesClusterManagedNamespaceNetwork{}
+	}
+
+	return []KubernetesClusterManagedNamespaceNetwork{
+		{
+			Ingress: string(pointer.From(input.Ingress)),
+			Egress:  string(pointer.From(input.Egress)),
+		},
+	}
+}
+
+func flattenKubernetesClusterManagedNamespaceResourceQuota(input *managednamespaces.ResourceQuota) []KubernetesClusterManagedNamespaceQuota {
+	if input == nil {
+		return []KubernetesClusterManagedNamespaceQuota{}
+	}
+
+	return []KubernetesClusterManagedNamespaceQuota{
+		{
+			CpuRequest:    pointer.From(input.CpuRequest),
+			CpuLimit:      pointer.From(input.CpuLimit),
+			MemoryRequest: pointer.From(input.MemoryRequest),
+			MemoryLimit:   pointer.From(input.MemoryLimit),
+		},
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managednamespaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// NOTE: Managed Namespaces are not yet available in `hashicorp/go-azure-sdk`, this package mirrors the layout of the
+// generated SDK so that it can be swapped out for the upstream package once it becomes available.
+
+const defaultApiVersion = "2025-03-02-preview"
+
+type ManagedNamespacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewManagedNamespacesClientWithBaseURI(sdkApi sdkEnv.Api) (*ManagedNamespacesClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "managednamespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ManagedNamespacesClient: %+v", err)
+	}
+
+	return &ManagedNamespacesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managednamespaces
+
+type AdoptionPolicy string
+
+const (
+	AdoptionPolicyAlways      AdoptionPolicy = "Always"
+	AdoptionPolicyIfIdentical AdoptionPolicy = "IfIdentical"
+	AdoptionPolicyNever       AdoptionPolicy = "Never"
+)
+
+func PossibleValuesForAdoptionPolicy() []string {
+	return []string{
+		string(AdoptionPolicyAlways),
+		string(AdoptionPolicyIfIdentical),
+		string(AdoptionPolicyNever),
+	}
+}
+
+type DeletePolicy string
+
+const (
+	DeletePolicyDelete DeletePolicy = "Delete"
+	DeletePolicyKeep   DeletePolicy = "Keep"
+)
+
+func PossibleValuesForDeletePolicy() []string {
+	return []string{
+		string(DeletePolicyDelete),
+		string(DeletePolicyKeep),
+	}
+}
+
+type PolicyRule string
+
+const (
+	PolicyRuleAllowAll           PolicyRule = "AllowAll"
+	PolicyRuleAllowSameNamespace PolicyRule = "AllowSameNamespace"
+	PolicyRuleDenyAll            PolicyRule = "DenyAll"
+)
+
+func PossibleValuesForPolicyRule() []string {
+	return []string{
+		string(PolicyRuleAllowAll),
+		string(PolicyRuleAllowSameNamespace),
+		string(PolicyRuleDenyAll),
+	}
+}
+
+type NamespaceProvisioningState string
+
+const (
+	NamespaceProvisioningStateCanceled  NamespaceProvisioningState = "Canceled"
+	NamespaceProvisioningStateCreating  NamespaceProvisioningState = "Creating"
+	NamespaceProvisioningStateDeleting  NamespaceProvisioningState = "Deleting"
+	NamespaceProvisioningStateFailed    NamespaceProvisioningState = "Failed"
+	NamespaceProvisioningStateSucceeded NamespaceProvisioningState = "Succeeded"
+	NamespaceProvisioningStateUpdating  NamespaceProvisioningState = "Updating"
+)
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managednamespaces
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type ManagedNamespace struct {
+	ETag       *string                `json:"eTag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *NamespaceProperties   `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type NamespaceProperties struct {
+	AdoptionPolicy       *AdoptionPolicy             `json:"adoptionPolicy,omitempty"`
+	Annotations          *map[string]string          `json:"annotations,omitempty"`
+	DefaultNetworkPolicy *NetworkPolicies            `json:"defaultNetworkPolicy,omitempty"`
+	DefaultResourceQuota *ResourceQuota              `json:"defaultResourceQuota,omitempty"`
+	DeletePolicy         *DeletePolicy               `json:"deletePolicy,omitempty"`
+	Labels               *map[string]string          `json:"labels,omitempty"`
+	PortalFqdn           *string                     `json:"portalFqdn,omitempty"`
+	ProvisioningState    *NamespaceProvisioningState `json:"provisioningState,omitempty"`
+}
+
+type NetworkPolicies struct {
+	Egress  *PolicyRule `json:"egress,omitempty"`
+	Ingress *PolicyRule `json:"ingress,omitempty"`
+}
+
+type ResourceQuota struct {
+	CpuLimit      *string `json:"cpuLimit,omitempty"`
+	CpuRequest    *string `json:"cpuRequest,omitempty"`
+	MemoryLimit   *string `json:"memoryLimit,omitempty"`
+	MemoryRequest *string `json:"memoryRequest,omitempty"`
+}
@@ -62,6 +62,20 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 	return warnings, errors
 }
 
+func KubernetesManagedNamespaceName(i interface{}, k string) (warnings []string, errors []error) {
+	namespaceName, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	re := regexp.MustCompile(`^[a-z\d]([-a-z\d]{0,61}[a-z\d])?$`)
+	if re != nil && !re.MatchString(namespaceName) {
+		errors = append(errors, fmt.Errorf("the %q must begin and end with a lowercase letter or number, contain only lowercase letters, numbers and hyphens and be between 1 and 63 characters in length, got %q", k, namespaceName))
+	}
+
+	return warnings, errors
+}
+
 func KubernetesGitRepositoryUrl() pluginsdk.SchemaValidateFunc {
 	return func(i interface{}, k string) ([]string, []error) {
 		v, ok := i.(string)
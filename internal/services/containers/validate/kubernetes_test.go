@@ -4,6 +4,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -165,6 +166,64 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 	}
 }
 
+func TestKubernetesManagedNamespaceName(t *testing.T) {
+	cases := []struct {
+		NamespaceName string
+		Errors        int
+	}{
+		{
+			NamespaceName: "",
+			Errors:        1,
+		},
+		{
+			NamespaceName: "a",
+			Errors:        0,
+		},
+		{
+			NamespaceName: "team-a",
+			Errors:        0,
+		},
+		{
+			NamespaceName: "1team",
+			Errors:        0,
+		},
+		{
+			NamespaceName: "Team-A",
+			Errors:        1,
+		},
+		{
+			NamespaceName: "-team",
+			Errors:        1,
+		},
+		{
+			NamespaceName: "team-",
+			Errors:        1,
+		},
+		{
+			NamespaceName: "team_a",
+			Errors:        1,
+		},
+		{
+			NamespaceName: strings.Repeat("a", 63),
+			Errors:        0,
+		},
+		{
+			NamespaceName: strings.Repeat("a", 64),
+			Errors:        1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.NamespaceName, func(t *testing.T) {
+			_, errors := KubernetesManagedNamespaceName(tc.NamespaceName, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesManagedNamespaceName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
 func TestKubernetesGitRepositoryUrl(t *testing.T) {
 	cases := []struct {
 		Input string
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func AccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[-a-z0-9]{3,50}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 50 characters in length and may only contain lowercase letters, numbers and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccountName(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "ab",
+			valid: false,
+		},
+		{
+			input: "example-cosmos-01",
+			valid: true,
+		},
+		{
+			input: "Example-Cosmos",
+			valid: false,
+		},
+		{
+			input: "example_cosmos",
+			valid: false,
+		},
+		{
+			input: strings.Repeat("a", 50),
+			valid: true,
+		},
+		{
+			input: strings.Repeat("a", 51),
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := AccountName(tt.input, "name")
+			valid := err == nil
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for input %s", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
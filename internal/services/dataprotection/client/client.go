@@ -6,9 +6,11 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/azurebackupjob"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/recoverypoint"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -18,6 +20,8 @@ type Client struct {
 	BackupPolicyClient   *backuppolicies.BackupPoliciesClient
 	BackupInstanceClient *backupinstances.BackupInstancesClient
 	ResourceGuardClient  *resourceguards.ResourceGuardsClient
+	BackupJobClient      *azurebackupjob.AzureBackupJobClient
+	RecoveryPointClient  *recoverypoint.RecoveryPointClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,10 +49,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceGuardClient.Client, o.Authorizers.ResourceManager)
 
+	backupJobClient, err := azurebackupjob.NewAzureBackupJobClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building AzureBackupJob client: %+v", err)
+	}
+	o.Configure(backupJobClient.Client, o.Authorizers.ResourceManager)
+
+	recoveryPointClient, err := recoverypoint.NewRecoveryPointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RecoveryPoint client: %+v", err)
+	}
+	o.Configure(recoveryPointClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		BackupVaultClient:    backupVaultClient,
 		BackupPolicyClient:   backupPolicyClient,
 		BackupInstanceClient: backupInstanceClient,
 		ResourceGuardClient:  resourceGuardClient,
+		BackupJobClient:      backupJobClient,
+		RecoveryPointClient:  recoveryPointClient,
 	}, nil
 }
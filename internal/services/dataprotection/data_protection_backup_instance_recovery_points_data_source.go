@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/recoverypoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DataProtectionBackupInstanceRecoveryPointsDataSource struct{}
+
+var _ sdk.DataSource = DataProtectionBackupInstanceRecoveryPointsDataSource{}
+
+type BackupInstanceRecoveryPointsDataSourceModel struct {
+	BackupInstanceId      string                                 `tfschema:"backup_instance_id"`
+	StartTime             string                                 `tfschema:"start_time"`
+	EndTime               string                                 `tfschema:"end_time"`
+	LatestRecoveryPointId string                                 `tfschema:"latest_recovery_point_id"`
+	RecoveryPoints        []BackupInstanceRecoveryPointDataModel `tfschema:"recovery_points"`
+}
+
+type BackupInstanceRecoveryPointDataModel struct {
+	Id                 string   `tfschema:"id"`
+	RecoveryPointId    string   `tfschema:"recovery_point_id"`
+	RecoveryPointTime  string   `tfschema:"recovery_point_time"`
+	RecoveryPointType  string   `tfschema:"recovery_point_type"`
+	RecoveryPointState string   `tfschema:"recovery_point_state"`
+	FriendlyName       string   `tfschema:"friendly_name"`
+	PolicyName         string   `tfschema:"policy_name"`
+	PolicyVersion      string   `tfschema:"policy_version"`
+	RetentionTagName   string   `tfschema:"retention_tag_name"`
+	ExpiryTime         string   `tfschema:"expiry_time"`
+	DataStoreTypes     []string `tfschema:"data_store_types"`
+}
+
+func (d DataProtectionBackupInstanceRecoveryPointsDataSource) ResourceType() string {
+	return "azurerm_data_protection_backup_instance_recovery_points"
+}
+
+func (d DataProtectionBackupInstanceRecoveryPointsDataSource) ModelObject() interface{} {
+	return &BackupInstanceRecoveryPointsDataSourceModel{}
+}
+
+func (d DataProtectionBackupInstanceRecoveryPointsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backup_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: recoverypoint.ValidateBackupInstanceID,
+		},
+
+		"start_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"end_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (d DataProtectionBackupInstanceRecoveryPointsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"latest_recovery_point_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"recovery_points": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"recovery_point_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"recovery_point_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"recovery_point_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"recovery_point_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"friendly_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"retention_tag_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"expiry_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"data_store_types": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DataProtectionBackupInstanceRecoveryPointsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.RecoveryPointClient
+
+			var state BackupInstanceRecoveryPointsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := recoverypoint.ParseBackupInstanceID(state.BackupInstanceId)
+			if err != nil {
+				return err
+			}
+
+			filters := make([]string, 0)
+			if state.StartTime != "" {
+				filters = append(filters, fmt.Sprintf("startDate eq '%s'", state.StartTime))
+			}
+			if state.EndTime != "" {
+				filters = append(filters, fmt.Sprintf("endDate eq '%s'", state.EndTime))
+			}
+
+			options := recoverypoint.DefaultListOperationOptions()
+			if len(filters) > 0 {
+				options.Filter = pointer.To(strings.Join(filters, " and "))
+			}
+
+			resp, err := client.ListComplete(ctx, *id, options)
+			if err != nil {
+				return fmt.Errorf("listing Recovery Points for %s: %+v", id, err)
+			}
+
+			state.RecoveryPoints = flattenBackupInstanceRecoveryPoints(resp.Items)
+			if len(state.RecoveryPoints) > 0 {
+				state.LatestRecoveryPointId = state.RecoveryPoints[0].RecoveryPointId
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenBackupInstanceRecoveryPoints flattens the discrete Recovery Points, ordered from the most recent to the oldest.
+func flattenBackupInstanceRecoveryPoints(input []recoverypoint.AzureBackupRecoveryPointResource) []BackupInstanceRecoveryPointDataModel {
+	output := make([]BackupInstanceRecoveryPointDataModel, 0)
+
+	for _, item := range input {
+		props, ok := item.Properties.(recoverypoint.AzureBackupDiscreteRecoveryPoint)
+		if !ok {
+			continue
+		}
+
+		dataStoreTypes := make([]string, 0)
+		if props.RecoveryPointDataStoresDetails != nil {
+			for _, v := range *props.RecoveryPointDataStoresDetails {
+				if v.Type != nil {
+					dataStoreTypes = append(dataStoreTypes, *v.Type)
+				}
+			}
+		}
+
+		output = append(output, BackupInstanceRecoveryPointDataModel{
+			Id:                 pointer.From(item.Id),
+			RecoveryPointId:    pointer.From(props.RecoveryPointId),
+			RecoveryPointTime:  props.RecoveryPointTime,
+			RecoveryPointType:  pointer.From(props.RecoveryPointType),
+			RecoveryPointState: string(pointer.From(props.RecoveryPointState)),
+			FriendlyName:       pointer.From(props.FriendlyName),
+			PolicyName:         pointer.From(props.PolicyName),
+			PolicyVersion:      pointer.From(props.PolicyVersion),
+			RetentionTagName:   pointer.From(props.RetentionTagName),
+			ExpiryTime:         pointer.From(props.ExpiryTime),
+			DataStoreTypes:     dataStoreTypes,
+		})
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		first, errFirst := time.Parse(time.RFC3339, output[i].RecoveryPointTime)
+		second, errSecond := time.Parse(time.RFC3339, output[j].RecoveryPointTime)
+		if errFirst != nil || errSecond != nil {
+			return output[i].RecoveryPointTime > output[j].RecoveryPointTime
+		}
+		return first.After(second)
+	})
+
+	return output
+}
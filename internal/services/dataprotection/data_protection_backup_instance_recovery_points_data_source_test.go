@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DataProtectionBackupInstanceRecoveryPointsDataSource struct{}
+
+func TestAccDataProtectionBackupInstanceRecoveryPointsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_protection_backup_instance_recovery_points", "test")
+	r := DataProtectionBackupInstanceRecoveryPointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("recovery_points.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataProtectionBackupInstanceRecoveryPointsDataSource_existingBackupInstance(t *testing.T) {
+	// Recovery Points are only created once a backup has completed, so this uses an existing Backup Instance
+	if os.Getenv("ARM_TEST_DATA_PROTECTION_BACKUP_INSTANCE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_PROTECTION_BACKUP_INSTANCE_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_data_protection_backup_instance_recovery_points", "test")
+	r := DataProtectionBackupInstanceRecoveryPointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.existingBackupInstance(os.Getenv("ARM_TEST_DATA_PROTECTION_BACKUP_INSTANCE_ID")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("latest_recovery_point_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("recovery_points.0.recovery_point_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("recovery_points.0.recovery_point_time").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (r DataProtectionBackupInstanceRecoveryPointsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_protection_backup_instance_recovery_points" "test" {
+  backup_instance_id = azurerm_data_protection_backup_instance_disk.test.id
+}
+`, DataProtectionBackupInstanceDiskResource{}.basic(data))
+}
+
+func (r DataProtectionBackupInstanceRecoveryPointsDataSource) existingBackupInstance(backupInstanceId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_data_protection_backup_instance_recovery_points" "test" {
+  backup_instance_id = %q
+}
+`, backupInstanceId)
+}
@@ -0,0 +1,626 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/azurebackupjob"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	backupJobStatusInProgress            = "InProgress"
+	backupJobStatusCompleted             = "Completed"
+	backupJobStatusCompletedWithWarnings = "CompletedWithWarnings"
+)
+
+type BackupInstanceRestoreModel struct {
+	BackupInstanceId                   string                                 `tfschema:"backup_instance_id"`
+	SourceDataStoreType                string                                 `tfschema:"source_data_store_type"`
+	RecoveryPointId                    string                                 `tfschema:"recovery_point_id"`
+	PointInTime                        string                                 `tfschema:"point_in_time"`
+	RestoreLocation                    string                                 `tfschema:"restore_location"`
+	TargetResourceId                   string                                 `tfschema:"target_resource_id"`
+	DatabaseCredentialKeyVaultSecretId string                                 `tfschema:"database_credential_key_vault_secret_id"`
+	RestoreAsFiles                     []BackupInstanceRestoreAsFilesModel    `tfschema:"restore_as_files"`
+	KubernetesClusterRestore           []BackupInstanceRestoreKubernetesModel `tfschema:"kubernetes_cluster_restore"`
+	Triggers                           map[string]string                      `tfschema:"triggers"`
+	JobId                              string                                 `tfschema:"job_id"`
+	Status                             string                                 `tfschema:"status"`
+}
+
+type BackupInstanceRestoreAsFilesModel struct {
+	StorageContainerId string `tfschema:"storage_container_id"`
+	FilePrefix         string `tfschema:"file_prefix"`
+}
+
+type BackupInstanceRestoreKubernetesModel struct {
+	IncludeClusterScopeResources bool              `tfschema:"include_cluster_scope_resources"`
+	IncludedNamespaces           []string          `tfschema:"included_namespaces"`
+	ExcludedNamespaces           []string          `tfschema:"excluded_namespaces"`
+	IncludedResourceTypes        []string          `tfschema:"included_resource_types"`
+	ExcludedResourceTypes        []string          `tfschema:"excluded_resource_types"`
+	LabelSelectors               []string          `tfschema:"label_selectors"`
+	NamespaceMappings            map[string]string `tfschema:"namespace_mappings"`
+	PersistentVolumeRestoreMode  string            `tfschema:"persistent_volume_restore_mode"`
+	ConflictPolicy               string            `tfschema:"conflict_policy"`
+}
+
+type DataProtectionBackupInstanceRestoreResource struct{}
+
+var _ sdk.Resource = DataProtectionBackupInstanceRestoreResource{}
+
+func (r DataProtectionBackupInstanceRestoreResource) ResourceType() string {
+	return "azurerm_data_protection_backup_instance_restore"
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) ModelObject() interface{} {
+	return &BackupInstanceRestoreModel{}
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azurebackupjob.ValidateBackupJobID
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backup_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: backupinstances.ValidateBackupInstanceID,
+		},
+
+		"source_data_store_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(backupinstances.PossibleValuesForSourceDataStoreType(), false),
+		},
+
+		"recovery_point_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"recovery_point_id", "point_in_time"},
+		},
+
+		"point_in_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			ExactlyOneOf: []string{"recovery_point_id", "point_in_time"},
+		},
+
+		"restore_location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"target_resource_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"restore_as_files"},
+		},
+
+		"database_credential_key_vault_secret_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  keyVaultValidate.NestedItemIdWithOptionalVersion,
+			ConflictsWith: []string{"restore_as_files"},
+		},
+
+		"restore_as_files": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"storage_container_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateStorageContainerID,
+					},
+
+					"file_prefix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+			ConflictsWith: []string{"kubernetes_cluster_restore"},
+		},
+
+		"kubernetes_cluster_restore": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"include_cluster_scope_resources": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"included_namespaces": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"excluded_namespaces": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"included_resource_types": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"excluded_resource_types": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"label_selectors": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"namespace_mappings": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"persistent_volume_restore_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(backupinstances.PersistentVolumeRestoreModeRestoreWithVolumeData),
+						ValidateFunc: validation.StringInSlice(backupinstances.PossibleValuesForPersistentVolumeRestoreMode(), false),
+					},
+
+					"conflict_policy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(backupinstances.ExistingResourcePolicySkip),
+						ValidateFunc: validation.StringInSlice(backupinstances.PossibleValuesForExistingResourcePolicy(), false),
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"job_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.BackupInstanceClient
+			jobClient := metadata.Client.DataProtection.BackupJobClient
+
+			var model BackupInstanceRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			instanceId, err := backupinstances.ParseBackupInstanceID(model.BackupInstanceId)
+			if err != nil {
+				return err
+			}
+
+			instance, err := client.Get(ctx, *instanceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", instanceId, err)
+			}
+			if instance.Model == nil || instance.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", instanceId)
+			}
+
+			restoreLocation := model.RestoreLocation
+			if restoreLocation == "" {
+				restoreLocation = pointer.From(instance.Model.Properties.DataSourceInfo.ResourceLocation)
+			}
+
+			targetInfo, err := expandBackupInstanceRestoreTargetInfo(metadata, model, *instance.Model.Properties, location.Normalize(restoreLocation))
+			if err != nil {
+				return err
+			}
+
+			request := expandBackupInstanceRestoreRequest(model, targetInfo)
+
+			// validating the restore first surfaces configuration errors before a restore job is created
+			if err := client.ValidateForRestoreThenPoll(ctx, *instanceId, backupinstances.ValidateRestoreRequestObject{RestoreRequestObject: request}); err != nil {
+				return fmt.Errorf("validating the restore of %s: %+v", instanceId, err)
+			}
+
+			result, err := client.TriggerRestore(ctx, *instanceId, request, backupinstances.DefaultTriggerRestoreOperationOptions())
+			if err != nil {
+				return fmt.Errorf("triggering the restore of %s: %+v", instanceId, err)
+			}
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("polling after triggering the restore of %s: %+v", instanceId, err)
+			}
+
+			var operation backupInstanceOperationResult
+			if err := result.Poller.FinalResult(&operation); err != nil {
+				return fmt.Errorf("retrieving the result of triggering the restore of %s: %+v", instanceId, err)
+			}
+			if operation.Properties == nil || pointer.From(operation.Properties.JobId) == "" {
+				return fmt.Errorf("retrieving the result of triggering the restore of %s: `jobId` was nil", instanceId)
+			}
+
+			jobName := *operation.Properties.JobId
+			// the Job ID may be returned as either the full Resource ID or only the name of the Job
+			if jobId, err := azurebackupjob.ParseBackupJobIDInsensitively(jobName); err == nil {
+				jobName = jobId.JobId
+			}
+
+			id := azurebackupjob.NewBackupJobID(instanceId.SubscriptionId, instanceId.ResourceGroupName, instanceId.BackupVaultName, jobName)
+			metadata.SetID(id)
+
+			if err := waitForBackupJobCompletion(ctx, jobClient, id); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.BackupJobClient
+
+			id, err := azurebackupjob.ParseBackupJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state BackupInstanceRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.JobsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.JobId = id.JobId
+
+			// the details of the restore request aren't returned by the Job, so these are retained from the state
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.Status = props.Status
+
+				if state.BackupInstanceId == "" && props.BackupInstanceId != nil {
+					instanceId, err := backupinstances.ParseBackupInstanceIDInsensitively(*props.BackupInstanceId)
+					if err != nil {
+						return err
+					}
+					state.BackupInstanceId = instanceId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := azurebackupjob.ParseBackupJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a restore can't be undone, so this only removes the resource from the state
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+
+			return nil
+		},
+	}
+}
+
+// backupInstanceOperationResult is the result of the long running operation to trigger a restore, which contains
+// the ID of the Job performing the restore.
+type backupInstanceOperationResult struct {
+	Properties *backupinstances.OperationJobExtendedInfo `json:"properties,omitempty"`
+}
+
+func expandBackupInstanceRestoreRequest(model BackupInstanceRestoreModel, targetInfo backupinstances.RestoreTargetInfoBase) backupinstances.AzureBackupRestoreRequest {
+	sourceDataStoreType := backupinstances.SourceDataStoreType(model.SourceDataStoreType)
+
+	if model.PointInTime != "" {
+		return backupinstances.AzureBackupRecoveryTimeBasedRestoreRequest{
+			RecoveryPointTime:   model.PointInTime,
+			RestoreTargetInfo:   targetInfo,
+			SourceDataStoreType: sourceDataStoreType,
+		}
+	}
+
+	return backupinstances.AzureBackupRecoveryPointBasedRestoreRequest{
+		RecoveryPointId:     model.RecoveryPointId,
+		RestoreTargetInfo:   targetInfo,
+		SourceDataStoreType: sourceDataStoreType,
+	}
+}
+
+// expandBackupInstanceRestoreTargetInfo builds the datasource-specific restore target, which is either a set of files
+// in a Storage Container, or the original datasource (or an alternate one of the same type) of the Backup Instance.
+func expandBackupInstanceRestoreTargetInfo(metadata sdk.ResourceMetaData, model BackupInstanceRestoreModel, instance backupinstances.BackupInstance, restoreLocation string) (backupinstances.RestoreTargetInfoBase, error) {
+	if len(model.RestoreAsFiles) > 0 {
+		files := model.RestoreAsFiles[0]
+
+		containerId, err := commonids.ParseStorageContainerID(files.StorageContainerId)
+		if err != nil {
+			return nil, err
+		}
+
+		storageDomainSuffix, ok := metadata.Client.Account.Environment.Storage.DomainSuffix()
+		if !ok {
+			return nil, fmt.Errorf("could not determine Storage domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+		}
+
+		return backupinstances.RestoreFilesTargetInfo{
+			RecoveryOption:  backupinstances.RecoveryOptionFailIfExists,
+			RestoreLocation: pointer.To(restoreLocation),
+			TargetDetails: backupinstances.TargetDetails{
+				FilePrefix:                files.FilePrefix,
+				RestoreTargetLocationType: backupinstances.RestoreTargetLocationTypeAzureBlobs,
+				TargetResourceArmId:       pointer.To(containerId.ID()),
+				Url:                       fmt.Sprintf("https://%s.blob.%s/%s", containerId.StorageAccountName, *storageDomainSuffix, containerId.ContainerName),
+			},
+		}, nil
+	}
+
+	datasource, datasourceSet := expandBackupInstanceRestoreTargetDatasource(instance, model.TargetResourceId, restoreLocation)
+
+	var authCredentials backupinstances.AuthCredentials
+	if model.DatabaseCredentialKeyVaultSecretId != "" {
+		authCredentials = backupinstances.SecretStoreBasedAuthCredentials{
+			SecretStoreResource: &backupinstances.SecretStoreResource{
+				SecretStoreType: backupinstances.SecretStoreTypeAzureKeyVault,
+				Uri:             pointer.To(model.DatabaseCredentialKeyVaultSecretId),
+			},
+		}
+	}
+
+	if len(model.KubernetesClusterRestore) > 0 {
+		return backupinstances.ItemLevelRestoreTargetInfo{
+			DatasourceAuthCredentials: authCredentials,
+			DatasourceInfo:            datasource,
+			DatasourceSetInfo:         datasourceSet,
+			RecoveryOption:            backupinstances.RecoveryOptionFailIfExists,
+			RestoreLocation:           pointer.To(restoreLocation),
+			RestoreCriteria: []backupinstances.ItemLevelRestoreCriteria{
+				expandBackupInstanceKubernetesClusterRestoreCriteria(model.KubernetesClusterRestore[0]),
+			},
+		}, nil
+	}
+
+	return backupinstances.RestoreTargetInfo{
+		DatasourceAuthCredentials: authCredentials,
+		DatasourceInfo:            datasource,
+		DatasourceSetInfo:         datasourceSet,
+		RecoveryOption:            backupinstances.RecoveryOptionFailIfExists,
+		RestoreLocation:           pointer.To(restoreLocation),
+	}, nil
+}
+
+func expandBackupInstanceKubernetesClusterRestoreCriteria(input BackupInstanceRestoreKubernetesModel) backupinstances.KubernetesClusterRestoreCriteria {
+	criteria := backupinstances.KubernetesClusterRestoreCriteria{
+		ConflictPolicy:               pointer.To(backupinstances.ExistingResourcePolicy(input.ConflictPolicy)),
+		IncludeClusterScopeResources: input.IncludeClusterScopeResources,
+		PersistentVolumeRestoreMode:  pointer.To(backupinstances.PersistentVolumeRestoreMode(input.PersistentVolumeRestoreMode)),
+	}
+
+	if len(input.IncludedNamespaces) > 0 {
+		criteria.IncludedNamespaces = pointer.To(input.IncludedNamespaces)
+	}
+	if len(input.ExcludedNamespaces) > 0 {
+		criteria.ExcludedNamespaces = pointer.To(input.ExcludedNamespaces)
+	}
+	if len(input.IncludedResourceTypes) > 0 {
+		criteria.IncludedResourceTypes = pointer.To(input.IncludedResourceTypes)
+	}
+	if len(input.ExcludedResourceTypes) > 0 {
+		criteria.ExcludedResourceTypes = pointer.To(input.ExcludedResourceTypes)
+	}
+	if len(input.LabelSelectors) > 0 {
+		criteria.LabelSelectors = pointer.To(input.LabelSelectors)
+	}
+	if len(input.NamespaceMappings) > 0 {
+		criteria.NamespaceMappings = pointer.To(input.NamespaceMappings)
+	}
+
+	return criteria
+}
+
+// expandBackupInstanceRestoreTargetDatasource returns the datasource of the Backup Instance, or when a target resource
+// is specified, an alternate datasource of the same type - where the datasource set is updated to be the parent of the
+// target resource when the original datasource set is the parent of the original datasource (e.g. a database server).
+func expandBackupInstanceRestoreTargetDatasource(instance backupinstances.BackupInstance, targetResourceId string, restoreLocation string) (backupinstances.Datasource, *backupinstances.DatasourceSet) {
+	datasource := instance.DataSourceInfo
+	datasourceSet := instance.DataSourceSetInfo
+
+	if targetResourceId == "" || strings.EqualFold(targetResourceId, datasource.ResourceID) {
+		return datasource, datasourceSet
+	}
+
+	originalResourceId := datasource.ResourceID
+	datasource = backupinstances.Datasource{
+		DatasourceType:   datasource.DatasourceType,
+		ObjectType:       datasource.ObjectType,
+		ResourceID:       targetResourceId,
+		ResourceLocation: pointer.To(restoreLocation),
+		ResourceName:     pointer.To(targetResourceId[strings.LastIndex(targetResourceId, "/")+1:]),
+		ResourceType:     datasource.ResourceType,
+		ResourceUri:      pointer.To(targetResourceId),
+	}
+
+	if datasourceSet == nil {
+		return datasource, nil
+	}
+
+	setResourceId := targetResourceId
+	if !strings.EqualFold(datasourceSet.ResourceID, originalResourceId) {
+		// e.g. `{serverId}/databases/{databaseName}` is within the datasource set `{serverId}`
+		segments := strings.Split(targetResourceId, "/")
+		if len(segments) > 2 {
+			setResourceId = strings.Join(segments[:len(segments)-2], "/")
+		}
+	}
+
+	datasourceSet = &backupinstances.DatasourceSet{
+		DatasourceType:   datasourceSet.DatasourceType,
+		ObjectType:       datasourceSet.ObjectType,
+		ResourceID:       setResourceId,
+		ResourceLocation: pointer.To(restoreLocation),
+		ResourceName:     pointer.To(setResourceId[strings.LastIndex(setResourceId, "/")+1:]),
+		ResourceType:     datasourceSet.ResourceType,
+		ResourceUri:      pointer.To(setResourceId),
+	}
+
+	return datasource, datasourceSet
+}
+
+func waitForBackupJobCompletion(ctx context.Context, client *azurebackupjob.AzureBackupJobClient, id azurebackupjob.BackupJobId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			backupJobStatusInProgress,
+		},
+		Target: []string{
+			backupJobStatusCompleted,
+			backupJobStatusCompletedWithWarnings,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.JobsGet(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			props := resp.Model.Properties
+			switch props.Status {
+			case backupJobStatusInProgress, backupJobStatusCompleted, backupJobStatusCompletedWithWarnings:
+				return resp, props.Status, nil
+			}
+
+			messages := make([]string, 0)
+			if props.ErrorDetails != nil {
+				for _, v := range *props.ErrorDetails {
+					messages = append(messages, pointer.From(v.Message))
+				}
+			}
+			return nil, props.Status, fmt.Errorf("%s finished with the status %q: %s", id, props.Status, strings.Join(messages, "; "))
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
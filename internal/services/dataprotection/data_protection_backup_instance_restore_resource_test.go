@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/azurebackupjob"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataProtectionBackupInstanceRestoreResource struct {
+	backupInstanceId string
+}
+
+func (r *DataProtectionBackupInstanceRestoreResource) populateFromEnvironment(t *testing.T) {
+	// a restore requires a Recovery Point, which is only created once a backup of the Disk has completed
+	if os.Getenv("ARM_TEST_DATA_PROTECTION_DISK_BACKUP_INSTANCE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_PROTECTION_DISK_BACKUP_INSTANCE_ID is not specified")
+	}
+	r.backupInstanceId = os.Getenv("ARM_TEST_DATA_PROTECTION_DISK_BACKUP_INSTANCE_ID")
+}
+
+func TestAccDataProtectionBackupInstanceRestore_diskAlternateLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_instance_restore", "test")
+	r := DataProtectionBackupInstanceRestoreResource{}
+	r.populateFromEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.diskAlternateLocation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("status").HasValue("Completed"),
+			),
+		},
+	})
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azurebackupjob.ParseBackupJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataProtection.BackupJobClient.JobsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DataProtectionBackupInstanceRestoreResource) diskAlternateLocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dataprotection-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_data_protection_backup_instance_recovery_points" "test" {
+  backup_instance_id = %[3]q
+}
+
+resource "azurerm_data_protection_backup_instance_restore" "test" {
+  backup_instance_id     = %[3]q
+  source_data_store_type = "OperationalStore"
+  recovery_point_id      = data.azurerm_data_protection_backup_instance_recovery_points.test.latest_recovery_point_id
+  restore_location       = azurerm_resource_group.test.location
+  target_resource_id     = "${azurerm_resource_group.test.id}/providers/Microsoft.Compute/disks/acctestrestored-%[1]d"
+}
+`, data.RandomInteger, data.Locations.Primary, r.backupInstanceId)
+}
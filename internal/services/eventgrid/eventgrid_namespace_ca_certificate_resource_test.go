@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+func TestAccEventGridNamespaceCaCertificateResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiry_time_in_utc").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificateResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventgrid_namespace_ca_certificate"),
+		},
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificateResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+	})
+}
+
+func (r EventGridNamespaceCaCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cacertificates.ParseCaCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.CaCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceCaCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  topic_spaces_configuration {
+    maximum_session_expiry_in_hours = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventGridNamespaceCaCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                = "acctest-egnca-%d"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  encoded_certificate = file("testdata/namespace_ca_certificate.pem")
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceCaCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "import" {
+  name                = azurerm_eventgrid_namespace_ca_certificate.test.name
+  namespace_id        = azurerm_eventgrid_namespace_ca_certificate.test.namespace_id
+  encoded_certificate = azurerm_eventgrid_namespace_ca_certificate.test.encoded_certificate
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceCaCertificateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                = "acctest-egnca-%d"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  encoded_certificate = file("testdata/namespace_ca_certificate.pem")
+  description         = "Root CA for acceptance test clients"
+}
+`, r.template(data), data.RandomInteger)
+}
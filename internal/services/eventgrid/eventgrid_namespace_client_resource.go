@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+
+type EventGridNamespaceClientResource struct{}
+
+type EventGridNamespaceClientResourceModel struct {
+	Name                            string                                 `tfschema:"name"`
+	NamespaceId                     string                                 `tfschema:"namespace_id"`
+	AuthenticationName              string                                 `tfschema:"authentication_name"`
+	ClientCertificateAuthentication []ClientCertificateAuthenticationModel `tfschema:"client_certificate_authentication"`
+	Attributes                      map[string]string                      `tfschema:"attributes"`
+	Description                     string                                 `tfschema:"description"`
+	Enabled                         bool                                   `tfschema:"enabled"`
+}
+
+type ClientCertificateAuthenticationModel struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9:._]{3,50}$"),
+				"EventGrid Namespace Client name must be 3 - 50 characters long, contain only letters, numbers, hyphens, colons, periods and underscores.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clients.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(clients.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientResourceModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			namespaceId, err := clients.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %s", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := clients.Client{
+				Properties: &clients.ClientProperties{
+					Attributes:                      expandClientAttributes(model.Attributes),
+					ClientCertificateAuthentication: expandClientCertificateAuthentication(model.ClientCertificateAuthentication),
+					State:                           expandClientState(model.Enabled),
+				},
+			}
+
+			if model.AuthenticationName != "" {
+				payload.Properties.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("authentication_name") {
+				payload.Properties.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if metadata.ResourceData.HasChange("client_certificate_authentication") {
+				payload.Properties.ClientCertificateAuthentication = expandClientCertificateAuthentication(model.ClientCertificateAuthentication)
+			}
+
+			if metadata.ResourceData.HasChange("attributes") {
+				payload.Properties.Attributes = expandClientAttributes(model.Attributes)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.State = expandClientState(model.Enabled)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientResourceModel{
+				Name:        id.ClientName,
+				NamespaceId: clients.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.ClientCertificateAuthentication = flattenClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Attributes = flattenClientAttributes(props.Attributes)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) == clients.ClientStateEnabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandClientState(enabled bool) *clients.ClientState {
+	if enabled {
+		return pointer.To(clients.ClientStateEnabled)
+	}
+	return pointer.To(clients.ClientStateDisabled)
+}
+
+func expandClientAttributes(input map[string]string) *map[string]interface{} {
+	attributes := make(map[string]interface{})
+	for k, v := range input {
+		attributes[k] = v
+	}
+	return &attributes
+}
+
+func flattenClientAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		// attributes can also be an integer or an array of strings, these are represented in their string form
+		output[k] = fmt.Sprintf("%v", v)
+	}
+	return output
+}
+
+func expandClientCertificateAuthentication(input []ClientCertificateAuthenticationModel) *clients.ClientCertificateAuthentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := clients.ClientCertificateAuthentication{
+		ValidationScheme: pointer.To(clients.ClientCertificateValidationScheme(input[0].ValidationScheme)),
+	}
+	if len(input[0].AllowedThumbprints) > 0 {
+		output.AllowedThumbprints = pointer.To(input[0].AllowedThumbprints)
+	}
+	return &output
+}
+
+func flattenClientCertificateAuthentication(input *clients.ClientCertificateAuthentication) []ClientCertificateAuthenticationModel {
+	output := make([]ClientCertificateAuthenticationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	return append(output, ClientCertificateAuthenticationModel{
+		ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+		AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+	})
+}
@@ -0,0 +1,486 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceTopicEventSubscriptionResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceTopicEventSubscriptionResource{}
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+type EventGridNamespaceTopicEventSubscriptionResourceModel struct {
+	Name                string                                      `tfschema:"name"`
+	NamespaceTopicId    string                                      `tfschema:"namespace_topic_id"`
+	DeliveryMode        string                                      `tfschema:"delivery_mode"`
+	EventDeliverySchema string                                      `tfschema:"event_delivery_schema"`
+	IncludedEventTypes  []string                                    `tfschema:"included_event_types"`
+	Queue               []NamespaceTopicEventSubscriptionQueueModel `tfschema:"queue"`
+	Push                []NamespaceTopicEventSubscriptionPushModel  `tfschema:"push"`
+}
+
+type NamespaceTopicEventSubscriptionQueueModel struct {
+	EventTimeToLive              string `tfschema:"event_time_to_live"`
+	MaxDeliveryCount             int64  `tfschema:"max_delivery_count"`
+	ReceiveLockDurationInSeconds int64  `tfschema:"receive_lock_duration_in_seconds"`
+}
+
+type NamespaceTopicEventSubscriptionPushModel struct {
+	EventHubId             string `tfschema:"event_hub_id"`
+	IdentityType           string `tfschema:"identity_type"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+	EventTimeToLive        string `tfschema:"event_time_to_live"`
+	MaxDeliveryCount       int64  `tfschema:"max_delivery_count"`
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Topic Event Subscription name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventsubscriptions.ValidateNamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(eventsubscriptions.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"queue": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"queue", "push"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"receive_lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+				},
+			},
+		},
+
+		"push": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"queue", "push"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_hub_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: eventhubs.ValidateEventhubID,
+					},
+
+					"identity_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(eventsubscriptions.EventSubscriptionIdentityTypeSystemAssigned),
+						ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForEventSubscriptionIdentityType(), false),
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"delivery_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionResourceModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return eventsubscriptions.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionClient
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			topicId, err := eventsubscriptions.ParseNamespaceTopicID(model.NamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := eventsubscriptions.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.NamespaceTopicEventSubscriptionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %s", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			deliveryConfiguration, err := expandNamespaceTopicEventSubscriptionDeliveryConfiguration(model)
+			if err != nil {
+				return err
+			}
+
+			payload := eventsubscriptions.Subscription{
+				Properties: &eventsubscriptions.SubscriptionProperties{
+					DeliveryConfiguration: deliveryConfiguration,
+					EventDeliverySchema:   pointer.To(eventsubscriptions.DeliverySchema(model.EventDeliverySchema)),
+					FiltersConfiguration: &eventsubscriptions.FiltersConfiguration{
+						IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+					},
+				},
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionClient
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			payload := eventsubscriptions.SubscriptionUpdateParameters{
+				Properties: &eventsubscriptions.SubscriptionUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("queue", "push") {
+				deliveryConfiguration, err := expandNamespaceTopicEventSubscriptionDeliveryConfiguration(model)
+				if err != nil {
+					return err
+				}
+				payload.Properties.DeliveryConfiguration = deliveryConfiguration
+			}
+
+			if metadata.ResourceData.HasChange("event_delivery_schema") {
+				payload.Properties.EventDeliverySchema = pointer.To(eventsubscriptions.DeliverySchema(model.EventDeliverySchema))
+			}
+
+			if metadata.ResourceData.HasChange("included_event_types") {
+				payload.Properties.FiltersConfiguration = &eventsubscriptions.FiltersConfiguration{
+					IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+				}
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionClient
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionResourceModel{
+				Name:             id.EventSubscriptionName,
+				NamespaceTopicId: eventsubscriptions.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						state.DeliveryMode = string(pointer.From(delivery.DeliveryMode))
+						state.Queue = flattenNamespaceTopicEventSubscriptionQueue(delivery.Queue)
+
+						push, err := flattenNamespaceTopicEventSubscriptionPush(delivery.Push)
+						if err != nil {
+							return err
+						}
+						state.Push = push
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the Delivery Mode is derived from whichever of the `queue` and `push` blocks is specified, and
+			// can't be changed once the Event Subscription has been created
+			mode := namespaceTopicEventSubscriptionDeliveryMode(len(metadata.ResourceDiff.Get("queue").([]interface{})) > 0)
+			if existing := metadata.ResourceDiff.Get("delivery_mode").(string); existing == string(mode) {
+				return nil
+			}
+
+			if err := metadata.ResourceDiff.SetNew("delivery_mode", string(mode)); err != nil {
+				return err
+			}
+
+			if metadata.ResourceDiff.Id() != "" {
+				if err := metadata.ResourceDiff.ForceNew("delivery_mode"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionClient
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func namespaceTopicEventSubscriptionDeliveryMode(hasQueue bool) eventsubscriptions.DeliveryMode {
+	if hasQueue {
+		return eventsubscriptions.DeliveryModeQueue
+	}
+	return eventsubscriptions.DeliveryModePush
+}
+
+func expandNamespaceTopicEventSubscriptionDeliveryConfiguration(model EventGridNamespaceTopicEventSubscriptionResourceModel) (*eventsubscriptions.DeliveryConfiguration, error) {
+	mode := namespaceTopicEventSubscriptionDeliveryMode(len(model.Queue) > 0)
+	output := eventsubscriptions.DeliveryConfiguration{
+		DeliveryMode: pointer.To(mode),
+	}
+
+	switch mode {
+	case eventsubscriptions.DeliveryModeQueue:
+		queue := model.Queue[0]
+		output.Queue = &eventsubscriptions.QueueInfo{}
+		if queue.EventTimeToLive != "" {
+			output.Queue.EventTimeToLive = pointer.To(queue.EventTimeToLive)
+		}
+		if queue.MaxDeliveryCount != 0 {
+			output.Queue.MaxDeliveryCount = pointer.To(queue.MaxDeliveryCount)
+		}
+		if queue.ReceiveLockDurationInSeconds != 0 {
+			output.Queue.ReceiveLockDurationInSeconds = pointer.To(queue.ReceiveLockDurationInSeconds)
+		}
+
+	case eventsubscriptions.DeliveryModePush:
+		push := model.Push[0]
+		identityType := eventsubscriptions.EventSubscriptionIdentityType(push.IdentityType)
+		identity := eventsubscriptions.EventSubscriptionIdentity{
+			Type: pointer.To(identityType),
+		}
+		if identityType == eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned {
+			if push.UserAssignedIdentityId == "" {
+				return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `identity_type` is `%s`", identityType)
+			}
+			identity.UserAssignedIdentity = pointer.To(push.UserAssignedIdentityId)
+		}
+
+		output.Push = &eventsubscriptions.PushInfo{
+			DeliveryWithResourceIdentity: &eventsubscriptions.DeliveryWithResourceIdentity{
+				Destination: eventsubscriptions.EventHubEventSubscriptionDestination{
+					Properties: &eventsubscriptions.EventHubEventSubscriptionDestinationProperties{
+						ResourceId: pointer.To(push.EventHubId),
+					},
+				},
+				Identity: &identity,
+			},
+		}
+		if push.EventTimeToLive != "" {
+			output.Push.EventTimeToLive = pointer.To(push.EventTimeToLive)
+		}
+		if push.MaxDeliveryCount != 0 {
+			output.Push.MaxDeliveryCount = pointer.To(push.MaxDeliveryCount)
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenNamespaceTopicEventSubscriptionQueue(input *eventsubscriptions.QueueInfo) []NamespaceTopicEventSubscriptionQueueModel {
+	output := make([]NamespaceTopicEventSubscriptionQueueModel, 0)
+	if input == nil {
+		return output
+	}
+
+	return append(output, NamespaceTopicEventSubscriptionQueueModel{
+		EventTimeToLive:              pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount:             pointer.From(input.MaxDeliveryCount),
+		ReceiveLockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+	})
+}
+
+func flattenNamespaceTopicEventSubscriptionPush(input *eventsubscriptions.PushInfo) ([]NamespaceTopicEventSubscriptionPushModel, error) {
+	output := make([]NamespaceTopicEventSubscriptionPushModel, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	push := NamespaceTopicEventSubscriptionPushModel{
+		EventTimeToLive:  pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount: pointer.From(input.MaxDeliveryCount),
+	}
+
+	if delivery := input.DeliveryWithResourceIdentity; delivery != nil {
+		if identity := delivery.Identity; identity != nil {
+			push.IdentityType = string(pointer.From(identity.Type))
+			if v := pointer.From(identity.UserAssignedIdentity); v != "" {
+				identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(v)
+				if err != nil {
+					return nil, err
+				}
+				push.UserAssignedIdentityId = identityId.ID()
+			}
+		}
+
+		if destination, ok := delivery.Destination.(eventsubscriptions.EventHubEventSubscriptionDestination); ok && destination.Properties != nil {
+			push.EventHubId = pointer.From(destination.Properties.ResourceId)
+		}
+	}
+
+	return append(output, push), nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/dpscertificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
@@ -19,6 +21,8 @@ type Client struct {
 	DeviceUpdatesClient     *deviceupdates.DeviceupdatesClient
 	DPSResourceClient       *iotdpsresource.IotDpsResourceClient
 	DPSCertificateClient    *dpscertificate.DpsCertificateClient
+
+	configureFunc func(c client.BaseClient, authorizer auth.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -52,5 +56,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DeviceUpdatesClient:     DeviceUpdatesClient,
 		DPSResourceClient:       DPSResourceClient,
 		DPSCertificateClient:    DPSCertificateClient,
+
+		configureFunc: o.Configure,
 	}, nil
 }
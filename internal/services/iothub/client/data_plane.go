@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/enrollmentgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/individualenrollments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/provisioningclient"
+)
+
+type DPSDataPlaneClient struct {
+	EnrollmentGroupsClient      *enrollmentgroups.EnrollmentGroupsClient
+	IndividualEnrollmentsClient *individualenrollments.IndividualEnrollmentsClient
+}
+
+// DPSDataPlaneClient returns the clients for the Data Plane API of the specified Device Provisioning Service.
+//
+// The Data Plane API is authenticated using a Shared Access Signature generated from the first Shared Access Policy
+// of the Device Provisioning Service which grants the `EnrollmentWrite` right.
+func (c *Client) DPSDataPlaneClient(ctx context.Context, id commonids.ProvisioningServiceId) (*DPSDataPlaneClient, error) {
+	resp, err := c.DPSResourceClient.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	hostName := ""
+	if model := resp.Model; model != nil {
+		hostName = pointer.From(model.Properties.ServiceOperationsHostName)
+	}
+	if hostName == "" {
+		return nil, fmt.Errorf("retrieving %s: `properties.serviceOperationsHostName` was nil", id)
+	}
+
+	keys, err := c.DPSResourceClient.ListKeysComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Shared Access Policies for %s: %+v", id, err)
+	}
+
+	policyName := ""
+	policyKey := ""
+	for _, item := range keys.Items {
+		if item.PrimaryKey == nil || !strings.Contains(string(item.Rights), "EnrollmentWrite") {
+			continue
+		}
+
+		policyName = item.KeyName
+		policyKey = *item.PrimaryKey
+		break
+	}
+	if policyName == "" {
+		return nil, fmt.Errorf("a Shared Access Policy granting the `EnrollmentWrite` right was not found for %s", id)
+	}
+
+	endpoint := fmt.Sprintf("https://%s", hostName)
+	configure := func(client *provisioningclient.Client) error {
+		c.configureFunc(client, nil)
+		return client.WithSharedAccessPolicy(hostName, policyName, policyKey)
+	}
+
+	enrollmentGroupsClient, err := enrollmentgroups.NewEnrollmentGroupsClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building EnrollmentGroups client: %+v", err)
+	}
+	if err := configure(enrollmentGroupsClient.Client); err != nil {
+		return nil, err
+	}
+
+	individualEnrollmentsClient, err := individualenrollments.NewIndividualEnrollmentsClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building IndividualEnrollments client: %+v", err)
+	}
+	if err := configure(individualEnrollmentsClient.Client); err != nil {
+		return nil, err
+	}
+
+	return &DPSDataPlaneClient{
+		EnrollmentGroupsClient:      enrollmentGroupsClient,
+		IndividualEnrollmentsClient: individualEnrollmentsClient,
+	}, nil
+}
@@ -0,0 +1,770 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/enrollmentgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	dpsAllocationPolicyCustom     = "Custom"
+	dpsAllocationPolicyGeoLatency = "GeoLatency"
+	dpsAllocationPolicyHashed     = "Hashed"
+	dpsAllocationPolicyStatic     = "Static"
+
+	dpsReprovisionPolicyNeverReprovision          = "NeverReprovision"
+	dpsReprovisionPolicyReprovisionAndMigrateData = "ReprovisionAndMigrateData"
+	dpsReprovisionPolicyReprovisionAndResetData   = "ReprovisionAndResetData"
+)
+
+type IotHubDpsEnrollmentGroupResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = IotHubDpsEnrollmentGroupResource{}
+	_ sdk.ResourceWithCustomizeDiff = IotHubDpsEnrollmentGroupResource{}
+)
+
+type IotHubDpsEnrollmentGroupModel struct {
+	Name                   string                           `tfschema:"name"`
+	IotHubDpsId            string                           `tfschema:"iothub_dps_id"`
+	X509CaReference        []IotHubDpsX509CaReference       `tfschema:"x509_ca_reference"`
+	X509SigningCertificate []IotHubDpsX509Certificate       `tfschema:"x509_signing_certificate"`
+	SymmetricKey           []IotHubDpsSymmetricKey          `tfschema:"symmetric_key"`
+	AllocationPolicy       string                           `tfschema:"allocation_policy"`
+	CustomAllocation       []IotHubDpsCustomAllocation      `tfschema:"custom_allocation"`
+	IotHubHostNames        []string                         `tfschema:"iothub_host_names"`
+	ReprovisionPolicy      string                           `tfschema:"reprovision_policy"`
+	InitialTwin            []IotHubDpsEnrollmentInitialTwin `tfschema:"initial_twin"`
+	IotEdgeEnabled         bool                             `tfschema:"iot_edge_enabled"`
+	Enabled                bool                             `tfschema:"enabled"`
+}
+
+type IotHubDpsX509CaReference struct {
+	PrimaryCertificateName   string `tfschema:"primary_certificate_name"`
+	SecondaryCertificateName string `tfschema:"secondary_certificate_name"`
+}
+
+type IotHubDpsX509Certificate struct {
+	PrimaryCertificate   string `tfschema:"primary_certificate"`
+	SecondaryCertificate string `tfschema:"secondary_certificate"`
+}
+
+type IotHubDpsSymmetricKey struct {
+	PrimaryKey   string `tfschema:"primary_key"`
+	SecondaryKey string `tfschema:"secondary_key"`
+}
+
+type IotHubDpsCustomAllocation struct {
+	WebhookUrl string `tfschema:"webhook_url"`
+	ApiVersion string `tfschema:"api_version"`
+}
+
+type IotHubDpsEnrollmentInitialTwin struct {
+	Tags              string `tfschema:"tags"`
+	DesiredProperties string `tfschema:"desired_properties"`
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	attestations := []string{"x509_ca_reference", "x509_signing_certificate", "symmetric_key"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.IotHubDpsEnrollmentName,
+		},
+
+		"iothub_dps_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateProvisioningServiceID,
+		},
+
+		"x509_ca_reference": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"primary_certificate_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secondary_certificate_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"x509_signing_certificate": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: dpsEnrollmentX509CertificateSchema(),
+			},
+		},
+
+		"symmetric_key": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: dpsEnrollmentSymmetricKeySchema(),
+			},
+		},
+
+		"allocation_policy": dpsEnrollmentAllocationPolicySchema(),
+
+		"custom_allocation": dpsEnrollmentCustomAllocationSchema(),
+
+		"iothub_host_names": dpsEnrollmentIotHubHostNamesSchema(),
+
+		"reprovision_policy": dpsEnrollmentReprovisionPolicySchema(),
+
+		"initial_twin": dpsEnrollmentInitialTwinSchema(),
+
+		"iot_edge_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) ResourceType() string {
+	return "azurerm_iothub_dps_enrollment_group"
+}
+
+func (r IotHubDpsEnrollmentGroupResource) ModelObject() interface{} {
+	return &IotHubDpsEnrollmentGroupModel{}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return enrollmentgroups.ValidateEnrollmentGroupID
+}
+
+func (r IotHubDpsEnrollmentGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := validateDpsEnrollmentAllocation(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			// the attestation mechanism of an Enrollment Group can't be changed once it's been created
+			return forceNewOnDpsEnrollmentAttestationChange(metadata.ResourceDiff, "x509_ca_reference", "x509_signing_certificate", "symmetric_key")
+		},
+	}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IotHubDpsEnrollmentGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dpsId, err := commonids.ParseProvisioningServiceID(model.IotHubDpsId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, *dpsId)
+			if err != nil {
+				return err
+			}
+			client := dataPlaneClient.EnrollmentGroupsClient
+
+			id := enrollmentgroups.NewEnrollmentGroupID(dpsId.SubscriptionId, dpsId.ResourceGroupName, dpsId.ProvisioningServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandDpsEnrollmentGroup(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters, enrollmentgroups.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := enrollmentgroups.ParseEnrollmentGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := dataPlaneClient.EnrollmentGroupsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the signing certificates aren't returned by the API, so are retained from the config
+			var config IotHubDpsEnrollmentGroupModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := IotHubDpsEnrollmentGroupModel{
+				Name:                   id.EnrollmentGroupName,
+				IotHubDpsId:            dpsId.ID(),
+				X509SigningCertificate: config.X509SigningCertificate,
+			}
+
+			if model := resp.Model; model != nil {
+				state.AllocationPolicy = flattenDpsAllocationPolicy(string(pointer.From(model.AllocationPolicy)))
+				state.Enabled = pointer.From(model.ProvisioningStatus) != enrollmentgroups.ProvisioningStatusDisabled
+				state.IotHubHostNames = pointer.From(model.IotHubs)
+				state.ReprovisionPolicy = dpsReprovisionPolicyReprovisionAndMigrateData
+				if v := model.ReprovisionPolicy; v != nil {
+					state.ReprovisionPolicy = flattenDpsReprovisionPolicy(v.UpdateHubAssignment, v.MigrateDeviceData)
+				}
+
+				if capabilities := model.Capabilities; capabilities != nil {
+					state.IotEdgeEnabled = capabilities.IotEdge
+				}
+
+				if v := model.CustomAllocationDefinition; v != nil {
+					state.CustomAllocation = flattenDpsCustomAllocation(v.WebhookUrl, v.ApiVersion, config.CustomAllocation)
+				}
+
+				if v := model.InitialTwin; v != nil {
+					var desired *map[string]interface{}
+					if v.Properties != nil {
+						desired = v.Properties.Desired
+					}
+					initialTwin, err := flattenDpsEnrollmentInitialTwin(v.Tags, desired)
+					if err != nil {
+						return err
+					}
+					state.InitialTwin = initialTwin
+				}
+
+				attestation := model.Attestation
+				if v := attestation.SymmetricKey; v != nil {
+					state.SymmetricKey = []IotHubDpsSymmetricKey{
+						{
+							PrimaryKey:   pointer.From(v.PrimaryKey),
+							SecondaryKey: pointer.From(v.SecondaryKey),
+						},
+					}
+				}
+
+				if attestation.X509 != nil && attestation.X509.CaReferences != nil {
+					state.X509CaReference = []IotHubDpsX509CaReference{
+						{
+							PrimaryCertificateName:   pointer.From(attestation.X509.CaReferences.Primary),
+							SecondaryCertificateName: pointer.From(attestation.X509.CaReferences.Secondary),
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := enrollmentgroups.ParseEnrollmentGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model IotHubDpsEnrollmentGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+			client := dataPlaneClient.EnrollmentGroupsClient
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			parameters, err := expandDpsEnrollmentGroup(model)
+			if err != nil {
+				return err
+			}
+
+			options := enrollmentgroups.CreateOrUpdateOperationOptions{
+				IfMatch: existing.Model.ETag,
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, *parameters, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r IotHubDpsEnrollmentGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := enrollmentgroups.ParseEnrollmentGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+
+			if resp, err := dataPlaneClient.EnrollmentGroupsClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandDpsEnrollmentGroup(model IotHubDpsEnrollmentGroupModel) (*enrollmentgroups.EnrollmentGroup, error) {
+	updateHubAssignment, migrateDeviceData := expandDpsReprovisionPolicy(model.ReprovisionPolicy)
+
+	provisioningStatus := enrollmentgroups.ProvisioningStatusEnabled
+	if !model.Enabled {
+		provisioningStatus = enrollmentgroups.ProvisioningStatusDisabled
+	}
+
+	output := enrollmentgroups.EnrollmentGroup{
+		EnrollmentGroupId: model.Name,
+		Capabilities: &enrollmentgroups.DeviceCapabilities{
+			IotEdge: model.IotEdgeEnabled,
+		},
+		ProvisioningStatus: pointer.To(provisioningStatus),
+		ReprovisionPolicy: &enrollmentgroups.ReprovisionPolicy{
+			UpdateHubAssignment: updateHubAssignment,
+			MigrateDeviceData:   migrateDeviceData,
+		},
+	}
+
+	if model.AllocationPolicy != "" {
+		output.AllocationPolicy = pointer.To(enrollmentgroups.AllocationPolicy(expandDpsAllocationPolicy(model.AllocationPolicy)))
+	}
+
+	if len(model.CustomAllocation) > 0 {
+		output.CustomAllocationDefinition = &enrollmentgroups.CustomAllocationDefinition{
+			WebhookUrl: model.CustomAllocation[0].WebhookUrl,
+			ApiVersion: model.CustomAllocation[0].ApiVersion,
+		}
+	}
+
+	if len(model.IotHubHostNames) > 0 {
+		output.IotHubs = pointer.To(model.IotHubHostNames)
+	}
+
+	if len(model.InitialTwin) > 0 {
+		tags, desired, err := expandDpsEnrollmentInitialTwin(model.InitialTwin[0])
+		if err != nil {
+			return nil, err
+		}
+		output.InitialTwin = &enrollmentgroups.InitialTwin{
+			Tags: tags,
+			Properties: &enrollmentgroups.InitialTwinProperties{
+				Desired: desired,
+			},
+		}
+	}
+
+	switch {
+	case len(model.SymmetricKey) > 0:
+		// the keys are generated by the Device Provisioning Service when they're omitted
+		symmetricKey := &enrollmentgroups.SymmetricKeyAttestation{}
+		if v := model.SymmetricKey[0]; v.PrimaryKey != "" {
+			symmetricKey.PrimaryKey = pointer.To(v.PrimaryKey)
+			symmetricKey.SecondaryKey = pointer.To(v.SecondaryKey)
+		}
+		output.Attestation = enrollmentgroups.AttestationMechanism{
+			Type:         enrollmentgroups.AttestationMechanismTypeSymmetricKey,
+			SymmetricKey: symmetricKey,
+		}
+
+	case len(model.X509CaReference) > 0:
+		references := &enrollmentgroups.X509CAReferences{
+			Primary: pointer.To(model.X509CaReference[0].PrimaryCertificateName),
+		}
+		if v := model.X509CaReference[0].SecondaryCertificateName; v != "" {
+			references.Secondary = pointer.To(v)
+		}
+		output.Attestation = enrollmentgroups.AttestationMechanism{
+			Type: enrollmentgroups.AttestationMechanismTypeXFiveZeroNine,
+			X509: &enrollmentgroups.X509Attestation{
+				CaReferences: references,
+			},
+		}
+
+	case len(model.X509SigningCertificate) > 0:
+		certificates := &enrollmentgroups.X509Certificates{
+			Primary: &enrollmentgroups.X509CertificateWithInfo{
+				Certificate: pointer.To(model.X509SigningCertificate[0].PrimaryCertificate),
+			},
+		}
+		if v := model.X509SigningCertificate[0].SecondaryCertificate; v != "" {
+			certificates.Secondary = &enrollmentgroups.X509CertificateWithInfo{
+				Certificate: pointer.To(v),
+			}
+		}
+		output.Attestation = enrollmentgroups.AttestationMechanism{
+			Type: enrollmentgroups.AttestationMechanismTypeXFiveZeroNine,
+			X509: &enrollmentgroups.X509Attestation{
+				SigningCertificates: certificates,
+			},
+		}
+	}
+
+	return &output, nil
+}
+
+func dpsEnrollmentX509CertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_certificate": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"secondary_certificate": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func dpsEnrollmentSymmetricKeySchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"symmetric_key.0.secondary_key"},
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"secondary_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"symmetric_key.0.primary_key"},
+			ValidateFunc: validation.StringIsBase64,
+		},
+	}
+}
+
+func dpsEnrollmentAllocationPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			dpsAllocationPolicyCustom,
+			dpsAllocationPolicyGeoLatency,
+			dpsAllocationPolicyHashed,
+			dpsAllocationPolicyStatic,
+		}, false),
+	}
+}
+
+func dpsEnrollmentCustomAllocationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"webhook_url": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"api_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "2021-10-01",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func dpsEnrollmentIotHubHostNamesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func dpsEnrollmentReprovisionPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  dpsReprovisionPolicyReprovisionAndMigrateData,
+		ValidateFunc: validation.StringInSlice([]string{
+			dpsReprovisionPolicyNeverReprovision,
+			dpsReprovisionPolicyReprovisionAndMigrateData,
+			dpsReprovisionPolicyReprovisionAndResetData,
+		}, false),
+	}
+}
+
+func dpsEnrollmentInitialTwinSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					AtLeastOneOf:     []string{"initial_twin.0.tags", "initial_twin.0.desired_properties"},
+				},
+
+				"desired_properties": {
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					AtLeastOneOf:     []string{"initial_twin.0.tags", "initial_twin.0.desired_properties"},
+				},
+			},
+		},
+	}
+}
+
+// validateDpsEnrollmentAllocation ensures that a `custom_allocation` block is specified only when the Allocation
+// Policy is `Custom`.
+func validateDpsEnrollmentAllocation(diff *pluginsdk.ResourceDiff) error {
+	allocationPolicy := diff.Get("allocation_policy").(string)
+	hasCustomAllocation := len(diff.Get("custom_allocation").([]interface{})) > 0
+
+	if allocationPolicy == dpsAllocationPolicyCustom && !hasCustomAllocation {
+		return fmt.Errorf("a `custom_allocation` block must be specified when `allocation_policy` is set to `%s`", dpsAllocationPolicyCustom)
+	}
+	if allocationPolicy != dpsAllocationPolicyCustom && hasCustomAllocation {
+		return fmt.Errorf("a `custom_allocation` block can only be specified when `allocation_policy` is set to `%s`", dpsAllocationPolicyCustom)
+	}
+
+	return nil
+}
+
+// forceNewOnDpsEnrollmentAttestationChange recreates the Enrollment when the attestation mechanism changes, since the
+// Device Provisioning Service doesn't allow the attestation mechanism of an existing Enrollment to be changed.
+func forceNewOnDpsEnrollmentAttestationChange(diff *pluginsdk.ResourceDiff, keys ...string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, key := range keys {
+		if !diff.HasChange(key) {
+			continue
+		}
+
+		oldVal, newVal := diff.GetChange(key)
+		if len(oldVal.([]interface{})) != len(newVal.([]interface{})) {
+			if err := diff.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandDpsAllocationPolicy converts the Allocation Policy into the camel-cased value used by the Data Plane API
+func expandDpsAllocationPolicy(input string) string {
+	return strings.ToLower(input[:1]) + input[1:]
+}
+
+func flattenDpsAllocationPolicy(input string) string {
+	for _, v := range []string{dpsAllocationPolicyCustom, dpsAllocationPolicyGeoLatency, dpsAllocationPolicyHashed, dpsAllocationPolicyStatic} {
+		if strings.EqualFold(v, input) {
+			return v
+		}
+	}
+	return ""
+}
+
+func expandDpsReprovisionPolicy(input string) (updateHubAssignment bool, migrateDeviceData bool) {
+	switch input {
+	case dpsReprovisionPolicyNeverReprovision:
+		return false, false
+	case dpsReprovisionPolicyReprovisionAndResetData:
+		return true, false
+	}
+	return true, true
+}
+
+func flattenDpsReprovisionPolicy(updateHubAssignment, migrateDeviceData bool) string {
+	switch {
+	case !updateHubAssignment:
+		return dpsReprovisionPolicyNeverReprovision
+	case !migrateDeviceData:
+		return dpsReprovisionPolicyReprovisionAndResetData
+	}
+	return dpsReprovisionPolicyReprovisionAndMigrateData
+}
+
+func flattenDpsCustomAllocation(webhookUrl, apiVersion string, config []IotHubDpsCustomAllocation) []IotHubDpsCustomAllocation {
+	// the webhook URL contains the Function Key, which may be redacted by the API
+	if webhookUrl == "" && len(config) > 0 {
+		webhookUrl = config[0].WebhookUrl
+	}
+
+	return []IotHubDpsCustomAllocation{
+		{
+			WebhookUrl: webhookUrl,
+			ApiVersion: apiVersion,
+		},
+	}
+}
+
+func expandDpsEnrollmentInitialTwin(input IotHubDpsEnrollmentInitialTwin) (*map[string]interface{}, *map[string]interface{}, error) {
+	var tags, desired *map[string]interface{}
+
+	if input.Tags != "" {
+		v := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(input.Tags), &v); err != nil {
+			return nil, nil, fmt.Errorf("unmarshaling `initial_twin.0.tags`: %+v", err)
+		}
+		tags = &v
+	}
+
+	if input.DesiredProperties != "" {
+		v := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(input.DesiredProperties), &v); err != nil {
+			return nil, nil, fmt.Errorf("unmarshaling `initial_twin.0.desired_properties`: %+v", err)
+		}
+		desired = &v
+	}
+
+	return tags, desired, nil
+}
+
+func flattenDpsEnrollmentInitialTwin(tags, desired *map[string]interface{}) ([]IotHubDpsEnrollmentInitialTwin, error) {
+	output := IotHubDpsEnrollmentInitialTwin{}
+
+	if tags != nil && len(*tags) > 0 {
+		v, err := json.Marshal(*tags)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling `initial_twin.0.tags`: %+v", err)
+		}
+		output.Tags = string(v)
+	}
+
+	if desired != nil {
+		// the `$metadata` and `$version` properties are managed by the Device Provisioning Service
+		properties := make(map[string]interface{})
+		for k, v := range *desired {
+			if !strings.HasPrefix(k, "$") {
+				properties[k] = v
+			}
+		}
+
+		if len(properties) > 0 {
+			v, err := json.Marshal(properties)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling `initial_twin.0.desired_properties`: %+v", err)
+			}
+			output.DesiredProperties = string(v)
+		}
+	}
+
+	if output.Tags == "" && output.DesiredProperties == "" {
+		return []IotHubDpsEnrollmentInitialTwin{}, nil
+	}
+
+	return []IotHubDpsEnrollmentInitialTwin{output}, nil
+}
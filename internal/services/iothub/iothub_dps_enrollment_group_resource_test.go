@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/enrollmentgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type IotHubDpsEnrollmentGroupResource struct{}
+
+func TestAccIotHubDpsEnrollmentGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("symmetric_key.0.primary_key").Exists(),
+				check.That(data.ResourceName).Key("symmetric_key.0.secondary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsEnrollmentGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIotHubDpsEnrollmentGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsEnrollmentGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsEnrollmentGroup_x509CaReference(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.x509CaReference(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsEnrollmentGroup_x509SigningCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_enrollment_group", "test")
+	r := IotHubDpsEnrollmentGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.x509SigningCertificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("x509_signing_certificate"),
+	})
+}
+
+func (IotHubDpsEnrollmentGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := enrollmentgroups.ParseEnrollmentGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+	dataPlaneClient, err := clients.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dataPlaneClient.EnrollmentGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (IotHubDpsEnrollmentGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = "1"
+  }
+}
+
+resource "azurerm_iothub_shared_access_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest"
+
+  registry_read   = true
+  registry_write  = true
+  service_connect = true
+}
+
+resource "azurerm_iothub_dps" "test" {
+  name                = "acctestIoTDPS-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  linked_hub {
+    connection_string = azurerm_iothub_shared_access_policy.test.primary_connection_string
+    location          = azurerm_resource_group.test.location
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r IotHubDpsEnrollmentGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_enrollment_group" "test" {
+  name          = "acctest-group-%d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+
+  symmetric_key {}
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsEnrollmentGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_enrollment_group" "import" {
+  name          = azurerm_iothub_dps_enrollment_group.test.name
+  iothub_dps_id = azurerm_iothub_dps_enrollment_group.test.iothub_dps_id
+
+  symmetric_key {}
+}
+`, r.basic(data))
+}
+
+func (r IotHubDpsEnrollmentGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_enrollment_group" "test" {
+  name               = "acctest-group-%d"
+  iothub_dps_id      = azurerm_iothub_dps.test.id
+  allocation_policy  = "Static"
+  iothub_host_names  = [azurerm_iothub.test.hostname]
+  reprovision_policy = "ReprovisionAndResetData"
+  iot_edge_enabled   = true
+  enabled            = false
+
+  symmetric_key {
+    primary_key   = "dGVzdC1wcmltYXJ5LWtleS0wMTIzNDU2Nzg5MDEyMzQ1"
+    secondary_key = "dGVzdC1zZWNvbmRhcnkta2V5LTAxMjM0NTY3ODkwMTIz"
+  }
+
+  initial_twin {
+    tags = jsonencode({
+      environment = "test"
+    })
+    desired_properties = jsonencode({
+      telemetryInterval = 30
+    })
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsEnrollmentGroupResource) x509CaReference(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_iothub_dps_certificate" "test" {
+  name                = "acctestIoTDPSCertificate-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  iot_dps_name        = azurerm_iothub_dps.test.name
+  is_verified         = true
+
+  certificate_content = filebase64("testdata/batch_certificate.cer")
+}
+
+resource "azurerm_iothub_dps_enrollment_group" "test" {
+  name          = "acctest-group-%[2]d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+
+  x509_ca_reference {
+    primary_certificate_name = azurerm_iothub_dps_certificate.test.name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsEnrollmentGroupResource) x509SigningCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_enrollment_group" "test" {
+  name          = "acctest-group-%d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+
+  x509_signing_certificate {
+    primary_certificate = filebase64("testdata/iothub_test.cer")
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
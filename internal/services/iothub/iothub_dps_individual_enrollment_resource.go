@@ -0,0 +1,480 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/individualenrollments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type IotHubDpsIndividualEnrollmentResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = IotHubDpsIndividualEnrollmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = IotHubDpsIndividualEnrollmentResource{}
+)
+
+type IotHubDpsIndividualEnrollmentModel struct {
+	Name                  string                           `tfschema:"name"`
+	IotHubDpsId           string                           `tfschema:"iothub_dps_id"`
+	DeviceId              string                           `tfschema:"device_id"`
+	Tpm                   []IotHubDpsTpm                   `tfschema:"tpm"`
+	X509ClientCertificate []IotHubDpsX509Certificate       `tfschema:"x509_client_certificate"`
+	SymmetricKey          []IotHubDpsSymmetricKey          `tfschema:"symmetric_key"`
+	AllocationPolicy      string                           `tfschema:"allocation_policy"`
+	CustomAllocation      []IotHubDpsCustomAllocation      `tfschema:"custom_allocation"`
+	IotHubHostNames       []string                         `tfschema:"iothub_host_names"`
+	ReprovisionPolicy     string                           `tfschema:"reprovision_policy"`
+	InitialTwin           []IotHubDpsEnrollmentInitialTwin `tfschema:"initial_twin"`
+	IotEdgeEnabled        bool                             `tfschema:"iot_edge_enabled"`
+	Enabled               bool                             `tfschema:"enabled"`
+}
+
+type IotHubDpsTpm struct {
+	EndorsementKey string `tfschema:"endorsement_key"`
+	StorageRootKey string `tfschema:"storage_root_key"`
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Arguments() map[string]*pluginsdk.Schema {
+	attestations := []string{"tpm", "x509_client_certificate", "symmetric_key"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.IotHubDpsEnrollmentName,
+		},
+
+		"iothub_dps_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateProvisioningServiceID,
+		},
+
+		"device_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tpm": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"endorsement_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"storage_root_key": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+				},
+			},
+		},
+
+		"x509_client_certificate": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: dpsEnrollmentX509CertificateSchema(),
+			},
+		},
+
+		"symmetric_key": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: attestations,
+			Elem: &pluginsdk.Resource{
+				Schema: dpsEnrollmentSymmetricKeySchema(),
+			},
+		},
+
+		"allocation_policy": dpsEnrollmentAllocationPolicySchema(),
+
+		"custom_allocation": dpsEnrollmentCustomAllocationSchema(),
+
+		"iothub_host_names": dpsEnrollmentIotHubHostNamesSchema(),
+
+		"reprovision_policy": dpsEnrollmentReprovisionPolicySchema(),
+
+		"initial_twin": dpsEnrollmentInitialTwinSchema(),
+
+		"iot_edge_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) ResourceType() string {
+	return "azurerm_iothub_dps_individual_enrollment"
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) ModelObject() interface{} {
+	return &IotHubDpsIndividualEnrollmentModel{}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return individualenrollments.ValidateIndividualEnrollmentID
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := validateDpsEnrollmentAllocation(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			return forceNewOnDpsEnrollmentAttestationChange(metadata.ResourceDiff, "tpm", "x509_client_certificate", "symmetric_key")
+		},
+	}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IotHubDpsIndividualEnrollmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dpsId, err := commonids.ParseProvisioningServiceID(model.IotHubDpsId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, *dpsId)
+			if err != nil {
+				return err
+			}
+			client := dataPlaneClient.IndividualEnrollmentsClient
+
+			id := individualenrollments.NewIndividualEnrollmentID(dpsId.SubscriptionId, dpsId.ResourceGroupName, dpsId.ProvisioningServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandDpsIndividualEnrollment(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters, individualenrollments.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := individualenrollments.ParseIndividualEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := dataPlaneClient.IndividualEnrollmentsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the client certificates and the Storage Root Key aren't returned by the API, so are retained from the config
+			var config IotHubDpsIndividualEnrollmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := IotHubDpsIndividualEnrollmentModel{
+				Name:                  id.IndividualEnrollmentName,
+				IotHubDpsId:           dpsId.ID(),
+				X509ClientCertificate: config.X509ClientCertificate,
+			}
+
+			if model := resp.Model; model != nil {
+				state.AllocationPolicy = flattenDpsAllocationPolicy(string(pointer.From(model.AllocationPolicy)))
+				state.DeviceId = pointer.From(model.DeviceId)
+				state.Enabled = pointer.From(model.ProvisioningStatus) != individualenrollments.ProvisioningStatusDisabled
+				state.IotHubHostNames = pointer.From(model.IotHubs)
+
+				state.ReprovisionPolicy = dpsReprovisionPolicyReprovisionAndMigrateData
+				if v := model.ReprovisionPolicy; v != nil {
+					state.ReprovisionPolicy = flattenDpsReprovisionPolicy(v.UpdateHubAssignment, v.MigrateDeviceData)
+				}
+
+				if capabilities := model.Capabilities; capabilities != nil {
+					state.IotEdgeEnabled = capabilities.IotEdge
+				}
+
+				if v := model.CustomAllocationDefinition; v != nil {
+					state.CustomAllocation = flattenDpsCustomAllocation(v.WebhookUrl, v.ApiVersion, config.CustomAllocation)
+				}
+
+				if v := model.InitialTwin; v != nil {
+					var desired *map[string]interface{}
+					if v.Properties != nil {
+						desired = v.Properties.Desired
+					}
+					initialTwin, err := flattenDpsEnrollmentInitialTwin(v.Tags, desired)
+					if err != nil {
+						return err
+					}
+					state.InitialTwin = initialTwin
+				}
+
+				attestation := model.Attestation
+				if v := attestation.SymmetricKey; v != nil {
+					state.SymmetricKey = []IotHubDpsSymmetricKey{
+						{
+							PrimaryKey:   pointer.From(v.PrimaryKey),
+							SecondaryKey: pointer.From(v.SecondaryKey),
+						},
+					}
+				}
+
+				if v := attestation.Tpm; v != nil {
+					storageRootKey := pointer.From(v.StorageRootKey)
+					if storageRootKey == "" && len(config.Tpm) > 0 {
+						storageRootKey = config.Tpm[0].StorageRootKey
+					}
+					state.Tpm = []IotHubDpsTpm{
+						{
+							EndorsementKey: v.EndorsementKey,
+							StorageRootKey: storageRootKey,
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := individualenrollments.ParseIndividualEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model IotHubDpsIndividualEnrollmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+			client := dataPlaneClient.IndividualEnrollmentsClient
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			parameters, err := expandDpsIndividualEnrollment(model)
+			if err != nil {
+				return err
+			}
+
+			options := individualenrollments.CreateOrUpdateOperationOptions{
+				IfMatch: existing.Model.ETag,
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, *parameters, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := individualenrollments.ParseIndividualEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+			dataPlaneClient, err := metadata.Client.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+			if err != nil {
+				return err
+			}
+
+			if resp, err := dataPlaneClient.IndividualEnrollmentsClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandDpsIndividualEnrollment(model IotHubDpsIndividualEnrollmentModel) (*individualenrollments.IndividualEnrollment, error) {
+	updateHubAssignment, migrateDeviceData := expandDpsReprovisionPolicy(model.ReprovisionPolicy)
+
+	provisioningStatus := individualenrollments.ProvisioningStatusEnabled
+	if !model.Enabled {
+		provisioningStatus = individualenrollments.ProvisioningStatusDisabled
+	}
+
+	output := individualenrollments.IndividualEnrollment{
+		RegistrationId: model.Name,
+		Capabilities: &individualenrollments.DeviceCapabilities{
+			IotEdge: model.IotEdgeEnabled,
+		},
+		ProvisioningStatus: pointer.To(provisioningStatus),
+		ReprovisionPolicy: &individualenrollments.ReprovisionPolicy{
+			UpdateHubAssignment: updateHubAssignment,
+			MigrateDeviceData:   migrateDeviceData,
+		},
+	}
+
+	if model.DeviceId != "" {
+		output.DeviceId = pointer.To(model.DeviceId)
+	}
+
+	if model.AllocationPolicy != "" {
+		output.AllocationPolicy = pointer.To(individualenrollments.AllocationPolicy(expandDpsAllocationPolicy(model.AllocationPolicy)))
+	}
+
+	if len(model.CustomAllocation) > 0 {
+		output.CustomAllocationDefinition = &individualenrollments.CustomAllocationDefinition{
+			WebhookUrl: model.CustomAllocation[0].WebhookUrl,
+			ApiVersion: model.CustomAllocation[0].ApiVersion,
+		}
+	}
+
+	if len(model.IotHubHostNames) > 0 {
+		output.IotHubs = pointer.To(model.IotHubHostNames)
+	}
+
+	if len(model.InitialTwin) > 0 {
+		tags, desired, err := expandDpsEnrollmentInitialTwin(model.InitialTwin[0])
+		if err != nil {
+			return nil, err
+		}
+		output.InitialTwin = &individualenrollments.InitialTwin{
+			Tags: tags,
+			Properties: &individualenrollments.InitialTwinProperties{
+				Desired: desired,
+			},
+		}
+	}
+
+	switch {
+	case len(model.SymmetricKey) > 0:
+		// the keys are generated by the Device Provisioning Service when they're omitted
+		symmetricKey := &individualenrollments.SymmetricKeyAttestation{}
+		if v := model.SymmetricKey[0]; v.PrimaryKey != "" {
+			symmetricKey.PrimaryKey = pointer.To(v.PrimaryKey)
+			symmetricKey.SecondaryKey = pointer.To(v.SecondaryKey)
+		}
+		output.Attestation = individualenrollments.AttestationMechanism{
+			Type:         individualenrollments.AttestationMechanismTypeSymmetricKey,
+			SymmetricKey: symmetricKey,
+		}
+
+	case len(model.Tpm) > 0:
+		tpm := &individualenrollments.TpmAttestation{
+			EndorsementKey: model.Tpm[0].EndorsementKey,
+		}
+		if v := model.Tpm[0].StorageRootKey; v != "" {
+			tpm.StorageRootKey = pointer.To(v)
+		}
+		output.Attestation = individualenrollments.AttestationMechanism{
+			Type: individualenrollments.AttestationMechanismTypeTpm,
+			Tpm:  tpm,
+		}
+
+	case len(model.X509ClientCertificate) > 0:
+		certificates := &individualenrollments.X509Certificates{
+			Primary: &individualenrollments.X509CertificateWithInfo{
+				Certificate: pointer.To(model.X509ClientCertificate[0].PrimaryCertificate),
+			},
+		}
+		if v := model.X509ClientCertificate[0].SecondaryCertificate; v != "" {
+			certificates.Secondary = &individualenrollments.X509CertificateWithInfo{
+				Certificate: pointer.To(v),
+			}
+		}
+		output.Attestation = individualenrollments.AttestationMechanism{
+			Type: individualenrollments.AttestationMechanismTypeXFiveZeroNine,
+			X509: &individualenrollments.X509Attestation{
+				ClientCertificates: certificates,
+			},
+		}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/individualenrollments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type IotHubDpsIndividualEnrollmentResource struct{}
+
+func TestAccIotHubDpsIndividualEnrollment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("symmetric_key.0.primary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsIndividualEnrollment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIotHubDpsIndividualEnrollment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsIndividualEnrollment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDpsIndividualEnrollment_x509ClientCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.x509ClientCertificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("x509_client_certificate"),
+	})
+}
+
+func TestAccIotHubDpsIndividualEnrollment_tpm(t *testing.T) {
+	endorsementKey := os.Getenv("ARM_TEST_DPS_TPM_ENDORSEMENT_KEY")
+	if endorsementKey == "" {
+		t.Skip("Skipping as `ARM_TEST_DPS_TPM_ENDORSEMENT_KEY` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_individual_enrollment", "test")
+	r := IotHubDpsIndividualEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tpm(data, endorsementKey),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (IotHubDpsIndividualEnrollmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := individualenrollments.ParseIndividualEnrollmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dpsId := commonids.NewProvisioningServiceID(id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName)
+	dataPlaneClient, err := clients.IoTHub.DPSDataPlaneClient(ctx, dpsId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dataPlaneClient.IndividualEnrollmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_individual_enrollment" "test" {
+  name          = "acctest-device-%d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+
+  symmetric_key {}
+}
+`, IotHubDpsEnrollmentGroupResource{}.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_individual_enrollment" "import" {
+  name          = azurerm_iothub_dps_individual_enrollment.test.name
+  iothub_dps_id = azurerm_iothub_dps_individual_enrollment.test.iothub_dps_id
+
+  symmetric_key {}
+}
+`, r.basic(data))
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_iothub_dps_individual_enrollment" "test" {
+  name               = "acctest-device-%[2]d"
+  iothub_dps_id      = azurerm_iothub_dps.test.id
+  allocation_policy  = "Hashed"
+  iothub_host_names  = [azurerm_iothub.test.hostname]
+  reprovision_policy = "NeverReprovision"
+  iot_edge_enabled   = true
+  enabled            = false
+
+  symmetric_key {
+    primary_key   = "dGVzdC1wcmltYXJ5LWtleS0wMTIzNDU2Nzg5MDEyMzQ1"
+    secondary_key = "dGVzdC1zZWNvbmRhcnkta2V5LTAxMjM0NTY3ODkwMTIz"
+  }
+
+  initial_twin {
+    tags = jsonencode({
+      environment = "test"
+    })
+    desired_properties = jsonencode({
+      telemetryInterval = 30
+    })
+  }
+}
+`, IotHubDpsEnrollmentGroupResource{}.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) x509ClientCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_individual_enrollment" "test" {
+  name          = "acctest-device-%d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+  device_id     = "acctest-device"
+
+  x509_client_certificate {
+    primary_certificate = filebase64("testdata/iothub_test.cer")
+  }
+}
+`, IotHubDpsEnrollmentGroupResource{}.template(data), data.RandomInteger)
+}
+
+func (r IotHubDpsIndividualEnrollmentResource) tpm(data acceptance.TestData, endorsementKey string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_individual_enrollment" "test" {
+  name          = "acctest-device-%d"
+  iothub_dps_id = azurerm_iothub_dps.test.id
+
+  tpm {
+    endorsement_key = "%s"
+  }
+}
+`, IotHubDpsEnrollmentGroupResource{}.template(data), data.RandomInteger, endorsementKey)
+}
@@ -68,5 +68,7 @@ func (r Registration) Resources() []sdk.Resource {
 		IotHubDeviceUpdateInstanceResource{},
 		IotHubFileUploadResource{},
 		IotHubEndpointCosmosDBAccountResource{},
+		IotHubDpsEnrollmentGroupResource{},
+		IotHubDpsIndividualEnrollmentResource{},
 	}
 }
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enrollmentgroups
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/provisioningclient"
+)
+
+const defaultApiVersion = "2021-10-01"
+
+type EnrollmentGroupsClient struct {
+	Client *provisioningclient.Client
+}
+
+func NewEnrollmentGroupsClientWithBaseURI(endpoint string) (*EnrollmentGroupsClient, error) {
+	client, err := provisioningclient.NewClient(endpoint, "enrollmentgroups", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating EnrollmentGroupsClient: %+v", err)
+	}
+
+	return &EnrollmentGroupsClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enrollmentgroups
+
+type AllocationPolicy string
+
+const (
+	AllocationPolicyCustom     AllocationPolicy = "custom"
+	AllocationPolicyGeoLatency AllocationPolicy = "geoLatency"
+	AllocationPolicyHashed     AllocationPolicy = "hashed"
+	AllocationPolicyStatic     AllocationPolicy = "static"
+)
+
+func PossibleValuesForAllocationPolicy() []string {
+	return []string{
+		string(AllocationPolicyCustom),
+		string(AllocationPolicyGeoLatency),
+		string(AllocationPolicyHashed),
+		string(AllocationPolicyStatic),
+	}
+}
+
+type AttestationMechanismType string
+
+const (
+	AttestationMechanismTypeNone          AttestationMechanismType = "none"
+	AttestationMechanismTypeSymmetricKey  AttestationMechanismType = "symmetricKey"
+	AttestationMechanismTypeTpm           AttestationMechanismType = "tpm"
+	AttestationMechanismTypeXFiveZeroNine AttestationMechanismType = "x509"
+)
+
+func PossibleValuesForAttestationMechanismType() []string {
+	return []string{
+		string(AttestationMechanismTypeNone),
+		string(AttestationMechanismTypeSymmetricKey),
+		string(AttestationMechanismTypeTpm),
+		string(AttestationMechanismTypeXFiveZeroNine),
+	}
+}
+
+type ProvisioningStatus string
+
+const (
+	ProvisioningStatusDisabled ProvisioningStatus = "disabled"
+	ProvisioningStatusEnabled  ProvisioningStatus = "enabled"
+)
+
+func PossibleValuesForProvisioningStatus() []string {
+	return []string{
+		string(ProvisioningStatusDisabled),
+		string(ProvisioningStatusEnabled),
+	}
+}
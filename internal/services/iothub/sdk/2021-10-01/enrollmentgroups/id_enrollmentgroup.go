@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enrollmentgroups
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&EnrollmentGroupId{})
+}
+
+var _ resourceids.ResourceId = &EnrollmentGroupId{}
+
+// EnrollmentGroupId is a struct representing the Resource ID for an Enrollment Group
+//
+// NOTE: Enrollment Groups are Data Plane resources, this ID is nested beneath the Device Provisioning Service so that the
+// Device Provisioning Service (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type EnrollmentGroupId struct {
+	SubscriptionId          string
+	ResourceGroupName       string
+	ProvisioningServiceName string
+	EnrollmentGroupName     string
+}
+
+// NewEnrollmentGroupID returns a new EnrollmentGroupId struct
+func NewEnrollmentGroupID(subscriptionId string, resourceGroupName string, provisioningServiceName string, enrollmentGroupName string) EnrollmentGroupId {
+	return EnrollmentGroupId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroupName:       resourceGroupName,
+		ProvisioningServiceName: provisioningServiceName,
+		EnrollmentGroupName:     enrollmentGroupName,
+	}
+}
+
+// ParseEnrollmentGroupID parses 'input' into an EnrollmentGroupId
+func ParseEnrollmentGroupID(input string) (*EnrollmentGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&EnrollmentGroupId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := EnrollmentGroupId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseEnrollmentGroupIDInsensitively parses 'input' case-insensitively into an EnrollmentGroupId
+// note: this method should only be used for API response data and not user input
+func ParseEnrollmentGroupIDInsensitively(input string) (*EnrollmentGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&EnrollmentGroupId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := EnrollmentGroupId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *EnrollmentGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProvisioningServiceName, ok = input.Parsed["provisioningServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "provisioningServiceName", input)
+	}
+
+	if id.EnrollmentGroupName, ok = input.Parsed["enrollmentGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "enrollmentGroupName", input)
+	}
+
+	return nil
+}
+
+// ValidateEnrollmentGroupID checks that 'input' can be parsed as an Enrollment Group ID
+func ValidateEnrollmentGroupID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseEnrollmentGroupID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Enrollment Group ID
+func (id EnrollmentGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/provisioningServices/%s/enrollmentGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName, id.EnrollmentGroupName)
+}
+
+// DataPlanePath returns the path of this Enrollment Group within the Data Plane API of the Device Provisioning Service
+func (id EnrollmentGroupId) DataPlanePath() string {
+	return fmt.Sprintf("/enrollmentGroups/%s", id.EnrollmentGroupName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Enrollment Group ID
+func (id EnrollmentGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticProvisioningServices", "provisioningServices", "provisioningServices"),
+		resourceids.UserSpecifiedSegment("provisioningServiceName", "provisioningServiceName"),
+		resourceids.StaticSegment("staticEnrollmentGroups", "enrollmentGroups", "enrollmentGroups"),
+		resourceids.UserSpecifiedSegment("enrollmentGroupName", "enrollmentGroupName"),
+	}
+}
+
+// String returns a human-readable description of this Enrollment Group ID
+func (id EnrollmentGroupId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Provisioning Service Name: %q", id.ProvisioningServiceName),
+		fmt.Sprintf("Enrollment Group Name: %q", id.EnrollmentGroupName),
+	}
+	return fmt.Sprintf("Enrollment Group (%s)", strings.Join(components, "\n"))
+}
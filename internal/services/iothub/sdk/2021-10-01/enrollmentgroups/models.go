@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enrollmentgroups
+
+type EnrollmentGroup struct {
+	AllocationPolicy           *AllocationPolicy           `json:"allocationPolicy,omitempty"`
+	Attestation                AttestationMechanism        `json:"attestation"`
+	Capabilities               *DeviceCapabilities         `json:"capabilities,omitempty"`
+	CreatedDateTimeUtc         *string                     `json:"createdDateTimeUtc,omitempty"`
+	CustomAllocationDefinition *CustomAllocationDefinition `json:"customAllocationDefinition,omitempty"`
+	EnrollmentGroupId          string                      `json:"enrollmentGroupId"`
+	ETag                       *string                     `json:"etag,omitempty"`
+	InitialTwin                *InitialTwin                `json:"initialTwin,omitempty"`
+	IotHubs                    *[]string                   `json:"iotHubs,omitempty"`
+	LastUpdatedDateTimeUtc     *string                     `json:"lastUpdatedDateTimeUtc,omitempty"`
+	ProvisioningStatus         *ProvisioningStatus         `json:"provisioningStatus,omitempty"`
+	ReprovisionPolicy          *ReprovisionPolicy          `json:"reprovisionPolicy,omitempty"`
+}
+
+type AttestationMechanism struct {
+	SymmetricKey *SymmetricKeyAttestation `json:"symmetricKey,omitempty"`
+	Tpm          *TpmAttestation          `json:"tpm,omitempty"`
+	Type         AttestationMechanismType `json:"type"`
+	X509         *X509Attestation         `json:"x509,omitempty"`
+}
+
+type CustomAllocationDefinition struct {
+	ApiVersion string `json:"apiVersion"`
+	WebhookUrl string `json:"webhookUrl"`
+}
+
+type DeviceCapabilities struct {
+	IotEdge bool `json:"iotEdge"`
+}
+
+type InitialTwin struct {
+	Properties *InitialTwinProperties  `json:"properties,omitempty"`
+	Tags       *map[string]interface{} `json:"tags,omitempty"`
+}
+
+type InitialTwinProperties struct {
+	Desired *map[string]interface{} `json:"desired,omitempty"`
+}
+
+type ReprovisionPolicy struct {
+	MigrateDeviceData   bool `json:"migrateDeviceData"`
+	UpdateHubAssignment bool `json:"updateHubAssignment"`
+}
+
+type SymmetricKeyAttestation struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
+
+type TpmAttestation struct {
+	EndorsementKey string  `json:"endorsementKey"`
+	StorageRootKey *string `json:"storageRootKey,omitempty"`
+}
+
+type X509Attestation struct {
+	CaReferences        *X509CAReferences `json:"caReferences,omitempty"`
+	ClientCertificates  *X509Certificates `json:"clientCertificates,omitempty"`
+	SigningCertificates *X509Certificates `json:"signingCertificates,omitempty"`
+}
+
+type X509CAReferences struct {
+	Primary   *string `json:"primary,omitempty"`
+	Secondary *string `json:"secondary,omitempty"`
+}
+
+type X509CertificateInfo struct {
+	NotAfterUtc      *string `json:"notAfterUtc,omitempty"`
+	NotBeforeUtc     *string `json:"notBeforeUtc,omitempty"`
+	Sha1Thumbprint   *string `json:"sha1Thumbprint,omitempty"`
+	Sha256Thumbprint *string `json:"sha256Thumbprint,omitempty"`
+	SubjectName      *string `json:"subjectName,omitempty"`
+}
+
+type X509CertificateWithInfo struct {
+	Certificate *string              `json:"certificate,omitempty"`
+	Info        *X509CertificateInfo `json:"info,omitempty"`
+}
+
+type X509Certificates struct {
+	Primary   *X509CertificateWithInfo `json:"primary,omitempty"`
+	Secondary *X509CertificateWithInfo `json:"secondary,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package individualenrollments
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/sdk/2021-10-01/provisioningclient"
+)
+
+const defaultApiVersion = "2021-10-01"
+
+type IndividualEnrollmentsClient struct {
+	Client *provisioningclient.Client
+}
+
+func NewIndividualEnrollmentsClientWithBaseURI(endpoint string) (*IndividualEnrollmentsClient, error) {
+	client, err := provisioningclient.NewClient(endpoint, "individualenrollments", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IndividualEnrollmentsClient: %+v", err)
+	}
+
+	return &IndividualEnrollmentsClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package individualenrollments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&IndividualEnrollmentId{})
+}
+
+var _ resourceids.ResourceId = &IndividualEnrollmentId{}
+
+// IndividualEnrollmentId is a struct representing the Resource ID for an Individual Enrollment
+//
+// NOTE: Individual Enrollments are Data Plane resources, this ID is nested beneath the Device Provisioning Service so that the
+// Device Provisioning Service (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type IndividualEnrollmentId struct {
+	SubscriptionId           string
+	ResourceGroupName        string
+	ProvisioningServiceName  string
+	IndividualEnrollmentName string
+}
+
+// NewIndividualEnrollmentID returns a new IndividualEnrollmentId struct
+func NewIndividualEnrollmentID(subscriptionId string, resourceGroupName string, provisioningServiceName string, individualEnrollmentName string) IndividualEnrollmentId {
+	return IndividualEnrollmentId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroupName:        resourceGroupName,
+		ProvisioningServiceName:  provisioningServiceName,
+		IndividualEnrollmentName: individualEnrollmentName,
+	}
+}
+
+// ParseIndividualEnrollmentID parses 'input' into an IndividualEnrollmentId
+func ParseIndividualEnrollmentID(input string) (*IndividualEnrollmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndividualEnrollmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndividualEnrollmentId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseIndividualEnrollmentIDInsensitively parses 'input' case-insensitively into an IndividualEnrollmentId
+// note: this method should only be used for API response data and not user input
+func ParseIndividualEnrollmentIDInsensitively(input string) (*IndividualEnrollmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndividualEnrollmentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndividualEnrollmentId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *IndividualEnrollmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProvisioningServiceName, ok = input.Parsed["provisioningServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "provisioningServiceName", input)
+	}
+
+	if id.IndividualEnrollmentName, ok = input.Parsed["individualEnrollmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "individualEnrollmentName", input)
+	}
+
+	return nil
+}
+
+// ValidateIndividualEnrollmentID checks that 'input' can be parsed as an Individual Enrollment ID
+func ValidateIndividualEnrollmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseIndividualEnrollmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Individual Enrollment ID
+func (id IndividualEnrollmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/provisioningServices/%s/individualEnrollments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ProvisioningServiceName, id.IndividualEnrollmentName)
+}
+
+// DataPlanePath returns the path of this Individual Enrollment within the Data Plane API of the Device Provisioning Service
+func (id IndividualEnrollmentId) DataPlanePath() string {
+	return fmt.Sprintf("/individualEnrollments/%s", id.IndividualEnrollmentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Individual Enrollment ID
+func (id IndividualEnrollmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticProvisioningServices", "provisioningServices", "provisioningServices"),
+		resourceids.UserSpecifiedSegment("provisioningServiceName", "provisioningServiceName"),
+		resourceids.StaticSegment("staticIndividualEnrollments", "individualEnrollments", "individualEnrollments"),
+		resourceids.UserSpecifiedSegment("individualEnrollmentName", "individualEnrollmentName"),
+	}
+}
+
+// String returns a human-readable description of this Individual Enrollment ID
+func (id IndividualEnrollmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Provisioning Service Name: %q", id.ProvisioningServiceName),
+		fmt.Sprintf("Individual Enrollment Name: %q", id.IndividualEnrollmentName),
+	}
+	return fmt.Sprintf("Individual Enrollment (%s)", strings.Join(components, "\n"))
+}
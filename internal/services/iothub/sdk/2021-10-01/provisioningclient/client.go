@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provisioningclient
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// NOTE: the IoT Hub Device Provisioning Service Data Plane API isn't available in `hashicorp/go-azure-sdk`, this
+// package (and the packages alongside it) mirror the layout of the generated SDK so that they can be swapped out for
+// the upstream packages once they become available.
+
+var _ client.BaseClient = &Client{}
+
+type Client struct {
+	*dataplane.Client
+}
+
+func NewClient(endpoint string, serviceName, apiVersion string) (*Client, error) {
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("parsing the endpoint %q: %+v", endpoint, err)
+	}
+
+	return &Client{
+		Client: dataplane.NewDataPlaneClient(endpoint, fmt.Sprintf("deviceprovisioningservices/%s", serviceName), apiVersion),
+	}, nil
+}
+
+// WithSharedAccessPolicy configures the Client to authenticate using a Shared Access Signature generated from the
+// specified Shared Access Policy of the Device Provisioning Service, rather than a bearer token.
+func (c *Client) WithSharedAccessPolicy(hostName, policyName, key string) error {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("decoding the key for the Shared Access Policy %q: %+v", policyName, err)
+	}
+
+	c.Client.Client.AuthorizeRequest = func(_ context.Context, req *http.Request, _ auth.Authorizer) error {
+		req.Header.Set("Authorization", sharedAccessSignature(hostName, policyName, decodedKey, time.Now().Add(time.Hour)))
+		return nil
+	}
+
+	return nil
+}
+
+// sharedAccessSignature builds a Shared Access Signature for the Device Provisioning Service, as documented at
+// https://learn.microsoft.com/azure/iot-dps/how-to-control-access
+func sharedAccessSignature(hostName, policyName string, key []byte, expiry time.Time) string {
+	resourceUri := url.QueryEscape(strings.ToLower(hostName))
+	expiresOn := expiry.Unix()
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(fmt.Sprintf("%s\n%d", resourceUri, expiresOn)))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%d&skn=%s", resourceUri, url.QueryEscape(signature), expiresOn, policyName)
+}
+
+func (c *Client) NewRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, fmt.Errorf("internal-error: the context used must have a deadline attached for polling purposes, but got no deadline")
+	}
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("internal-error: pre-validating request payload: %+v", err)
+	}
+
+	req, err := c.Client.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("building %s request: %+v", input.HttpMethod, err)
+	}
+
+	req.Client = c
+
+	query := url.Values{}
+	query.Set("api-version", c.Client.ApiVersion)
+	if input.OptionsObject != nil {
+		if h := input.OptionsObject.ToHeaders(); h != nil {
+			for k, v := range h.Headers() {
+				req.Header[k] = v
+			}
+		}
+
+		if q := input.OptionsObject.ToQuery(); q != nil {
+			for k, v := range q.Values() {
+				query.Del(k)
+				query.Add(k, v[0])
+			}
+		}
+	}
+
+	req.URL.RawQuery = query.Encode()
+	req.RetryFunc = input.RetryFunc
+	req.ValidStatusCodes = input.ExpectedStatusCodes
+
+	return req, nil
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// IotHubDpsEnrollmentName validates the ID of an Enrollment Group or the Registration ID of an Individual Enrollment
+// within a Device Provisioning Service.
+func IotHubDpsEnrollmentName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9\-._:]*[a-zA-Z0-9\-]$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, dashes (-), periods (.), underscores (_) and colons (:), and must end with an alphanumeric character or a dash", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIotHubDpsEnrollmentName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"device-01",
+		"Device.Group_01:a",
+		"ends-with-dash-",
+		strings.Repeat("a", 128),
+	}
+	for _, v := range validNames {
+		_, errors := IotHubDpsEnrollmentName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Device Provisioning Service Enrollment Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ends-with-period.",
+		"ends_with_underscore_",
+		"contains spaces",
+		"contains/slash",
+		strings.Repeat("a", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := IotHubDpsEnrollmentName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Device Provisioning Service Enrollment Name", v)
+		}
+	}
+}
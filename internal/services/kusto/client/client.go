@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/dataconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/managedprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/scripts"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -26,6 +28,9 @@ type Client struct {
 	DataConnectionsClient                *dataconnections.DataConnectionsClient
 	DatabasePrincipalAssignmentsClient   *databaseprincipalassignments.DatabasePrincipalAssignmentsClient
 	ScriptsClient                        *scripts.ScriptsClient
+
+	authorizerFunc common.ApiAuthorizerFunc
+	configureFunc  func(c client.BaseClient, authorizer auth.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -86,5 +91,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DataConnectionsClient:                dataConnectionsClient,
 		DatabasePrincipalAssignmentsClient:   databasePrincipalAssignmentsClient,
 		ScriptsClient:                        scriptsClient,
+
+		authorizerFunc: o.Authorizers.AuthorizerFunc,
+		configureFunc:  o.Configure,
 	}, nil
 }
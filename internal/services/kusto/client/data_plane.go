@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+)
+
+// ManagementClient returns a client for the Data Plane Management API of the specified Kusto Cluster, which is used
+// to run Management (Control) Commands against the Databases within the Cluster.
+//
+// The access token is obtained using the credentials configured for the Provider, using the URI of the Kusto Cluster
+// as the Audience.
+func (c *Client) ManagementClient(ctx context.Context, id commonids.KustoClusterId) (*management.ManagementClient, error) {
+	resp, err := c.ClustersClient.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	endpoint := ""
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Uri != nil {
+		endpoint = *model.Properties.Uri
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("retrieving %s: `properties.uri` was nil", id)
+	}
+
+	api := environments.NewApiEndpoint("Kusto", endpoint, nil)
+	authorizer, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", api.Name(), err)
+	}
+
+	client, err := management.NewManagementClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building Management client: %+v", err)
+	}
+	c.configureFunc(client.Client, authorizer)
+
+	return client, nil
+}
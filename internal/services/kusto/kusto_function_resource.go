@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KustoFunctionResource struct{}
+
+var (
+	_ sdk.Resource           = KustoFunctionResource{}
+	_ sdk.ResourceWithUpdate = KustoFunctionResource{}
+)
+
+type KustoFunctionModel struct {
+	Name                  string                        `tfschema:"name"`
+	DatabaseId            string                        `tfschema:"database_id"`
+	Body                  string                        `tfschema:"body"`
+	Parameter             []KustoFunctionParameterModel `tfschema:"parameter"`
+	Description           string                        `tfschema:"description"`
+	Folder                string                        `tfschema:"folder"`
+	SkipValidationEnabled bool                          `tfschema:"skip_validation_enabled"`
+}
+
+type KustoFunctionParameterModel struct {
+	Name         string `tfschema:"name"`
+	Type         string `tfschema:"type"`
+	DefaultValue string `tfschema:"default_value"`
+}
+
+func (r KustoFunctionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.EntityName,
+		},
+
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKustoDatabaseID,
+		},
+
+		"body": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: kustoBodyDiffSuppress,
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.EntityName,
+					},
+
+					// either a scalar type (e.g. `long`) or a tabular schema (e.g. `(*)` or `(Timestamp:datetime)`)
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"default_value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"folder": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"skip_validation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r KustoFunctionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KustoFunctionResource) ModelObject() interface{} {
+	return &KustoFunctionModel{}
+}
+
+func (r KustoFunctionResource) ResourceType() string {
+	return "azurerm_kusto_function"
+}
+
+func (r KustoFunctionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return management.ValidateFunctionID
+}
+
+func (r KustoFunctionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KustoFunctionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := commonids.ParseKustoDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := management.NewFunctionID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.KustoClusterName, databaseId.KustoDatabaseName, model.Name)
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			existing, err := executeKustoManagementCommand(ctx, client, *databaseId, fmt.Sprintf(".show functions | where Name == %s", kustoStringLiteral(id.FunctionName)))
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := executeKustoManagementCommand(ctx, client, *databaseId, expandKustoFunctionCommand(id, model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KustoFunctionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseFunctionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing KustoFunctionModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := id.KustoDatabaseId()
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			functions, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show functions | where Name == %s", kustoStringLiteral(id.FunctionName)))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if len(functions) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := KustoFunctionModel{
+				Name:        id.FunctionName,
+				DatabaseId:  databaseId.ID(),
+				Body:        flattenKustoFunctionBody(kustoRecordString(functions[0], "Body")),
+				Parameter:   flattenKustoFunctionParameters(kustoRecordString(functions[0], "Parameters")),
+				Description: kustoRecordString(functions[0], "DocString"),
+				Folder:      kustoRecordString(functions[0], "Folder"),
+
+				// this is only used when the function is created or altered and isn't returned by Kusto
+				SkipValidationEnabled: existing.SkipValidationEnabled,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KustoFunctionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseFunctionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KustoFunctionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), expandKustoFunctionCommand(*id, model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoFunctionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseFunctionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), fmt.Sprintf(".drop function %s ifexists", kustoEntityName(id.FunctionName))); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKustoFunctionCommand(id management.FunctionId, model KustoFunctionModel) string {
+	parameters := make([]string, 0)
+	for _, v := range model.Parameter {
+		parameter := fmt.Sprintf("%s:%s", kustoEntityName(v.Name), v.Type)
+		if v.DefaultValue != "" {
+			parameter += fmt.Sprintf(" = %s", v.DefaultValue)
+		}
+		parameters = append(parameters, parameter)
+	}
+
+	return fmt.Sprintf(".create-or-alter function with (docstring = %s, folder = %s, skipvalidation = %s) %s(%s) {\n%s\n}", kustoStringLiteral(model.Description), kustoStringLiteral(model.Folder), kustoStringLiteral(fmt.Sprintf("%t", model.SkipValidationEnabled)), kustoEntityName(id.FunctionName), strings.Join(parameters, ", "), strings.TrimSpace(model.Body))
+}
+
+// flattenKustoFunctionBody removes the braces surrounding the body of the function returned by Kusto
+func flattenKustoFunctionBody(input string) string {
+	body := strings.TrimSpace(input)
+	body = strings.TrimPrefix(body, "{")
+	body = strings.TrimSuffix(body, "}")
+	return strings.TrimSpace(body)
+}
+
+// flattenKustoFunctionParameters parses the parameters returned by Kusto, e.g. `(T:(*), Limit:long = 10)`
+func flattenKustoFunctionParameters(input string) []KustoFunctionParameterModel {
+	output := make([]KustoFunctionParameterModel, 0)
+
+	parameters := strings.TrimSpace(input)
+	parameters = strings.TrimPrefix(parameters, "(")
+	parameters = strings.TrimSuffix(parameters, ")")
+
+	for _, parameter := range splitKustoTopLevel(parameters, ',') {
+		parameter = strings.TrimSpace(parameter)
+		if parameter == "" {
+			continue
+		}
+
+		nameAndType := splitKustoTopLevel(parameter, ':')
+		if len(nameAndType) < 2 {
+			continue
+		}
+
+		name := strings.TrimSpace(nameAndType[0])
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "['"), "']")
+
+		typeAndDefault := splitKustoTopLevel(strings.Join(nameAndType[1:], ":"), '=')
+		model := KustoFunctionParameterModel{
+			Name: name,
+			Type: strings.TrimSpace(typeAndDefault[0]),
+		}
+		if len(typeAndDefault) > 1 {
+			model.DefaultValue = strings.TrimSpace(strings.Join(typeAndDefault[1:], "="))
+		}
+
+		output = append(output, model)
+	}
+
+	return output
+}
+
+// splitKustoTopLevel splits the input on the separator, ignoring any separators within parentheses or string literals
+func splitKustoTopLevel(input string, separator rune) []string {
+	output := make([]string, 0)
+
+	depth := 0
+	var quote rune
+	current := strings.Builder{}
+	for _, c := range input {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case c == separator && depth == 0:
+			output = append(output, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(c)
+	}
+	output = append(output, current.String())
+
+	return output
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KustoFunctionResource struct{}
+
+func TestAccKustoFunction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_function", "test")
+	r := KustoFunctionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("skip_validation_enabled"),
+	})
+}
+
+func TestAccKustoFunction_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_function", "test")
+	r := KustoFunctionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKustoFunction_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_function", "test")
+	r := KustoFunctionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("skip_validation_enabled"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("skip_validation_enabled"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("skip_validation_enabled"),
+	})
+}
+
+func (r KustoFunctionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := management.ParseFunctionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	managementClient, err := client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := managementClient.Execute(ctx, management.ExecuteRequest{
+		Csl: fmt.Sprintf(".show functions | where Name == '%s'", id.FunctionName),
+		Db:  id.KustoDatabaseName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && len(resp.Model.PrimaryResult()) > 0), nil
+}
+
+func (r KustoFunctionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_function" "test" {
+  name        = "acctest_function"
+  database_id = azurerm_kusto_database.test.id
+  body        = "print Message = 'hello world'"
+}
+`, KustoTableResource{}.template(data))
+}
+
+func (r KustoFunctionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_function" "import" {
+  name        = azurerm_kusto_function.test.name
+  database_id = azurerm_kusto_function.test.database_id
+  body        = azurerm_kusto_function.test.body
+}
+`, r.basic(data))
+}
+
+func (r KustoFunctionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table" "test" {
+  name        = "acctest_table"
+  database_id = azurerm_kusto_database.test.id
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+
+resource "azurerm_kusto_function" "test" {
+  name        = "acctest_function"
+  database_id = azurerm_kusto_database.test.id
+  description = "Acceptance Test Function"
+  folder      = "acctest"
+
+  parameter {
+    name = "since"
+    type = "timespan"
+  }
+
+  parameter {
+    name          = "limit"
+    type          = "long"
+    default_value = "10"
+  }
+
+  body = <<QUERY
+${azurerm_kusto_table.test.name}
+| where Timestamp > ago(since)
+| take limit
+QUERY
+}
+`, KustoTableResource{}.template(data))
+}
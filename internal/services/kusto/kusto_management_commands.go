@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/rickb777/date/period"
+)
+
+// executeKustoManagementCommand runs the Management Command against the Database and returns the rows of the primary result
+func executeKustoManagementCommand(ctx context.Context, client *management.ManagementClient, databaseId commonids.KustoDatabaseId, command string) ([]map[string]interface{}, error) {
+	resp, err := client.Execute(ctx, management.ExecuteRequest{
+		Csl: command,
+		Db:  databaseId.KustoDatabaseName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil {
+		return []map[string]interface{}{}, nil
+	}
+
+	return resp.Model.PrimaryResult(), nil
+}
+
+// waitForKustoOperation polls the Operation started by an `async` Management Command until it has finished
+func waitForKustoOperation(ctx context.Context, client *management.ManagementClient, databaseId commonids.KustoDatabaseId, operationId string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(management.OperationStateInProgress),
+			string(management.OperationStateScheduled),
+		},
+		Target: []string{
+			string(management.OperationStateCompleted),
+		},
+		Refresh: func() (interface{}, string, error) {
+			rows, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show operations %s", operationId))
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving Operation %q: %+v", operationId, err)
+			}
+			if len(rows) == 0 {
+				return nil, "", fmt.Errorf("retrieving Operation %q: no rows were returned", operationId)
+			}
+
+			state := kustoRecordString(rows[0], "State")
+			switch management.OperationState(state) {
+			case management.OperationStateCompleted, management.OperationStateInProgress, management.OperationStateScheduled:
+				return rows[0], state, nil
+			}
+
+			return nil, state, fmt.Errorf("Operation %q finished with the State %q: %s", operationId, state, kustoRecordString(rows[0], "Status"))
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// kustoEntityName quotes the name of a Table, Column, Function or Materialized View so that names containing spaces,
+// dashes or dots can be used within a Management Command
+func kustoEntityName(name string) string {
+	return fmt.Sprintf("['%s']", strings.ReplaceAll(name, "'", "\\'"))
+}
+
+// kustoStringLiteral returns the input as a (double-quoted) Kusto string literal
+func kustoStringLiteral(input string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return fmt.Sprintf(`"%s"`, replacer.Replace(input))
+}
+
+// kustoMultiLineStringLiteral returns the input as a Kusto multi-line string literal, which is used for JSON policies
+func kustoMultiLineStringLiteral(input string) string {
+	return fmt.Sprintf("```%s```", input)
+}
+
+// kustoJsonLiteral marshals the input into a Kusto string literal, which is used to set Policies
+func kustoJsonLiteral(input interface{}) (string, error) {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return kustoMultiLineStringLiteral(string(payload)), nil
+}
+
+// kustoRecordString returns the value of the specified column within a row of the result of a Management Command
+func kustoRecordString(record map[string]interface{}, column string) string {
+	switch v := record[column].(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// kustoRecordBool returns the boolean value of the specified column within a row of the result of a Management Command
+func kustoRecordBool(record map[string]interface{}, column string) bool {
+	v, _ := strconv.ParseBool(kustoRecordString(record, column))
+	return v
+}
+
+// kustoRecordPolicy unmarshals the (JSON) Policy returned within a row of the result of a `.show ... policy` Management
+// Command, returning false when no Policy is defined for the entity
+func kustoRecordPolicy(record map[string]interface{}, target interface{}) (bool, error) {
+	raw := strings.TrimSpace(kustoRecordString(record, "Policy"))
+	if raw == "" || raw == "null" {
+		return false, nil
+	}
+
+	if err := json.Unmarshal([]byte(raw), target); err != nil {
+		return false, fmt.Errorf("unmarshaling Policy %q: %+v", raw, err)
+	}
+
+	return true, nil
+}
+
+// kustoTimespanFromISO8601Duration converts an ISO8601 Duration (e.g. `P31D`) into the timespan format used within
+// Kusto Policies (e.g. `31.00:00:00`)
+func kustoTimespanFromISO8601Duration(input string) (string, error) {
+	p, err := period.Parse(input)
+	if err != nil {
+		return "", err
+	}
+
+	duration := p.DurationApprox()
+	days := int64(duration / (24 * time.Hour))
+	duration -= time.Duration(days) * 24 * time.Hour
+	hours := int64(duration / time.Hour)
+	duration -= time.Duration(hours) * time.Hour
+	minutes := int64(duration / time.Minute)
+	duration -= time.Duration(minutes) * time.Minute
+	seconds := int64(duration / time.Second)
+
+	return fmt.Sprintf("%d.%02d:%02d:%02d", days, hours, minutes, seconds), nil
+}
+
+// kustoTimespanToISO8601Duration converts a timespan returned within a Kusto Policy (e.g. `31.00:00:00`) into an ISO8601
+// Duration. When the timespan is equivalent to the existing value, the existing value is returned to avoid a diff when
+// a different (but equivalent) representation was used in the configuration, e.g. `PT24H` rather than `P1D`.
+func kustoTimespanToISO8601Duration(input string, existing string) (string, error) {
+	days := int64(0)
+	clock := input
+	if idx := strings.Index(input, "."); idx != -1 && idx < strings.Index(input, ":") {
+		v, err := strconv.ParseInt(input[:idx], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("parsing the days from the timespan %q: %+v", input, err)
+		}
+		days = v
+		clock = input[idx+1:]
+	}
+
+	segments := strings.Split(clock, ":")
+	if len(segments) != 3 {
+		return "", fmt.Errorf("expected the timespan %q to be in the format `d.hh:mm:ss`", input)
+	}
+	hours, err := strconv.ParseInt(segments[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parsing the hours from the timespan %q: %+v", input, err)
+	}
+	minutes, err := strconv.ParseInt(segments[1], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parsing the minutes from the timespan %q: %+v", input, err)
+	}
+	seconds, err := strconv.ParseFloat(segments[2], 64)
+	if err != nil {
+		return "", fmt.Errorf("parsing the seconds from the timespan %q: %+v", input, err)
+	}
+
+	if existing != "" {
+		if expected, err := kustoTimespanFromISO8601Duration(existing); err == nil {
+			if actual := fmt.Sprintf("%d.%02d:%02d:%02d", days, hours, minutes, int64(seconds)); actual == expected {
+				return existing, nil
+			}
+		}
+	}
+
+	output := "P"
+	if days > 0 {
+		output += fmt.Sprintf("%dD", days)
+	}
+
+	clockOutput := ""
+	if hours > 0 {
+		clockOutput += fmt.Sprintf("%dH", hours)
+	}
+	if minutes > 0 {
+		clockOutput += fmt.Sprintf("%dM", minutes)
+	}
+	if int64(seconds) > 0 {
+		clockOutput += fmt.Sprintf("%dS", int64(seconds))
+	}
+	if clockOutput != "" {
+		output += "T" + clockOutput
+	}
+
+	if output == "P" {
+		output = "PT0S"
+	}
+
+	return output, nil
+}
+
+// kustoBodyDiffSuppress suppresses differences in the leading and trailing whitespace of a Function body or a
+// Materialized View query, which isn't preserved by Kusto
+func kustoBodyDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
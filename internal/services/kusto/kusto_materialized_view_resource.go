@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KustoMaterializedViewResource struct{}
+
+var (
+	_ sdk.Resource           = KustoMaterializedViewResource{}
+	_ sdk.ResourceWithUpdate = KustoMaterializedViewResource{}
+)
+
+type KustoMaterializedViewModel struct {
+	Name                    string `tfschema:"name"`
+	DatabaseId              string `tfschema:"database_id"`
+	SourceTableName         string `tfschema:"source_table_name"`
+	Query                   string `tfschema:"query"`
+	AutoUpdateSchemaEnabled bool   `tfschema:"auto_update_schema_enabled"`
+	BackfillEnabled         bool   `tfschema:"backfill_enabled"`
+	Description             string `tfschema:"description"`
+	Enabled                 bool   `tfschema:"enabled"`
+	Folder                  string `tfschema:"folder"`
+}
+
+func (r KustoMaterializedViewResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.EntityName,
+		},
+
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKustoDatabaseID,
+		},
+
+		"source_table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.EntityName,
+		},
+
+		"query": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: kustoBodyDiffSuppress,
+		},
+
+		"auto_update_schema_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"backfill_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"folder": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KustoMaterializedViewResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KustoMaterializedViewResource) ModelObject() interface{} {
+	return &KustoMaterializedViewModel{}
+}
+
+func (r KustoMaterializedViewResource) ResourceType() string {
+	return "azurerm_kusto_materialized_view"
+}
+
+func (r KustoMaterializedViewResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return management.ValidateMaterializedViewID
+}
+
+func (r KustoMaterializedViewResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// backfilling a materialized view from the existing records in the source table can take some time
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KustoMaterializedViewModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := commonids.ParseKustoDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := management.NewMaterializedViewID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.KustoClusterName, databaseId.KustoDatabaseName, model.Name)
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			existing, err := executeKustoManagementCommand(ctx, client, *databaseId, fmt.Sprintf(".show materialized-views | where Name == %s", kustoStringLiteral(id.MaterializedViewName)))
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := []string{
+				fmt.Sprintf("autoUpdateSchema = %t", model.AutoUpdateSchemaEnabled),
+				fmt.Sprintf("backfill = %t", model.BackfillEnabled),
+				fmt.Sprintf("docString = %s", kustoStringLiteral(model.Description)),
+				fmt.Sprintf("folder = %s", kustoStringLiteral(model.Folder)),
+			}
+			command := fmt.Sprintf(".create async materialized-view with (%s) %s on table %s {\n%s\n}", strings.Join(properties, ", "), kustoEntityName(id.MaterializedViewName), kustoEntityName(model.SourceTableName), strings.TrimSpace(model.Query))
+
+			rows, err := executeKustoManagementCommand(ctx, client, *databaseId, command)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if len(rows) == 0 {
+				return fmt.Errorf("creating %s: the Operation ID was not returned", id)
+			}
+
+			if err := waitForKustoOperation(ctx, client, *databaseId, kustoRecordString(rows[0], "OperationId")); err != nil {
+				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if !model.Enabled {
+				if _, err := executeKustoManagementCommand(ctx, client, *databaseId, fmt.Sprintf(".disable materialized-view %s", kustoEntityName(id.MaterializedViewName))); err != nil {
+					return fmt.Errorf("disabling %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoMaterializedViewResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseMaterializedViewID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing KustoMaterializedViewModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := id.KustoDatabaseId()
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			views, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show materialized-views | where Name == %s", kustoStringLiteral(id.MaterializedViewName)))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if len(views) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := KustoMaterializedViewModel{
+				Name:                    id.MaterializedViewName,
+				DatabaseId:              databaseId.ID(),
+				SourceTableName:         kustoRecordString(views[0], "SourceTable"),
+				Query:                   strings.TrimSpace(kustoRecordString(views[0], "Query")),
+				AutoUpdateSchemaEnabled: kustoRecordBool(views[0], "AutoUpdateSchema"),
+				Description:             kustoRecordString(views[0], "DocString"),
+				Enabled:                 kustoRecordBool(views[0], "IsEnabled"),
+				Folder:                  kustoRecordString(views[0], "Folder"),
+
+				// this is only used when the materialized view is created and isn't returned by Kusto
+				BackfillEnabled: existing.BackfillEnabled,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KustoMaterializedViewResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseMaterializedViewID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KustoMaterializedViewModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := id.KustoDatabaseId()
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			name := kustoEntityName(id.MaterializedViewName)
+			commands := make([]string, 0)
+			if metadata.ResourceData.HasChange("query") {
+				commands = append(commands, fmt.Sprintf(".alter materialized-view %s on table %s {\n%s\n}", name, kustoEntityName(model.SourceTableName), strings.TrimSpace(model.Query)))
+			}
+			if metadata.ResourceData.HasChange("auto_update_schema_enabled") {
+				commands = append(commands, fmt.Sprintf(".alter materialized-view %s autoUpdateSchema %t", name, model.AutoUpdateSchemaEnabled))
+			}
+			if metadata.ResourceData.HasChange("description") {
+				commands = append(commands, fmt.Sprintf(".alter materialized-view %s docstring %s", name, kustoStringLiteral(model.Description)))
+			}
+			if metadata.ResourceData.HasChange("folder") {
+				commands = append(commands, fmt.Sprintf(".alter materialized-view %s folder %s", name, kustoStringLiteral(model.Folder)))
+			}
+			if metadata.ResourceData.HasChange("enabled") {
+				if model.Enabled {
+					commands = append(commands, fmt.Sprintf(".enable materialized-view %s", name))
+				} else {
+					commands = append(commands, fmt.Sprintf(".disable materialized-view %s", name))
+				}
+			}
+
+			for _, command := range commands {
+				if _, err := executeKustoManagementCommand(ctx, client, databaseId, command); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoMaterializedViewResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseMaterializedViewID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), fmt.Sprintf(".drop materialized-view %s ifexists", kustoEntityName(id.MaterializedViewName))); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
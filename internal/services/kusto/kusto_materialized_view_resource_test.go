@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KustoMaterializedViewResource struct{}
+
+func TestAccKustoMaterializedView_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_materialized_view", "test")
+	r := KustoMaterializedViewResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("backfill_enabled"),
+	})
+}
+
+func TestAccKustoMaterializedView_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_materialized_view", "test")
+	r := KustoMaterializedViewResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKustoMaterializedView_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_materialized_view", "test")
+	r := KustoMaterializedViewResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("backfill_enabled"),
+	})
+}
+
+func TestAccKustoMaterializedView_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_materialized_view", "test")
+	r := KustoMaterializedViewResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("backfill_enabled"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("backfill_enabled"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("backfill_enabled"),
+	})
+}
+
+func (r KustoMaterializedViewResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := management.ParseMaterializedViewID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	managementClient, err := client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := managementClient.Execute(ctx, management.ExecuteRequest{
+		Csl: fmt.Sprintf(".show materialized-views | where Name == '%s'", id.MaterializedViewName),
+		Db:  id.KustoDatabaseName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && len(resp.Model.PrimaryResult()) > 0), nil
+}
+
+func (r KustoMaterializedViewResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_materialized_view" "test" {
+  name              = "acctest_view"
+  database_id       = azurerm_kusto_database.test.id
+  source_table_name = azurerm_kusto_table.test.name
+  query             = "${azurerm_kusto_table.test.name} | summarize arg_max(Timestamp, *) by DeviceId"
+}
+`, r.template(data))
+}
+
+func (r KustoMaterializedViewResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_materialized_view" "import" {
+  name              = azurerm_kusto_materialized_view.test.name
+  database_id       = azurerm_kusto_materialized_view.test.database_id
+  source_table_name = azurerm_kusto_materialized_view.test.source_table_name
+  query             = azurerm_kusto_materialized_view.test.query
+}
+`, r.basic(data))
+}
+
+func (r KustoMaterializedViewResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_materialized_view" "test" {
+  name                       = "acctest_view"
+  database_id                = azurerm_kusto_database.test.id
+  source_table_name          = azurerm_kusto_table.test.name
+  query                      = "${azurerm_kusto_table.test.name} | summarize arg_max(Timestamp, *) by DeviceId"
+  auto_update_schema_enabled = true
+  backfill_enabled           = true
+  description                = "Acceptance Test Materialized View"
+  folder                     = "acctest"
+}
+`, r.template(data))
+}
+
+func (r KustoMaterializedViewResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_materialized_view" "test" {
+  name                       = "acctest_view"
+  database_id                = azurerm_kusto_database.test.id
+  source_table_name          = azurerm_kusto_table.test.name
+  query                      = "${azurerm_kusto_table.test.name} | summarize count() by DeviceId, bin(Timestamp, 1h)"
+  auto_update_schema_enabled = true
+  description                = "Acceptance Test Materialized View"
+  enabled                    = false
+  folder                     = "acctest"
+}
+`, r.template(data))
+}
+
+func (KustoMaterializedViewResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table" "test" {
+  name        = "acctest_table"
+  database_id = azurerm_kusto_database.test.id
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "DeviceId"
+    type = "string"
+  }
+
+  column {
+    name = "Temperature"
+    type = "real"
+  }
+}
+`, KustoTableResource{}.template(data))
+}
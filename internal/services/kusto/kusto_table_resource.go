@@ -0,0 +1,621 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KustoTableResource struct{}
+
+var (
+	_ sdk.Resource                  = KustoTableResource{}
+	_ sdk.ResourceWithUpdate        = KustoTableResource{}
+	_ sdk.ResourceWithCustomizeDiff = KustoTableResource{}
+)
+
+type KustoTableModel struct {
+	Name            string                        `tfschema:"name"`
+	DatabaseId      string                        `tfschema:"database_id"`
+	Column          []KustoTableColumnModel       `tfschema:"column"`
+	Description     string                        `tfschema:"description"`
+	Folder          string                        `tfschema:"folder"`
+	CachingPolicy   []KustoTableCachingPolicy     `tfschema:"caching_policy"`
+	RetentionPolicy []KustoTableRetentionPolicy   `tfschema:"retention_policy"`
+	UpdatePolicy    []KustoTableUpdatePolicyModel `tfschema:"update_policy"`
+}
+
+type KustoTableColumnModel struct {
+	Name string `tfschema:"name"`
+	Type string `tfschema:"type"`
+}
+
+type KustoTableCachingPolicy struct {
+	HotCachePeriod string `tfschema:"hot_cache_period"`
+}
+
+type KustoTableRetentionPolicy struct {
+	SoftDeletePeriod      string `tfschema:"soft_delete_period"`
+	RecoverabilityEnabled bool   `tfschema:"recoverability_enabled"`
+}
+
+type KustoTableUpdatePolicyModel struct {
+	SourceTableName                     string `tfschema:"source_table_name"`
+	Query                               string `tfschema:"query"`
+	Enabled                             bool   `tfschema:"enabled"`
+	TransactionalEnabled                bool   `tfschema:"transactional_enabled"`
+	PropagateIngestionPropertiesEnabled bool   `tfschema:"propagate_ingestion_properties_enabled"`
+}
+
+func (r KustoTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.EntityName,
+		},
+
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKustoDatabaseID,
+		},
+
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: kustoValidate.EntityName,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(management.PossibleValuesForColumnType(), false),
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"folder": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"caching_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"hot_cache_period": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		"retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"soft_delete_period": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"recoverability_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"update_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_table_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: kustoValidate.EntityName,
+					},
+
+					"query": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     validation.StringIsNotEmpty,
+						DiffSuppressFunc: kustoBodyDiffSuppress,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"transactional_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"propagate_ingestion_properties_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KustoTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KustoTableResource) ModelObject() interface{} {
+	return &KustoTableModel{}
+}
+
+func (r KustoTableResource) ResourceType() string {
+	return "azurerm_kusto_table"
+}
+
+func (r KustoTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return management.ValidateTableID
+}
+
+func (r KustoTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KustoTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := commonids.ParseKustoDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := management.NewTableID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.KustoClusterName, databaseId.KustoDatabaseName, model.Name)
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			existing, err := executeKustoManagementCommand(ctx, client, *databaseId, fmt.Sprintf(".show tables | where TableName == %s", kustoStringLiteral(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			command := fmt.Sprintf(".create table %s (%s) with (docstring = %s, folder = %s)", kustoEntityName(id.TableName), expandKustoTableColumns(model.Column), kustoStringLiteral(model.Description), kustoStringLiteral(model.Folder))
+			if _, err := executeKustoManagementCommand(ctx, client, *databaseId, command); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.CachingPolicy) > 0 {
+				if err := updateKustoTableCachingPolicy(ctx, client, id, model.CachingPolicy); err != nil {
+					return err
+				}
+			}
+
+			if len(model.RetentionPolicy) > 0 {
+				if err := updateKustoTableRetentionPolicy(ctx, client, id, model.RetentionPolicy); err != nil {
+					return err
+				}
+			}
+
+			if len(model.UpdatePolicy) > 0 {
+				if err := updateKustoTableUpdatePolicy(ctx, client, id, model.UpdatePolicy); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing KustoTableModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := id.KustoDatabaseId()
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			tables, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show tables | where TableName == %s", kustoStringLiteral(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if len(tables) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := KustoTableModel{
+				Name:        id.TableName,
+				DatabaseId:  databaseId.ID(),
+				Description: kustoRecordString(tables[0], "DocString"),
+				Folder:      kustoRecordString(tables[0], "Folder"),
+			}
+
+			schemaRows, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show table %s schema as json", kustoEntityName(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("retrieving the schema for %s: %+v", *id, err)
+			}
+			if len(schemaRows) > 0 {
+				var schema management.TableSchema
+				if err := json.Unmarshal([]byte(kustoRecordString(schemaRows[0], "Schema")), &schema); err != nil {
+					return fmt.Errorf("unmarshaling the schema for %s: %+v", *id, err)
+				}
+				state.Column = flattenKustoTableColumns(schema.OrderedColumns)
+			}
+
+			cachingPolicyRows, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show table %s policy caching", kustoEntityName(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("retrieving the caching policy for %s: %+v", *id, err)
+			}
+			if len(cachingPolicyRows) > 0 {
+				var policy management.CachingPolicy
+				exists, err := kustoRecordPolicy(cachingPolicyRows[0], &policy)
+				if err != nil {
+					return fmt.Errorf("parsing the caching policy for %s: %+v", *id, err)
+				}
+				if exists {
+					if state.CachingPolicy, err = flattenKustoTableCachingPolicy(policy, existing.CachingPolicy); err != nil {
+						return fmt.Errorf("flattening the caching policy for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			retentionPolicyRows, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show table %s policy retention", kustoEntityName(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("retrieving the retention policy for %s: %+v", *id, err)
+			}
+			if len(retentionPolicyRows) > 0 {
+				var policy management.RetentionPolicy
+				exists, err := kustoRecordPolicy(retentionPolicyRows[0], &policy)
+				if err != nil {
+					return fmt.Errorf("parsing the retention policy for %s: %+v", *id, err)
+				}
+				if exists {
+					if state.RetentionPolicy, err = flattenKustoTableRetentionPolicy(policy, existing.RetentionPolicy); err != nil {
+						return fmt.Errorf("flattening the retention policy for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			updatePolicyRows, err := executeKustoManagementCommand(ctx, client, databaseId, fmt.Sprintf(".show table %s policy update", kustoEntityName(id.TableName)))
+			if err != nil {
+				return fmt.Errorf("retrieving the update policy for %s: %+v", *id, err)
+			}
+			if len(updatePolicyRows) > 0 {
+				policies := make([]management.UpdatePolicy, 0)
+				if _, err := kustoRecordPolicy(updatePolicyRows[0], &policies); err != nil {
+					return fmt.Errorf("parsing the update policy for %s: %+v", *id, err)
+				}
+				state.UpdatePolicy = flattenKustoTableUpdatePolicies(policies)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KustoTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KustoTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := id.KustoDatabaseId()
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChanges("column", "description", "folder") {
+				// NOTE: columns which are removed from the schema are dropped, along with the data they contain
+				command := fmt.Sprintf(".alter table %s (%s) with (docstring = %s, folder = %s)", kustoEntityName(id.TableName), expandKustoTableColumns(model.Column), kustoStringLiteral(model.Description), kustoStringLiteral(model.Folder))
+				if _, err := executeKustoManagementCommand(ctx, client, databaseId, command); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("caching_policy") {
+				if err := updateKustoTableCachingPolicy(ctx, client, *id, model.CachingPolicy); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("retention_policy") {
+				if err := updateKustoTableRetentionPolicy(ctx, client, *id, model.RetentionPolicy); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("update_policy") {
+				if err := updateKustoTableUpdatePolicy(ctx, client, *id, model.UpdatePolicy); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := management.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), fmt.Sprintf(".drop table %s ifexists", kustoEntityName(id.TableName))); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoTableResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" || !rd.HasChange("column") {
+				return nil
+			}
+
+			// columns can be added to, removed from and reordered within an existing table, however the type
+			// of an existing column can't be changed
+			oldRaw, newRaw := rd.GetChange("column")
+			oldTypes := make(map[string]string)
+			for _, raw := range oldRaw.([]interface{}) {
+				if v, ok := raw.(map[string]interface{}); ok {
+					oldTypes[v["name"].(string)] = v["type"].(string)
+				}
+			}
+
+			for _, raw := range newRaw.([]interface{}) {
+				v, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if oldType, ok := oldTypes[v["name"].(string)]; ok && oldType != v["type"].(string) {
+					return rd.ForceNew("column")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func updateKustoTableCachingPolicy(ctx context.Context, client *management.ManagementClient, id management.TableId, input []KustoTableCachingPolicy) error {
+	command := fmt.Sprintf(".delete table %s policy caching", kustoEntityName(id.TableName))
+	if len(input) > 0 {
+		hotCachePeriod, err := kustoTimespanFromISO8601Duration(input[0].HotCachePeriod)
+		if err != nil {
+			return fmt.Errorf("parsing `hot_cache_period`: %+v", err)
+		}
+		command = fmt.Sprintf(".alter table %s policy caching hot = time(%s)", kustoEntityName(id.TableName), hotCachePeriod)
+	}
+
+	if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), command); err != nil {
+		return fmt.Errorf("updating the caching policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func updateKustoTableRetentionPolicy(ctx context.Context, client *management.ManagementClient, id management.TableId, input []KustoTableRetentionPolicy) error {
+	command := fmt.Sprintf(".delete table %s policy retention", kustoEntityName(id.TableName))
+	if len(input) > 0 {
+		softDeletePeriod, err := kustoTimespanFromISO8601Duration(input[0].SoftDeletePeriod)
+		if err != nil {
+			return fmt.Errorf("parsing `soft_delete_period`: %+v", err)
+		}
+
+		policy := management.RetentionPolicy{
+			Recoverability:   management.RecoverabilityDisabled,
+			SoftDeletePeriod: softDeletePeriod,
+		}
+		if input[0].RecoverabilityEnabled {
+			policy.Recoverability = management.RecoverabilityEnabled
+		}
+
+		literal, err := kustoJsonLiteral(policy)
+		if err != nil {
+			return fmt.Errorf("marshaling the retention policy for %s: %+v", id, err)
+		}
+		command = fmt.Sprintf(".alter table %s policy retention %s", kustoEntityName(id.TableName), literal)
+	}
+
+	if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), command); err != nil {
+		return fmt.Errorf("updating the retention policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func updateKustoTableUpdatePolicy(ctx context.Context, client *management.ManagementClient, id management.TableId, input []KustoTableUpdatePolicyModel) error {
+	command := fmt.Sprintf(".delete table %s policy update", kustoEntityName(id.TableName))
+	if len(input) > 0 {
+		policies := make([]management.UpdatePolicy, 0)
+		for _, v := range input {
+			policies = append(policies, management.UpdatePolicy{
+				IsEnabled:                    v.Enabled,
+				IsTransactional:              v.TransactionalEnabled,
+				PropagateIngestionProperties: v.PropagateIngestionPropertiesEnabled,
+				Query:                        strings.TrimSpace(v.Query),
+				Source:                       v.SourceTableName,
+			})
+		}
+
+		literal, err := kustoJsonLiteral(policies)
+		if err != nil {
+			return fmt.Errorf("marshaling the update policy for %s: %+v", id, err)
+		}
+		command = fmt.Sprintf(".alter table %s policy update %s", kustoEntityName(id.TableName), literal)
+	}
+
+	if _, err := executeKustoManagementCommand(ctx, client, id.KustoDatabaseId(), command); err != nil {
+		return fmt.Errorf("updating the update policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandKustoTableColumns(input []KustoTableColumnModel) string {
+	columns := make([]string, 0)
+	for _, v := range input {
+		columns = append(columns, fmt.Sprintf("%s:%s", kustoEntityName(v.Name), v.Type))
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+func flattenKustoTableColumns(input []management.TableSchemaColumn) []KustoTableColumnModel {
+	output := make([]KustoTableColumnModel, 0)
+	for _, v := range input {
+		output = append(output, KustoTableColumnModel{
+			Name: v.Name,
+			Type: v.CslType,
+		})
+	}
+
+	return output
+}
+
+func flattenKustoTableCachingPolicy(input management.CachingPolicy, existing []KustoTableCachingPolicy) ([]KustoTableCachingPolicy, error) {
+	if input.DataHotSpan == nil || input.DataHotSpan.Value == "" {
+		return []KustoTableCachingPolicy{}, nil
+	}
+
+	existingHotCachePeriod := ""
+	if len(existing) > 0 {
+		existingHotCachePeriod = existing[0].HotCachePeriod
+	}
+
+	hotCachePeriod, err := kustoTimespanToISO8601Duration(input.DataHotSpan.Value, existingHotCachePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return []KustoTableCachingPolicy{
+		{
+			HotCachePeriod: hotCachePeriod,
+		},
+	}, nil
+}
+
+func flattenKustoTableRetentionPolicy(input management.RetentionPolicy, existing []KustoTableRetentionPolicy) ([]KustoTableRetentionPolicy, error) {
+	existingSoftDeletePeriod := ""
+	if len(existing) > 0 {
+		existingSoftDeletePeriod = existing[0].SoftDeletePeriod
+	}
+
+	softDeletePeriod, err := kustoTimespanToISO8601Duration(input.SoftDeletePeriod, existingSoftDeletePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return []KustoTableRetentionPolicy{
+		{
+			SoftDeletePeriod:      softDeletePeriod,
+			RecoverabilityEnabled: input.Recoverability != management.RecoverabilityDisabled,
+		},
+	}, nil
+}
+
+func flattenKustoTableUpdatePolicies(input []management.UpdatePolicy) []KustoTableUpdatePolicyModel {
+	output := make([]KustoTableUpdatePolicyModel, 0)
+	for _, v := range input {
+		output = append(output, KustoTableUpdatePolicyModel{
+			SourceTableName:                     v.Source,
+			Query:                               v.Query,
+			Enabled:                             v.IsEnabled,
+			TransactionalEnabled:                v.IsTransactional,
+			PropagateIngestionPropertiesEnabled: v.PropagateIngestionProperties,
+		})
+	}
+
+	return output
+}
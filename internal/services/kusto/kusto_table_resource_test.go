@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/v1/management"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KustoTableResource struct{}
+
+func TestAccKustoTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table", "test")
+	r := KustoTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table", "test")
+	r := KustoTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKustoTable_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table", "test")
+	r := KustoTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoTable_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table", "test")
+	r := KustoTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KustoTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := management.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	managementClient, err := client.Kusto.ManagementClient(ctx, commonids.NewKustoClusterID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := managementClient.Execute(ctx, management.ExecuteRequest{
+		Csl: fmt.Sprintf(".show tables | where TableName == '%s'", id.TableName),
+		Db:  id.KustoDatabaseName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && len(resp.Model.PrimaryResult()) > 0), nil
+}
+
+func (r KustoTableResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table" "test" {
+  name        = "acctest_table"
+  database_id = azurerm_kusto_database.test.id
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.template(data))
+}
+
+func (r KustoTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table" "import" {
+  name        = azurerm_kusto_table.test.name
+  database_id = azurerm_kusto_table.test.database_id
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.basic(data))
+}
+
+func (r KustoTableResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table" "source" {
+  name        = "acctest_source"
+  database_id = azurerm_kusto_database.test.id
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "Payload"
+    type = "dynamic"
+  }
+}
+
+resource "azurerm_kusto_table" "test" {
+  name        = "acctest_table"
+  database_id = azurerm_kusto_database.test.id
+  description = "Acceptance Test Table"
+  folder      = "acctest"
+
+  column {
+    name = "Timestamp"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+
+  column {
+    name = "Level"
+    type = "long"
+  }
+
+  caching_policy {
+    hot_cache_period = "P7D"
+  }
+
+  retention_policy {
+    soft_delete_period     = "P31D"
+    recoverability_enabled = false
+  }
+
+  update_policy {
+    source_table_name = azurerm_kusto_table.source.name
+    query             = "acctest_source | project Timestamp, Message = tostring(Payload.message), Level = tolong(Payload.level)"
+  }
+}
+`, r.template(data))
+}
+
+func (KustoTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-kusto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -21,6 +21,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		CosmosDBDataConnectionResource{},
+		KustoFunctionResource{},
+		KustoMaterializedViewResource{},
+		KustoTableResource{},
 	}
 }
 
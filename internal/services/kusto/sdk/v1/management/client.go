@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// NOTE: the Kusto Data Plane API isn't available in `hashicorp/go-azure-sdk`, this package mirrors the layout of the
+// generated SDK so that it can be swapped out for an upstream package once one becomes available.
+
+type ManagementClient struct {
+	Client *dataplane.Client
+}
+
+func NewManagementClientWithBaseURI(endpoint string) (*ManagementClient, error) {
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("parsing the endpoint %q: %+v", endpoint, err)
+	}
+
+	// the Kusto REST API is versioned through the path (e.g. `/v1/rest/mgmt`) rather than an `api-version`
+	return &ManagementClient{
+		Client: dataplane.NewDataPlaneClient(endpoint, "kusto/management", ""),
+	}, nil
+}
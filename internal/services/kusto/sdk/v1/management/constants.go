@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+type ColumnType string
+
+const (
+	ColumnTypeBool     ColumnType = "bool"
+	ColumnTypeDateTime ColumnType = "datetime"
+	ColumnTypeDecimal  ColumnType = "decimal"
+	ColumnTypeDynamic  ColumnType = "dynamic"
+	ColumnTypeGuid     ColumnType = "guid"
+	ColumnTypeInt      ColumnType = "int"
+	ColumnTypeLong     ColumnType = "long"
+	ColumnTypeReal     ColumnType = "real"
+	ColumnTypeString   ColumnType = "string"
+	ColumnTypeTimespan ColumnType = "timespan"
+)
+
+func PossibleValuesForColumnType() []string {
+	return []string{
+		string(ColumnTypeBool),
+		string(ColumnTypeDateTime),
+		string(ColumnTypeDecimal),
+		string(ColumnTypeDynamic),
+		string(ColumnTypeGuid),
+		string(ColumnTypeInt),
+		string(ColumnTypeLong),
+		string(ColumnTypeReal),
+		string(ColumnTypeString),
+		string(ColumnTypeTimespan),
+	}
+}
+
+type OperationState string
+
+const (
+	OperationStateAbandoned  OperationState = "Abandoned"
+	OperationStateBadInput   OperationState = "BadInput"
+	OperationStateCanceled   OperationState = "Canceled"
+	OperationStateCompleted  OperationState = "Completed"
+	OperationStateFailed     OperationState = "Failed"
+	OperationStateInProgress OperationState = "InProgress"
+	OperationStateScheduled  OperationState = "Scheduled"
+	OperationStateThrottled  OperationState = "Throttled"
+)
+
+type Recoverability string
+
+const (
+	RecoverabilityDisabled Recoverability = "Disabled"
+	RecoverabilityEnabled  Recoverability = "Enabled"
+)
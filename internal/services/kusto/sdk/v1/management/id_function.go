@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&FunctionId{})
+}
+
+var _ resourceids.ResourceId = &FunctionId{}
+
+// FunctionId is a struct representing the Resource ID for a Kusto Function
+//
+// NOTE: Functions are Data Plane resources, this ID is nested beneath the Kusto Database so that the Kusto Cluster
+// (and therefore the Data Plane Endpoint) and the Database can be determined from the ID.
+type FunctionId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	KustoClusterName  string
+	KustoDatabaseName string
+	FunctionName      string
+}
+
+// NewFunctionID returns a new FunctionId struct
+func NewFunctionID(subscriptionId string, resourceGroupName string, kustoClusterName string, kustoDatabaseName string, functionName string) FunctionId {
+	return FunctionId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		KustoClusterName:  kustoClusterName,
+		KustoDatabaseName: kustoDatabaseName,
+		FunctionName:      functionName,
+	}
+}
+
+// ParseFunctionID parses 'input' into a FunctionId
+func ParseFunctionID(input string) (*FunctionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&FunctionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := FunctionId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseFunctionIDInsensitively parses 'input' case-insensitively into a FunctionId
+// note: this method should only be used for API response data and not user input
+func ParseFunctionIDInsensitively(input string) (*FunctionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&FunctionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := FunctionId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *FunctionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.KustoClusterName, ok = input.Parsed["kustoClusterName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoClusterName", input)
+	}
+
+	if id.KustoDatabaseName, ok = input.Parsed["kustoDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoDatabaseName", input)
+	}
+
+	if id.FunctionName, ok = input.Parsed["functionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "functionName", input)
+	}
+
+	return nil
+}
+
+// ValidateFunctionID checks that 'input' can be parsed as a Function ID
+func ValidateFunctionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseFunctionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Function ID
+func (id FunctionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/clusters/%s/databases/%s/functions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName, id.FunctionName)
+}
+
+// KustoDatabaseId returns the ID of the Kusto Database containing this Function
+func (id FunctionId) KustoDatabaseId() commonids.KustoDatabaseId {
+	return commonids.NewKustoDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Function ID
+func (id FunctionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto", "Microsoft.Kusto"),
+		resourceids.StaticSegment("staticClusters", "clusters", "clusters"),
+		resourceids.UserSpecifiedSegment("kustoClusterName", "kustoClusterName"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("kustoDatabaseName", "kustoDatabaseName"),
+		resourceids.StaticSegment("staticFunctions", "functions", "functions"),
+		resourceids.UserSpecifiedSegment("functionName", "functionName"),
+	}
+}
+
+// String returns a human-readable description of this Function ID
+func (id FunctionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Kusto Cluster Name: %q", id.KustoClusterName),
+		fmt.Sprintf("Kusto Database Name: %q", id.KustoDatabaseName),
+		fmt.Sprintf("Function Name: %q", id.FunctionName),
+	}
+	return fmt.Sprintf("Function (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&MaterializedViewId{})
+}
+
+var _ resourceids.ResourceId = &MaterializedViewId{}
+
+// MaterializedViewId is a struct representing the Resource ID for a Kusto Materialized View
+//
+// NOTE: Materialized Views are Data Plane resources, this ID is nested beneath the Kusto Database so that the Kusto Cluster
+// (and therefore the Data Plane Endpoint) and the Database can be determined from the ID.
+type MaterializedViewId struct {
+	SubscriptionId       string
+	ResourceGroupName    string
+	KustoClusterName     string
+	KustoDatabaseName    string
+	MaterializedViewName string
+}
+
+// NewMaterializedViewID returns a new MaterializedViewId struct
+func NewMaterializedViewID(subscriptionId string, resourceGroupName string, kustoClusterName string, kustoDatabaseName string, materializedViewName string) MaterializedViewId {
+	return MaterializedViewId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroupName:    resourceGroupName,
+		KustoClusterName:     kustoClusterName,
+		KustoDatabaseName:    kustoDatabaseName,
+		MaterializedViewName: materializedViewName,
+	}
+}
+
+// ParseMaterializedViewID parses 'input' into a MaterializedViewId
+func ParseMaterializedViewID(input string) (*MaterializedViewId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&MaterializedViewId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := MaterializedViewId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseMaterializedViewIDInsensitively parses 'input' case-insensitively into a MaterializedViewId
+// note: this method should only be used for API response data and not user input
+func ParseMaterializedViewIDInsensitively(input string) (*MaterializedViewId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&MaterializedViewId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := MaterializedViewId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *MaterializedViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.KustoClusterName, ok = input.Parsed["kustoClusterName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoClusterName", input)
+	}
+
+	if id.KustoDatabaseName, ok = input.Parsed["kustoDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoDatabaseName", input)
+	}
+
+	if id.MaterializedViewName, ok = input.Parsed["materializedViewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "materializedViewName", input)
+	}
+
+	return nil
+}
+
+// ValidateMaterializedViewID checks that 'input' can be parsed as a Materialized View ID
+func ValidateMaterializedViewID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseMaterializedViewID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Materialized View ID
+func (id MaterializedViewId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/clusters/%s/databases/%s/materializedViews/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName, id.MaterializedViewName)
+}
+
+// KustoDatabaseId returns the ID of the Kusto Database containing this Materialized View
+func (id MaterializedViewId) KustoDatabaseId() commonids.KustoDatabaseId {
+	return commonids.NewKustoDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Materialized View ID
+func (id MaterializedViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto", "Microsoft.Kusto"),
+		resourceids.StaticSegment("staticClusters", "clusters", "clusters"),
+		resourceids.UserSpecifiedSegment("kustoClusterName", "kustoClusterName"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("kustoDatabaseName", "kustoDatabaseName"),
+		resourceids.StaticSegment("staticMaterializedViews", "materializedViews", "materializedViews"),
+		resourceids.UserSpecifiedSegment("materializedViewName", "materializedViewName"),
+	}
+}
+
+// String returns a human-readable description of this Materialized View ID
+func (id MaterializedViewId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Kusto Cluster Name: %q", id.KustoClusterName),
+		fmt.Sprintf("Kusto Database Name: %q", id.KustoDatabaseName),
+		fmt.Sprintf("Materialized View Name: %q", id.MaterializedViewName),
+	}
+	return fmt.Sprintf("Materialized View (%s)", strings.Join(components, "\n"))
+}
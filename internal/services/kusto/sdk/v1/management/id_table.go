@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&TableId{})
+}
+
+var _ resourceids.ResourceId = &TableId{}
+
+// TableId is a struct representing the Resource ID for a Kusto Table
+//
+// NOTE: Tables are Data Plane resources, this ID is nested beneath the Kusto Database so that the Kusto Cluster
+// (and therefore the Data Plane Endpoint) and the Database can be determined from the ID.
+type TableId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	KustoClusterName  string
+	KustoDatabaseName string
+	TableName         string
+}
+
+// NewTableID returns a new TableId struct
+func NewTableID(subscriptionId string, resourceGroupName string, kustoClusterName string, kustoDatabaseName string, tableName string) TableId {
+	return TableId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		KustoClusterName:  kustoClusterName,
+		KustoDatabaseName: kustoDatabaseName,
+		TableName:         tableName,
+	}
+}
+
+// ParseTableID parses 'input' into a TableId
+func ParseTableID(input string) (*TableId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&TableId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := TableId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseTableIDInsensitively parses 'input' case-insensitively into a TableId
+// note: this method should only be used for API response data and not user input
+func ParseTableIDInsensitively(input string) (*TableId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&TableId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := TableId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *TableId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.KustoClusterName, ok = input.Parsed["kustoClusterName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoClusterName", input)
+	}
+
+	if id.KustoDatabaseName, ok = input.Parsed["kustoDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "kustoDatabaseName", input)
+	}
+
+	if id.TableName, ok = input.Parsed["tableName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tableName", input)
+	}
+
+	return nil
+}
+
+// ValidateTableID checks that 'input' can be parsed as a Table ID
+func ValidateTableID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseTableID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Table ID
+func (id TableId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/clusters/%s/databases/%s/tables/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName, id.TableName)
+}
+
+// KustoDatabaseId returns the ID of the Kusto Database containing this Table
+func (id TableId) KustoDatabaseId() commonids.KustoDatabaseId {
+	return commonids.NewKustoDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.KustoClusterName, id.KustoDatabaseName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Table ID
+func (id TableId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto", "Microsoft.Kusto"),
+		resourceids.StaticSegment("staticClusters", "clusters", "clusters"),
+		resourceids.UserSpecifiedSegment("kustoClusterName", "kustoClusterName"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("kustoDatabaseName", "kustoDatabaseName"),
+		resourceids.StaticSegment("staticTables", "tables", "tables"),
+		resourceids.UserSpecifiedSegment("tableName", "tableName"),
+	}
+}
+
+// String returns a human-readable description of this Table ID
+func (id TableId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Kusto Cluster Name: %q", id.KustoClusterName),
+		fmt.Sprintf("Kusto Database Name: %q", id.KustoDatabaseName),
+		fmt.Sprintf("Table Name: %q", id.TableName),
+	}
+	return fmt.Sprintf("Table (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ExecuteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ExecuteResult
+}
+
+// Execute runs a Management (Control) Command against the specified Database
+func (c ManagementClient) Execute(ctx context.Context, input ExecuteRequest) (result ExecuteOperationResponse, err error) {
+	if _, ok := ctx.Deadline(); !ok {
+		err = fmt.Errorf("internal-error: the context used must have a deadline attached for polling purposes, but got no deadline")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       "/v1/rest/mgmt",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ExecuteResult
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package management
+
+type ExecuteRequest struct {
+	Csl        string  `json:"csl"`
+	Db         string  `json:"db"`
+	Properties *string `json:"properties,omitempty"`
+}
+
+type ExecuteResult struct {
+	Tables []ResultTable `json:"Tables"`
+}
+
+type ResultTable struct {
+	TableName string          `json:"TableName"`
+	Columns   []ResultColumn  `json:"Columns"`
+	Rows      [][]interface{} `json:"Rows"`
+}
+
+type ResultColumn struct {
+	ColumnName string `json:"ColumnName"`
+	ColumnType string `json:"ColumnType"`
+	DataType   string `json:"DataType"`
+}
+
+// PrimaryResult returns the rows of the first table returned by a Management Command, which contains the result of
+// the command, with each row keyed by column name.
+func (r ExecuteResult) PrimaryResult() []map[string]interface{} {
+	output := make([]map[string]interface{}, 0)
+	if len(r.Tables) == 0 {
+		return output
+	}
+
+	table := r.Tables[0]
+	for _, row := range table.Rows {
+		record := make(map[string]interface{}, len(table.Columns))
+		for i, column := range table.Columns {
+			if i < len(row) {
+				record[column.ColumnName] = row[i]
+			}
+		}
+		output = append(output, record)
+	}
+
+	return output
+}
+
+type CachingPolicy struct {
+	DataHotSpan  *CachingPolicyHotSpan `json:"DataHotSpan,omitempty"`
+	IndexHotSpan *CachingPolicyHotSpan `json:"IndexHotSpan,omitempty"`
+}
+
+type CachingPolicyHotSpan struct {
+	Value string `json:"Value"`
+}
+
+type RetentionPolicy struct {
+	Recoverability   Recoverability `json:"Recoverability"`
+	SoftDeletePeriod string         `json:"SoftDeletePeriod"`
+}
+
+type TableSchema struct {
+	Name           string              `json:"Name"`
+	OrderedColumns []TableSchemaColumn `json:"OrderedColumns"`
+}
+
+type TableSchemaColumn struct {
+	CslType string `json:"CslType"`
+	Name    string `json:"Name"`
+	Type    string `json:"Type"`
+}
+
+type UpdatePolicy struct {
+	IsEnabled                    bool   `json:"IsEnabled"`
+	IsTransactional              bool   `json:"IsTransactional"`
+	PropagateIngestionProperties bool   `json:"PropagateIngestionProperties"`
+	Query                        string `json:"Query"`
+	Source                       string `json:"Source"`
+}
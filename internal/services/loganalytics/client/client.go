@@ -20,6 +20,7 @@ import (
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2023-01-01-preview/summarylogs"
 )
 
 type Client struct {
@@ -32,6 +33,7 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	SummaryLogsClient          *summarylogs.SummaryLogsClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	TablesClient               *tables.TablesClient
@@ -87,6 +89,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(storageInsightsClient.Client, o.Authorizers.ResourceManager)
 
+	summaryLogsClient, err := summarylogs.NewSummaryLogsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SummaryLogs client: %+v", err)
+	}
+	o.Configure(summaryLogsClient.Client, o.Authorizers.ResourceManager)
+
 	linkedServicesClient, err := linkedservices.NewLinkedServicesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building LinkedServices client: %+v", err)
@@ -128,6 +136,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SavedSearchesClient:        savedSearchesClient,
 		SolutionsClient:            solutionsClient,
 		StorageInsightsClient:      storageInsightsClient,
+		SummaryLogsClient:          summaryLogsClient,
 		SharedKeyWorkspacesClient:  workspacesClient,
 		TablesClient:               tablesClient,
 		WorkspaceClient:            featureWorkspaceClient,
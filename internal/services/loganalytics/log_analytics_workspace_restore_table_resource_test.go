@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceRestoreTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceRestoreTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_restore_table", "test")
+	r := LogAnalyticsWorkspaceRestoreTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceRestoreTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_restore_table", "test")
+	r := LogAnalyticsWorkspaceRestoreTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  retention_in_days   = 30
+}
+
+resource "azurerm_log_analytics_workspace_restore_table" "test" {
+  name         = "AppTraces%[1]d_RST"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  source_table = "AppTraces"
+  start_time   = "%[3]s"
+  end_time     = "%[4]s"
+}
+`, data.RandomInteger, data.Locations.Primary, searchTableStartTime(), searchTableEndTime())
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_restore_table" "import" {
+  name         = azurerm_log_analytics_workspace_restore_table.test.name
+  workspace_id = azurerm_log_analytics_workspace_restore_table.test.workspace_id
+  source_table = azurerm_log_analytics_workspace_restore_table.test.source_table
+  start_time   = azurerm_log_analytics_workspace_restore_table.test.start_time
+  end_time     = azurerm_log_analytics_workspace_restore_table.test.end_time
+}
+`, r.basic(data))
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceSearchTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_table").HasValue("AppTraces"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name         = "acctest%d_SRCH"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "AppTraces | where SeverityLevel > 1"
+  start_time   = "%s"
+  end_time     = "%s"
+}
+`, r.template(data), data.RandomInteger, searchTableStartTime(), searchTableEndTime())
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "import" {
+  name         = azurerm_log_analytics_workspace_search_table.test.name
+  workspace_id = azurerm_log_analytics_workspace_search_table.test.workspace_id
+  query        = azurerm_log_analytics_workspace_search_table.test.query
+  start_time   = azurerm_log_analytics_workspace_search_table.test.start_time
+  end_time     = azurerm_log_analytics_workspace_search_table.test.end_time
+}
+`, r.basic(data))
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name         = "acctest%d_SRCH"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "AppTraces | where SeverityLevel > 1"
+  start_time   = "%s"
+  end_time     = "%s"
+  limit        = 1000
+  description  = "Acceptance Test Search Job"
+}
+`, r.template(data), data.RandomInteger, searchTableStartTime(), searchTableEndTime())
+}
+
+// the time range of a search job has to be in the past, so it's computed relative to the current day
+func searchTableStartTime() string {
+	return time.Now().UTC().Truncate(24 * time.Hour).Add(-48 * time.Hour).Format(time.RFC3339)
+}
+
+func searchTableEndTime() string {
+	return time.Now().UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour).Format(time.RFC3339)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2023-01-01-preview/summarylogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogAnalyticsWorkspaceSummaryRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogAnalyticsWorkspaceSummaryRuleResource{}
+
+type LogAnalyticsWorkspaceSummaryRuleResourceModel struct {
+	Name              string `tfschema:"name"`
+	WorkspaceId       string `tfschema:"workspace_id"`
+	DisplayName       string `tfschema:"display_name"`
+	Description       string `tfschema:"description"`
+	Query             string `tfschema:"query"`
+	DestinationTable  string `tfschema:"destination_table"`
+	BinSizeInMinutes  int64  `tfschema:"bin_size_in_minutes"`
+	BinDelayInMinutes int64  `tfschema:"bin_delay_in_minutes"`
+	BinStartTime      string `tfschema:"bin_start_time"`
+	TimeSelector      string `tfschema:"time_selector"`
+	Enabled           bool   `tfschema:"enabled"`
+	StatusCode        string `tfschema:"status_code"`
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_]{0,62}$`),
+				"`name` must be between 1 and 63 characters long, start with a letter or number and can only contain letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_table": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*_CL$`),
+				"`destination_table` must start with a letter, can only contain letters, numbers and underscores and must end with `_CL`",
+			),
+		},
+
+		"bin_size_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntInSlice([]int{20, 30, 60, 120, 180, 360, 720, 1440}),
+		},
+
+		"bin_delay_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 1440),
+		},
+
+		"bin_start_time": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppressLogAnalyticsTimeDiff,
+		},
+
+		"time_selector": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(summarylogs.TimeSelectorEnumTimeGenerated),
+			ValidateFunc: validation.StringInSlice(summarylogs.PossibleValuesForTimeSelectorEnum(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceSummaryRuleResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_summary_rule"
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return summarylogs.ValidateSummaryLogID
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			var model LogAnalyticsWorkspaceSummaryRuleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := summarylogs.NewSummaryLogID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandLogAnalyticsWorkspaceSummaryRule(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// summary rules are active once created
+			if !model.Enabled {
+				if _, err := client.Stop(ctx, id); err != nil {
+					return fmt.Errorf("stopping %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := summarylogs.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := LogAnalyticsWorkspaceSummaryRuleResourceModel{
+				Name:        id.SummaryLogName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.IsActive)
+					state.StatusCode = string(pointer.From(props.StatusCode))
+
+					if def := props.RuleDefinition; def != nil {
+						state.Query = pointer.From(def.Query)
+						state.DestinationTable = pointer.From(def.DestinationTable)
+						state.BinSizeInMinutes = pointer.From(def.BinSize)
+						state.BinDelayInMinutes = pointer.From(def.BinDelay)
+						state.BinStartTime = pointer.From(def.BinStartTime)
+						state.TimeSelector = string(pointer.From(def.TimeSelector))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := summarylogs.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceSummaryRuleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("display_name", "description", "query", "bin_size_in_minutes", "bin_delay_in_minutes", "bin_start_time", "time_selector") {
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, expandLogAnalyticsWorkspaceSummaryRule(model)); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				if model.Enabled {
+					if _, err := client.Start(ctx, *id); err != nil {
+						return fmt.Errorf("starting %s: %+v", id, err)
+					}
+				} else {
+					if _, err := client.Stop(ctx, *id); err != nil {
+						return fmt.Errorf("stopping %s: %+v", id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := summarylogs.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceSummaryRule(model LogAnalyticsWorkspaceSummaryRuleResourceModel) summarylogs.SummaryLogs {
+	definition := summarylogs.RuleDefinition{
+		Query:            pointer.To(model.Query),
+		DestinationTable: pointer.To(model.DestinationTable),
+		BinSize:          pointer.To(model.BinSizeInMinutes),
+		TimeSelector:     pointer.To(summarylogs.TimeSelectorEnum(model.TimeSelector)),
+	}
+
+	if model.BinDelayInMinutes != 0 {
+		definition.BinDelay = pointer.To(model.BinDelayInMinutes)
+	}
+
+	if model.BinStartTime != "" {
+		definition.BinStartTime = pointer.To(model.BinStartTime)
+	}
+
+	props := summarylogs.SummaryLogsProperties{
+		RuleType:       pointer.To(summarylogs.RuleTypeEnumUser),
+		RuleDefinition: &definition,
+	}
+
+	if model.DisplayName != "" {
+		props.DisplayName = pointer.To(model.DisplayName)
+	}
+
+	if model.Description != "" {
+		props.Description = pointer.To(model.Description)
+	}
+
+	return summarylogs.SummaryLogs{
+		Properties: &props,
+	}
+}
+
+// suppressLogAnalyticsTimeDiff suppresses the diff between RFC3339 timestamps which represent the same point in time,
+// since the service may return the time in a different format to the one specified
+func suppressLogAnalyticsTimeDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
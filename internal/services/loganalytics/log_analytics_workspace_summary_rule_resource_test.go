@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2023-01-01-preview/summarylogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceSummaryRuleResource struct{}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := summarylogs.ParseSummaryLogID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.SummaryLogsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "test" {
+  name                = "acctest-sr-%d"
+  workspace_id        = azurerm_log_analytics_workspace.test.id
+  query               = "AppTraces | summarize Count = count() by AppRoleName"
+  destination_table   = "AppTracesSummary_CL"
+  bin_size_in_minutes = 60
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "import" {
+  name                = azurerm_log_analytics_workspace_summary_rule.test.name
+  workspace_id        = azurerm_log_analytics_workspace_summary_rule.test.workspace_id
+  query               = azurerm_log_analytics_workspace_summary_rule.test.query
+  destination_table   = azurerm_log_analytics_workspace_summary_rule.test.destination_table
+  bin_size_in_minutes = azurerm_log_analytics_workspace_summary_rule.test.bin_size_in_minutes
+}
+`, r.basic(data))
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "test" {
+  name                 = "acctest-sr-%d"
+  workspace_id         = azurerm_log_analytics_workspace.test.id
+  display_name         = "Acceptance Test Summary Rule"
+  description          = "Summarises the AppTraces table by role"
+  query                = "AppTraces | summarize Count = count() by AppRoleName, SeverityLevel"
+  destination_table    = "AppTracesSummary_CL"
+  bin_size_in_minutes  = 120
+  bin_delay_in_minutes = 10
+  time_selector        = "TimeGenerated"
+  enabled              = false
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// waitForLogAnalyticsWorkspaceTableProvisioned waits until the Table has finished provisioning, which for search result
+// and restored tables happens once the search job or restore has completed rather than when the long running operation
+// to create the Table completes.
+func waitForLogAnalyticsWorkspaceTableProvisioned(ctx context.Context, client *tables.TablesClient, id tables.TableId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(tables.ProvisioningStateEnumInProgress),
+			string(tables.ProvisioningStateEnumUpdating),
+		},
+		Target: []string{
+			string(tables.ProvisioningStateEnumSucceeded),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			return resp, string(pointer.From(resp.Model.Properties.ProvisioningState)), nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	return nil
+}
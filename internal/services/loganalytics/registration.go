@@ -30,6 +30,9 @@ func (r Registration) Resources() []sdk.Resource {
 		LogAnalyticsQueryPackQueryResource{},
 		LogAnalyticsSolutionResource{},
 		LogAnalyticsWorkspaceTableResource{},
+		LogAnalyticsWorkspaceSummaryRuleResource{},
+		LogAnalyticsWorkspaceSearchTableResource{},
+		LogAnalyticsWorkspaceRestoreTableResource{},
 	}
 }
 
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package summarylogs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// NOTE: Summary Logs are not yet available in `hashicorp/go-azure-sdk`, this package mirrors the layout of the
+// generated SDK so that it can be swapped out for the upstream package once it becomes available.
+
+const defaultApiVersion = "2023-01-01-preview"
+
+type SummaryLogsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewSummaryLogsClientWithBaseURI(sdkApi sdkEnv.Api) (*SummaryLogsClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "summarylogs", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SummaryLogsClient: %+v", err)
+	}
+
+	return &SummaryLogsClient{
+		Client: client,
+	}, nil
+}
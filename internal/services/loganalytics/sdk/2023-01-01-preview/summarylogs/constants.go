@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package summarylogs
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumDeleting   ProvisioningStateEnum = "Deleting"
+	ProvisioningStateEnumFailed     ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+	ProvisioningStateEnumUpdating   ProvisioningStateEnum = "Updating"
+)
+
+type RuleTypeEnum string
+
+const (
+	RuleTypeEnumSystem RuleTypeEnum = "System"
+	RuleTypeEnumUser   RuleTypeEnum = "User"
+)
+
+type StatusCodeEnum string
+
+const (
+	StatusCodeEnumDataPlaneError StatusCodeEnum = "DataPlaneError"
+	StatusCodeEnumUserAction     StatusCodeEnum = "UserAction"
+)
+
+func PossibleValuesForStatusCodeEnum() []string {
+	return []string{
+		string(StatusCodeEnumDataPlaneError),
+		string(StatusCodeEnumUserAction),
+	}
+}
+
+type TimeSelectorEnum string
+
+const (
+	TimeSelectorEnumTimeGenerated TimeSelectorEnum = "TimeGenerated"
+)
+
+func PossibleValuesForTimeSelectorEnum() []string {
+	return []string{
+		string(TimeSelectorEnumTimeGenerated),
+	}
+}
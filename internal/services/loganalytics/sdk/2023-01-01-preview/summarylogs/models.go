@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package summarylogs
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type SummaryLogs struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SummaryLogsProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type SummaryLogsProperties struct {
+	Description       *string                `json:"description,omitempty"`
+	DisplayName       *string                `json:"displayName,omitempty"`
+	IsActive          *bool                  `json:"isActive,omitempty"`
+	ProvisioningState *ProvisioningStateEnum `json:"provisioningState,omitempty"`
+	RuleDefinition    *RuleDefinition        `json:"ruleDefinition,omitempty"`
+	RuleType          *RuleTypeEnum          `json:"ruleType,omitempty"`
+	StatusCode        *StatusCodeEnum        `json:"statusCode,omitempty"`
+}
+
+type RuleDefinition struct {
+	BinDelay         *int64            `json:"binDelay,omitempty"`
+	BinSize          *int64            `json:"binSize,omitempty"`
+	BinStartTime     *string           `json:"binStartTime,omitempty"`
+	DestinationTable *string           `json:"destinationTable,omitempty"`
+	Query            *string           `json:"query,omitempty"`
+	TimeSelector     *TimeSelectorEnum `json:"timeSelector,omitempty"`
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogicAppStandardConnectionsResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogicAppStandardConnectionsResource{}
+
+type LogicAppStandardConnectionsModel struct {
+	LogicAppId string `tfschema:"logic_app_id"`
+	Content    string `tfschema:"content"`
+}
+
+func (r LogicAppStandardConnectionsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"logic_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateLogicAppId,
+		},
+
+		"content": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r LogicAppStandardConnectionsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogicAppStandardConnectionsResource) ResourceType() string {
+	return "azurerm_logic_app_standard_connections"
+}
+
+func (r LogicAppStandardConnectionsResource) ModelObject() interface{} {
+	return &LogicAppStandardConnectionsModel{}
+}
+
+func (r LogicAppStandardConnectionsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateLogicAppId
+}
+
+func (r LogicAppStandardConnectionsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogicAppStandardConnectionsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseLogicAppId(model.LogicAppId)
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetFile(ctx, logicAppStandardConnectionsFileName)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing `%s` on %s: %+v", logicAppStandardConnectionsFileName, id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			content, err := normalizeLogicAppStandardJson(model.Content)
+			if err != nil {
+				return fmt.Errorf("normalizing `content`: %+v", err)
+			}
+
+			if err := client.PutFile(ctx, logicAppStandardConnectionsFileName, content); err != nil {
+				return fmt.Errorf("creating `%s` on %s: %+v", logicAppStandardConnectionsFileName, id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardConnectionsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			content, err := client.GetFile(ctx, logicAppStandardConnectionsFileName)
+			if err != nil {
+				return fmt.Errorf("retrieving `%s` on %s: %+v", logicAppStandardConnectionsFileName, id, err)
+			}
+			if content == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			model := LogicAppStandardConnectionsModel{
+				LogicAppId: id.ID(),
+				Content:    *content,
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r LogicAppStandardConnectionsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogicAppStandardConnectionsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			content, err := normalizeLogicAppStandardJson(model.Content)
+			if err != nil {
+				return fmt.Errorf("normalizing `content`: %+v", err)
+			}
+
+			if err := client.PutFile(ctx, logicAppStandardConnectionsFileName, content); err != nil {
+				return fmt.Errorf("updating `%s` on %s: %+v", logicAppStandardConnectionsFileName, id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardConnectionsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, logicAppStandardConnectionsFileName); err != nil {
+				return fmt.Errorf("deleting `%s` on %s: %+v", logicAppStandardConnectionsFileName, id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppStandardConnectionsResource struct{}
+
+func TestAccLogicAppStandardConnections_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_connections", "test")
+	r := LogicAppStandardConnectionsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardConnections_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_connections", "test")
+	r := LogicAppStandardConnectionsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardConnections_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_connections", "test")
+	r := LogicAppStandardConnectionsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogicAppStandardConnectionsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseLogicAppId(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := logic.NewLogicAppStandardVfsClient(ctx, clients.AppService.WebAppsClient, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := client.GetFile(ctx, "connections.json")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving `connections.json` on %s: %+v", id, err)
+	}
+
+	return pointer.To(content != nil), nil
+}
+
+func (r LogicAppStandardConnectionsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_connections" "test" {
+  logic_app_id = azurerm_logic_app_standard.test.id
+
+  content = jsonencode({
+    serviceProviderConnections = {
+      serviceBus = {
+        displayName = "Service Bus"
+        parameterValues = {
+          connectionString = "@appsetting('serviceBus_connectionString')"
+        }
+        serviceProvider = {
+          id = "/serviceProviders/serviceBus"
+        }
+      }
+    }
+  })
+}
+`, LogicAppStandardResource{}.basic(data))
+}
+
+func (r LogicAppStandardConnectionsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_connections" "import" {
+  logic_app_id = azurerm_logic_app_standard_connections.test.logic_app_id
+  content      = azurerm_logic_app_standard_connections.test.content
+}
+`, r.basic(data))
+}
+
+func (r LogicAppStandardConnectionsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_connections" "test" {
+  logic_app_id = azurerm_logic_app_standard.test.id
+
+  content = jsonencode({
+    serviceProviderConnections = {
+      serviceBus = {
+        displayName = "Service Bus"
+        parameterValues = {
+          connectionString = "@appsetting('serviceBus_connectionString')"
+        }
+        serviceProvider = {
+          id = "/serviceProviders/serviceBus"
+        }
+      }
+      AzureBlob = {
+        displayName = "Blob Storage"
+        parameterValues = {
+          connectionString = "@appsetting('AzureWebJobsStorage')"
+        }
+        serviceProvider = {
+          id = "/serviceProviders/AzureBlob"
+        }
+      }
+    }
+  })
+}
+`, LogicAppStandardResource{}.basic(data))
+}
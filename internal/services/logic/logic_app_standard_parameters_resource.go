@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogicAppStandardParametersResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogicAppStandardParametersResource{}
+
+type LogicAppStandardParametersModel struct {
+	LogicAppId string `tfschema:"logic_app_id"`
+	Content    string `tfschema:"content"`
+}
+
+func (r LogicAppStandardParametersResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"logic_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateLogicAppId,
+		},
+
+		"content": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r LogicAppStandardParametersResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogicAppStandardParametersResource) ResourceType() string {
+	return "azurerm_logic_app_standard_parameters"
+}
+
+func (r LogicAppStandardParametersResource) ModelObject() interface{} {
+	return &LogicAppStandardParametersModel{}
+}
+
+func (r LogicAppStandardParametersResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateLogicAppId
+}
+
+func (r LogicAppStandardParametersResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogicAppStandardParametersModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseLogicAppId(model.LogicAppId)
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetFile(ctx, logicAppStandardParametersFileName)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing `%s` on %s: %+v", logicAppStandardParametersFileName, id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			content, err := normalizeLogicAppStandardJson(model.Content)
+			if err != nil {
+				return fmt.Errorf("normalizing `content`: %+v", err)
+			}
+
+			if err := client.PutFile(ctx, logicAppStandardParametersFileName, content); err != nil {
+				return fmt.Errorf("creating `%s` on %s: %+v", logicAppStandardParametersFileName, id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardParametersResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			content, err := client.GetFile(ctx, logicAppStandardParametersFileName)
+			if err != nil {
+				return fmt.Errorf("retrieving `%s` on %s: %+v", logicAppStandardParametersFileName, id, err)
+			}
+			if content == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			model := LogicAppStandardParametersModel{
+				LogicAppId: id.ID(),
+				Content:    *content,
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r LogicAppStandardParametersResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogicAppStandardParametersModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			content, err := normalizeLogicAppStandardJson(model.Content)
+			if err != nil {
+				return fmt.Errorf("normalizing `content`: %+v", err)
+			}
+
+			if err := client.PutFile(ctx, logicAppStandardParametersFileName, content); err != nil {
+				return fmt.Errorf("updating `%s` on %s: %+v", logicAppStandardParametersFileName, id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardParametersResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *id)
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, logicAppStandardParametersFileName); err != nil {
+				return fmt.Errorf("deleting `%s` on %s: %+v", logicAppStandardParametersFileName, id, err)
+			}
+
+			return nil
+		},
+	}
+}
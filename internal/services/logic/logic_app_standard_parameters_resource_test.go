@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppStandardParametersResource struct{}
+
+func TestAccLogicAppStandardParameters_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_parameters", "test")
+	r := LogicAppStandardParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardParameters_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_parameters", "test")
+	r := LogicAppStandardParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardParameters_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_parameters", "test")
+	r := LogicAppStandardParametersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogicAppStandardParametersResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseLogicAppId(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := logic.NewLogicAppStandardVfsClient(ctx, clients.AppService.WebAppsClient, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := client.GetFile(ctx, "parameters.json")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving `parameters.json` on %s: %+v", id, err)
+	}
+
+	return pointer.To(content != nil), nil
+}
+
+func (r LogicAppStandardParametersResource) basic(data acceptance.TestData, message string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_parameters" "test" {
+  logic_app_id = azurerm_logic_app_standard.test.id
+
+  content = jsonencode({
+    message = {
+      type  = "String"
+      value = "%s"
+    }
+  })
+}
+`, LogicAppStandardResource{}.basic(data), message)
+}
+
+func (r LogicAppStandardParametersResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_parameters" "import" {
+  logic_app_id = azurerm_logic_app_standard_parameters.test.logic_app_id
+  content      = azurerm_logic_app_standard_parameters.test.content
+}
+`, r.basic(data, "hello"))
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+const (
+	logicAppStandardConnectionsFileName = "connections.json"
+	logicAppStandardParametersFileName  = "parameters.json"
+	logicAppStandardWorkflowFileName    = "workflow.json"
+)
+
+// LogicAppStandardVfsClient reads and writes the project files of a Logic App Standard (workflow definitions,
+// `connections.json` and `parameters.json`) through the Kudu VFS API of the app's SCM site, which is how the
+// Logic App runtime expects them to be deployed. There is no Resource Manager API to write these files.
+type LogicAppStandardVfsClient struct {
+	host      string
+	user      string
+	password  string
+	userAgent string
+}
+
+func NewLogicAppStandardVfsClient(ctx context.Context, client *webapps.WebAppsClient, id commonids.LogicAppId) (*LogicAppStandardVfsClient, error) {
+	site, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	host := ""
+	if model := site.Model; model != nil && model.Properties != nil && model.Properties.HostNameSslStates != nil {
+		for _, v := range *model.Properties.HostNameSslStates {
+			if v.Name != nil && *v.Name != "" && pointer.From(v.HostType) == webapps.HostTypeRepository {
+				host = fmt.Sprintf("https://%s", *v.Name)
+				break
+			}
+		}
+	}
+	if host == "" {
+		return nil, fmt.Errorf("could not determine the SCM Site host name for %s", id)
+	}
+
+	user, password, err := helpers.GetSitePublishingCredentials(ctx, client, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving publishing credentials for %s: %+v", id, err)
+	}
+
+	return &LogicAppStandardVfsClient{
+		host:      host,
+		user:      pointer.From(user),
+		password:  pointer.From(password),
+		userAgent: client.Client.UserAgent,
+	}, nil
+}
+
+// GetFile returns the contents of the file at `path` (relative to `site/wwwroot`), or nil if the file does not exist
+func (c LogicAppStandardVfsClient) GetFile(ctx context.Context, path string) (*string, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body for %q: %+v", path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving %q: unexpected status %s: %s", path, resp.Status, string(body))
+	}
+
+	return pointer.To(string(body)), nil
+}
+
+// PutFile creates or overwrites the file at `path` (relative to `site/wwwroot`), creating any parent directories
+func (c LogicAppStandardVfsClient) PutFile(ctx context.Context, path string, content string) error {
+	resp, err := c.do(ctx, http.MethodPut, path, strings.NewReader(content))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("writing %q: unexpected status %s: %s", path, resp.Status, string(body))
+	}
+
+	return nil
+}
+
+// Delete removes the file, or the directory and its contents when `path` ends with `/`, at `path` (relative to `site/wwwroot`)
+func (c LogicAppStandardVfsClient) Delete(ctx context.Context, path string) error {
+	if strings.HasSuffix(path, "/") {
+		path += "?recursive=true"
+	}
+
+	resp, err := c.do(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("deleting %q: unexpected status %s: %s", path, resp.Status, string(body))
+	}
+
+	return nil
+}
+
+func (c LogicAppStandardVfsClient) do(ctx context.Context, method string, path string, body io.Reader) (*http.Response, error) {
+	if body == nil {
+		body = http.NoBody
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/api/vfs/site/wwwroot/%s", c.host, path), body)
+	if err != nil {
+		return nil, fmt.Errorf("preparing %s request for %q: %+v", method, path, err)
+	}
+
+	req.SetBasicAuth(c.user, c.password)
+	req.Header["Cache-Control"] = []string{"no-cache"}
+	req.Header["User-Agent"] = []string{c.userAgent}
+	if method != http.MethodGet {
+		// the VFS API rejects overwriting or deleting an existing file without an ETag, `*` matches any version
+		req.Header["If-Match"] = []string{"*"}
+	}
+	if method == http.MethodPut {
+		req.Header["Content-Type"] = []string{"application/octet-stream"}
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending %s request for %q: %+v", method, path, err)
+	}
+
+	return resp, nil
+}
+
+// normalizeLogicAppStandardJson re-encodes `input` so that the content written to the app is stable regardless of
+// the formatting used in the configuration
+func normalizeLogicAppStandardJson(input string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(input), "", "  "); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
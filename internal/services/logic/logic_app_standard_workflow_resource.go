@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	logicAppStandardWorkflowKindStateful  = "Stateful"
+	logicAppStandardWorkflowKindStateless = "Stateless"
+)
+
+type LogicAppStandardWorkflowResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogicAppStandardWorkflowResource{}
+
+type LogicAppStandardWorkflowModel struct {
+	Name       string `tfschema:"name"`
+	LogicAppId string `tfschema:"logic_app_id"`
+	Kind       string `tfschema:"kind"`
+	Definition string `tfschema:"definition"`
+}
+
+// logicAppStandardWorkflowFile is the content of the `workflow.json` file of a Logic App Standard workflow
+type logicAppStandardWorkflowFile struct {
+	Definition json.RawMessage `json:"definition"`
+	Kind       string          `json:"kind"`
+}
+
+func (r LogicAppStandardWorkflowResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LogicAppStandardWorkflowName,
+		},
+
+		"logic_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateLogicAppId,
+		},
+
+		"definition": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  logicAppStandardWorkflowKindStateful,
+			ValidateFunc: validation.StringInSlice([]string{
+				logicAppStandardWorkflowKindStateful,
+				logicAppStandardWorkflowKindStateless,
+			}, false),
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogicAppStandardWorkflowResource) ResourceType() string {
+	return "azurerm_logic_app_standard_workflow"
+}
+
+func (r LogicAppStandardWorkflowResource) ModelObject() interface{} {
+	return &LogicAppStandardWorkflowModel{}
+}
+
+func (r LogicAppStandardWorkflowResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webapps.ValidateWorkflowID
+}
+
+func (r LogicAppStandardWorkflowResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogicAppStandardWorkflowModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			logicAppId, err := commonids.ParseLogicAppId(model.LogicAppId)
+			if err != nil {
+				return err
+			}
+
+			id := webapps.NewWorkflowID(logicAppId.SubscriptionId, logicAppId.ResourceGroupName, logicAppId.SiteName, model.Name)
+
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, *logicAppId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetFile(ctx, r.workflowFilePath(id))
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			content, err := r.expandWorkflowFile(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.PutFile(ctx, r.workflowFilePath(id), content); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := webapps.ParseWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, logicAppId)
+			if err != nil {
+				return err
+			}
+
+			content, err := client.GetFile(ctx, r.workflowFilePath(*id))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if content == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			var file logicAppStandardWorkflowFile
+			if err := json.Unmarshal([]byte(*content), &file); err != nil {
+				return fmt.Errorf("parsing the `%s` file of %s: %+v", logicAppStandardWorkflowFileName, id, err)
+			}
+
+			model := LogicAppStandardWorkflowModel{
+				Name:       id.WorkflowName,
+				LogicAppId: logicAppId.ID(),
+				Kind:       file.Kind,
+				Definition: string(file.Definition),
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := webapps.ParseWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogicAppStandardWorkflowModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, logicAppId)
+			if err != nil {
+				return err
+			}
+
+			content, err := r.expandWorkflowFile(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.PutFile(ctx, r.workflowFilePath(*id), content); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := webapps.ParseWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+			client, err := NewLogicAppStandardVfsClient(ctx, metadata.Client.AppService.WebAppsClient, logicAppId)
+			if err != nil {
+				return err
+			}
+
+			// the workflow is a directory containing `workflow.json` (and any files added alongside it), so the whole directory is removed
+			if err := client.Delete(ctx, fmt.Sprintf("%s/", id.WorkflowName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) workflowFilePath(id webapps.WorkflowId) string {
+	return fmt.Sprintf("%s/%s", id.WorkflowName, logicAppStandardWorkflowFileName)
+}
+
+func (r LogicAppStandardWorkflowResource) expandWorkflowFile(model LogicAppStandardWorkflowModel) (string, error) {
+	file := logicAppStandardWorkflowFile{
+		Definition: json.RawMessage(model.Definition),
+		Kind:       model.Kind,
+	}
+
+	content, err := json.Marshal(file)
+	if err != nil {
+		return "", fmt.Errorf("building the `%s` file: %+v", logicAppStandardWorkflowFileName, err)
+	}
+
+	return normalizeLogicAppStandardJson(string(content))
+}
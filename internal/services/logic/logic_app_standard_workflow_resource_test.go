@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppStandardWorkflowResource struct{}
+
+func TestAccLogicAppStandardWorkflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("Stateful"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_stateless(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("Stateless"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogicAppStandardWorkflowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webapps.ParseWorkflowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := logic.NewLogicAppStandardVfsClient(ctx, clients.AppService.WebAppsClient, commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := client.GetFile(ctx, fmt.Sprintf("%s/workflow.json", id.WorkflowName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(content != nil), nil
+}
+
+func (r LogicAppStandardWorkflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow-%d"
+  logic_app_id = azurerm_logic_app_standard.test.id
+
+  definition = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+    contentVersion = "1.0.0.0"
+    triggers = {
+      manual = {
+        type   = "Request"
+        kind   = "Http"
+        inputs = {}
+      }
+    }
+    actions = {
+      Response = {
+        type     = "Response"
+        kind     = "Http"
+        runAfter = {}
+        inputs = {
+          statusCode = 200
+        }
+      }
+    }
+    outputs = {}
+  })
+}
+`, LogicAppStandardResource{}.basic(data), data.RandomInteger)
+}
+
+func (r LogicAppStandardWorkflowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "import" {
+  name         = azurerm_logic_app_standard_workflow.test.name
+  logic_app_id = azurerm_logic_app_standard_workflow.test.logic_app_id
+  definition   = azurerm_logic_app_standard_workflow.test.definition
+}
+`, r.basic(data))
+}
+
+func (r LogicAppStandardWorkflowResource) stateless(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow-%d"
+  logic_app_id = azurerm_logic_app_standard.test.id
+  kind         = "Stateless"
+
+  definition = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+    contentVersion = "1.0.0.0"
+    triggers = {
+      manual = {
+        type   = "Request"
+        kind   = "Http"
+        inputs = {}
+      }
+    }
+    actions = {
+      Response = {
+        type     = "Response"
+        kind     = "Http"
+        runAfter = {}
+        inputs = {
+          statusCode = 202
+          body       = "@parameters('message')"
+        }
+      }
+    }
+    parameters = {
+      message = {
+        type         = "String"
+        defaultValue = "accepted"
+      }
+    }
+    outputs = {}
+  })
+}
+`, LogicAppStandardResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogicAppStandardWorkflowName(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "workflow1",
+			valid: true,
+		},
+		{
+			input: "order-processing_v2",
+			valid: true,
+		},
+		{
+			input: "-workflow",
+			valid: false,
+		},
+		{
+			input: "work.flow",
+			valid: false,
+		},
+		{
+			input: "work flow",
+			valid: false,
+		},
+		{
+			input: strings.Repeat("s", 80),
+			valid: true,
+		},
+		{
+			input: strings.Repeat("s", 81),
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, errors := LogicAppStandardWorkflowName(tt.input, "name")
+			valid := len(errors) == 0
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for input %s", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningOnlineDeployment struct{}
+
+type MachineLearningOnlineDeploymentModel struct {
+	Name                             string                    `tfschema:"name"`
+	OnlineEndpointId                 string                    `tfschema:"online_endpoint_id"`
+	InstanceType                     string                    `tfschema:"instance_type"`
+	InstanceCount                    int64                     `tfschema:"instance_count"`
+	ModelId                          string                    `tfschema:"model_id"`
+	EnvironmentId                    string                    `tfschema:"environment_id"`
+	CodeConfiguration                []OnlineCodeConfiguration `tfschema:"code_configuration"`
+	TargetUtilizationScale           []OnlineTargetUtilization `tfschema:"target_utilization_scale"`
+	LivenessProbe                    []OnlineProbe             `tfschema:"liveness_probe"`
+	ReadinessProbe                   []OnlineProbe             `tfschema:"readiness_probe"`
+	RequestSettings                  []OnlineRequestSettings   `tfschema:"request_settings"`
+	EnvironmentVariables             map[string]string         `tfschema:"environment_variables"`
+	AppInsightsEnabled               bool                      `tfschema:"app_insights_enabled"`
+	EgressPublicNetworkAccessEnabled bool                      `tfschema:"egress_public_network_access_enabled"`
+	Description                      string                    `tfschema:"description"`
+	Tags                             map[string]string         `tfschema:"tags"`
+}
+
+type OnlineCodeConfiguration struct {
+	CodeId        string `tfschema:"code_id"`
+	ScoringScript string `tfschema:"scoring_script"`
+}
+
+type OnlineTargetUtilization struct {
+	MinInstances                int64  `tfschema:"min_instances"`
+	MaxInstances                int64  `tfschema:"max_instances"`
+	PollingInterval             string `tfschema:"polling_interval"`
+	TargetUtilizationPercentage int64  `tfschema:"target_utilization_percentage"`
+}
+
+type OnlineProbe struct {
+	FailureThreshold int64  `tfschema:"failure_threshold"`
+	SuccessThreshold int64  `tfschema:"success_threshold"`
+	InitialDelay     string `tfschema:"initial_delay"`
+	Period           string `tfschema:"period"`
+	Timeout          string `tfschema:"timeout"`
+}
+
+type OnlineRequestSettings struct {
+	MaxConcurrentRequestsPerInstance int64  `tfschema:"max_concurrent_requests_per_instance"`
+	RequestTimeout                   string `tfschema:"request_timeout"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineDeployment{}
+
+func (r MachineLearningOnlineDeployment) ResourceType() string {
+	return "azurerm_machine_learning_online_deployment"
+}
+
+func (r MachineLearningOnlineDeployment) ModelObject() interface{} {
+	return &MachineLearningOnlineDeploymentModel{}
+}
+
+func (r MachineLearningOnlineDeployment) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlinedeployment.ValidateOnlineEndpointDeploymentID
+}
+
+func (r MachineLearningOnlineDeployment) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.OnlineDeploymentName,
+		},
+
+		"online_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlinedeployment.ValidateOnlineEndpointID,
+		},
+
+		"instance_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"model_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"code_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scoring_script": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"code_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"target_utilization_scale": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"min_instances": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"max_instances": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"polling_interval": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT1S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"target_utilization_percentage": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      70,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+				},
+			},
+		},
+
+		"liveness_probe": onlineProbeSchema(),
+
+		"readiness_probe": onlineProbeSchema(),
+
+		"request_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_concurrent_requests_per_instance": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"request_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT5S",
+						ValidateFunc: azValidate.ISO8601DurationBetween("PT1S", "PT3M"),
+					},
+				},
+			},
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"app_insights_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"egress_public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningOnlineDeployment) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+			endpointClient := metadata.Client.MachineLearning.OnlineEndpoint
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			endpointId, err := onlinedeployment.ParseOnlineEndpointID(model.OnlineEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := onlinedeployment.NewOnlineEndpointDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.OnlineEndpointName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// a Deployment must live in the same location as the Endpoint it belongs to
+			endpoint, err := endpointClient.Get(ctx, onlineendpoint.NewOnlineEndpointID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.OnlineEndpointName))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", endpointId, err)
+			}
+			if endpoint.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", endpointId)
+			}
+
+			payload := onlinedeployment.OnlineDeploymentTrackedResource{
+				Location:   endpoint.Model.Location,
+				Properties: expandOnlineDeployment(model),
+				Sku: &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(model.InstanceCount),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineDeploymentModel{
+				Name:             id.DeploymentName,
+				OnlineEndpointId: onlinedeployment.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.InstanceCount = pointer.From(sku.Capacity)
+				}
+
+				props, ok := model.Properties.(onlinedeployment.ManagedOnlineDeployment)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected a Managed Online Deployment but got %T", *id, model.Properties)
+				}
+
+				state.InstanceType = pointer.From(props.InstanceType)
+				state.ModelId = pointer.From(props.Model)
+				state.EnvironmentId = pointer.From(props.EnvironmentId)
+				state.EnvironmentVariables = pointer.From(props.EnvironmentVariables)
+				state.AppInsightsEnabled = pointer.From(props.AppInsightsEnabled)
+				state.EgressPublicNetworkAccessEnabled = pointer.From(props.EgressPublicNetworkAccess) != onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+				state.Description = pointer.From(props.Description)
+				state.CodeConfiguration = flattenOnlineCodeConfiguration(props.CodeConfiguration)
+				state.TargetUtilizationScale = flattenOnlineTargetUtilization(props.ScaleSettings)
+				state.LivenessProbe = flattenOnlineProbe(props.LivenessProbe)
+				state.ReadinessProbe = flattenOnlineProbe(props.ReadinessProbe)
+				state.RequestSettings = flattenOnlineRequestSettings(props.RequestSettings)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := onlinedeployment.OnlineDeploymentTrackedResource{
+				Location:   existing.Model.Location,
+				Properties: expandOnlineDeployment(model),
+				Sku: &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(model.InstanceCount),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func onlineProbeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"failure_threshold": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      30,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"success_threshold": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"initial_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT10S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+
+				"period": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT10S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+
+				"timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT2S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
+func expandOnlineDeployment(input MachineLearningOnlineDeploymentModel) onlinedeployment.ManagedOnlineDeployment {
+	egressPublicNetworkAccess := onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+	if input.EgressPublicNetworkAccessEnabled {
+		egressPublicNetworkAccess = onlinedeployment.EgressPublicNetworkAccessTypeEnabled
+	}
+
+	output := onlinedeployment.ManagedOnlineDeployment{
+		AppInsightsEnabled:        pointer.To(input.AppInsightsEnabled),
+		EgressPublicNetworkAccess: pointer.To(egressPublicNetworkAccess),
+		EndpointComputeType:       onlinedeployment.EndpointComputeTypeManaged,
+		EnvironmentVariables:      pointer.To(input.EnvironmentVariables),
+		InstanceType:              pointer.To(input.InstanceType),
+		LivenessProbe:             expandOnlineProbe(input.LivenessProbe),
+		ReadinessProbe:            expandOnlineProbe(input.ReadinessProbe),
+		ScaleSettings:             onlinedeployment.DefaultScaleSettings{},
+	}
+
+	if input.ModelId != "" {
+		output.Model = pointer.To(input.ModelId)
+	}
+
+	if input.EnvironmentId != "" {
+		output.EnvironmentId = pointer.To(input.EnvironmentId)
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if len(input.CodeConfiguration) > 0 {
+		code := input.CodeConfiguration[0]
+		output.CodeConfiguration = &onlinedeployment.CodeConfiguration{
+			ScoringScript: code.ScoringScript,
+		}
+		if code.CodeId != "" {
+			output.CodeConfiguration.CodeId = pointer.To(code.CodeId)
+		}
+	}
+
+	if len(input.TargetUtilizationScale) > 0 {
+		scale := input.TargetUtilizationScale[0]
+		output.ScaleSettings = onlinedeployment.TargetUtilizationScaleSettings{
+			MinInstances:                pointer.To(scale.MinInstances),
+			MaxInstances:                pointer.To(scale.MaxInstances),
+			PollingInterval:             pointer.To(scale.PollingInterval),
+			TargetUtilizationPercentage: pointer.To(scale.TargetUtilizationPercentage),
+		}
+	}
+
+	if len(input.RequestSettings) > 0 {
+		settings := input.RequestSettings[0]
+		output.RequestSettings = &onlinedeployment.OnlineRequestSettings{
+			MaxConcurrentRequestsPerInstance: pointer.To(settings.MaxConcurrentRequestsPerInstance),
+			RequestTimeout:                   pointer.To(settings.RequestTimeout),
+		}
+	}
+
+	return output
+}
+
+func expandOnlineProbe(input []OnlineProbe) *onlinedeployment.ProbeSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	probe := input[0]
+	return &onlinedeployment.ProbeSettings{
+		FailureThreshold: pointer.To(probe.FailureThreshold),
+		SuccessThreshold: pointer.To(probe.SuccessThreshold),
+		InitialDelay:     pointer.To(probe.InitialDelay),
+		Period:           pointer.To(probe.Period),
+		Timeout:          pointer.To(probe.Timeout),
+	}
+}
+
+func flattenOnlineCodeConfiguration(input *onlinedeployment.CodeConfiguration) []OnlineCodeConfiguration {
+	if input == nil {
+		return []OnlineCodeConfiguration{}
+	}
+
+	return []OnlineCodeConfiguration{
+		{
+			CodeId:        pointer.From(input.CodeId),
+			ScoringScript: input.ScoringScript,
+		},
+	}
+}
+
+func flattenOnlineTargetUtilization(input onlinedeployment.OnlineScaleSettings) []OnlineTargetUtilization {
+	scale, ok := input.(onlinedeployment.TargetUtilizationScaleSettings)
+	if !ok {
+		return []OnlineTargetUtilization{}
+	}
+
+	return []OnlineTargetUtilization{
+		{
+			MinInstances:                pointer.From(scale.MinInstances),
+			MaxInstances:                pointer.From(scale.MaxInstances),
+			PollingInterval:             pointer.From(scale.PollingInterval),
+			TargetUtilizationPercentage: pointer.From(scale.TargetUtilizationPercentage),
+		},
+	}
+}
+
+func flattenOnlineProbe(input *onlinedeployment.ProbeSettings) []OnlineProbe {
+	if input == nil {
+		return []OnlineProbe{}
+	}
+
+	return []OnlineProbe{
+		{
+			FailureThreshold: pointer.From(input.FailureThreshold),
+			SuccessThreshold: pointer.From(input.SuccessThreshold),
+			InitialDelay:     pointer.From(input.InitialDelay),
+			Period:           pointer.From(input.Period),
+			Timeout:          pointer.From(input.Timeout),
+		},
+	}
+}
+
+func flattenOnlineRequestSettings(input *onlinedeployment.OnlineRequestSettings) []OnlineRequestSettings {
+	if input == nil {
+		return []OnlineRequestSettings{}
+	}
+
+	return []OnlineRequestSettings{
+		{
+			MaxConcurrentRequestsPerInstance: pointer.From(input.MaxConcurrentRequestsPerInstance),
+			RequestTimeout:                   pointer.From(input.RequestTimeout),
+		},
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineDeploymentResource struct{}
+
+// ARM_TEST_ML_MODEL_ID should contain the ID of an MLflow model, which can be deployed without specifying an environment or scoring script.
+func TestAccMachineLearningOnlineDeployment_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_ML_MODEL_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_ML_MODEL_ID is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_ML_MODEL_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_ML_MODEL_ID is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_ML_MODEL_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_ML_MODEL_ID is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_machine_learning_online_endpoint.test").Key("traffic.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningOnlineDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.OnlineDeployment.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningOnlineDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name               = "acctestdep%s"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  model_id           = "%s"
+  instance_type      = "Standard_DS3_v2"
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data), data.RandomString, os.Getenv("ARM_TEST_ML_MODEL_ID"))
+}
+
+func (r MachineLearningOnlineDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "import" {
+  name               = azurerm_machine_learning_online_deployment.test.name
+  online_endpoint_id = azurerm_machine_learning_online_deployment.test.online_endpoint_id
+  model_id           = azurerm_machine_learning_online_deployment.test.model_id
+  instance_type      = azurerm_machine_learning_online_deployment.test.instance_type
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) complete(data acceptance.TestData) string {
+	// the traffic split references the Deployment by name, since referencing the resource would introduce a cycle
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_machine_learning_online_endpoint" "test" {
+  name                = "acctestep%[2]s"
+  workspace_id        = azurerm_machine_learning_workspace.test.id
+  location            = azurerm_resource_group.test.location
+  authentication_mode = "Key"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  traffic = {
+    "acctestdep%[2]s" = 100
+  }
+}
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name                 = "acctestdep%[2]s"
+  online_endpoint_id   = azurerm_machine_learning_online_endpoint.test.id
+  model_id             = "%[3]s"
+  instance_type        = "Standard_DS3_v2"
+  instance_count       = 2
+  app_insights_enabled = true
+  description          = "Test Deployment"
+
+  target_utilization_scale {
+    min_instances                 = 2
+    max_instances                 = 3
+    polling_interval              = "PT10S"
+    target_utilization_percentage = 60
+  }
+
+  liveness_probe {
+    failure_threshold = 10
+    initial_delay     = "PT20S"
+    period            = "PT5S"
+    timeout           = "PT3S"
+  }
+
+  readiness_probe {
+    failure_threshold = 10
+    success_threshold = 1
+  }
+
+  request_settings {
+    max_concurrent_requests_per_instance = 2
+    request_timeout                      = "PT10S"
+  }
+
+  environment_variables = {
+    EXAMPLE = "value"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, MachineLearningDataStoreBlobStorage{}.template(data), data.RandomString, os.Getenv("ARM_TEST_ML_MODEL_ID"))
+}
@@ -0,0 +1,526 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-10-01/registrymanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningRegistry struct{}
+
+type MachineLearningRegistryModel struct {
+	Name                       string                                     `tfschema:"name"`
+	ResourceGroupName          string                                     `tfschema:"resource_group_name"`
+	Location                   string                                     `tfschema:"location"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	PrimaryRegion              []RegistryPrimaryRegion                    `tfschema:"primary_region"`
+	ReplicationRegion          []RegistryReplicationRegion                `tfschema:"replication_region"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	Tags                       map[string]string                          `tfschema:"tags"`
+	DiscoveryUrl               string                                     `tfschema:"discovery_url"`
+	MlFlowRegistryUri          string                                     `tfschema:"ml_flow_registry_uri"`
+	ManagedResourceGroupId     string                                     `tfschema:"managed_resource_group_id"`
+}
+
+type RegistryPrimaryRegion struct {
+	StorageAccountType        string `tfschema:"storage_account_type"`
+	HnsEnabled                bool   `tfschema:"hns_enabled"`
+	CustomStorageAccountId    string `tfschema:"custom_storage_account_id"`
+	CustomContainerRegistryId string `tfschema:"custom_container_registry_id"`
+	StorageAccountId          string `tfschema:"storage_account_id"`
+	ContainerRegistryId       string `tfschema:"container_registry_id"`
+}
+
+type RegistryReplicationRegion struct {
+	Location                  string `tfschema:"location"`
+	StorageAccountType        string `tfschema:"storage_account_type"`
+	HnsEnabled                bool   `tfschema:"hns_enabled"`
+	CustomStorageAccountId    string `tfschema:"custom_storage_account_id"`
+	CustomContainerRegistryId string `tfschema:"custom_container_registry_id"`
+	StorageAccountId          string `tfschema:"storage_account_id"`
+	ContainerRegistryId       string `tfschema:"container_registry_id"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningRegistry{}
+
+func (r MachineLearningRegistry) ResourceType() string {
+	return "azurerm_machine_learning_registry"
+}
+
+func (r MachineLearningRegistry) ModelObject() interface{} {
+	return &MachineLearningRegistryModel{}
+}
+
+func (r MachineLearningRegistry) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return registrymanagement.ValidateRegistryID
+}
+
+func (r MachineLearningRegistry) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"primary_region": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: registryRegionSchema(false),
+			},
+		},
+
+		"replication_region": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: registryRegionSchema(true),
+			},
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningRegistry) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"discovery_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ml_flow_registry_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"managed_resource_group_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registry
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MachineLearningRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := registrymanagement.NewRegistryID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.RegistriesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := registrymanagement.RegistryTrackedResource{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: registrymanagement.Registry{
+					PublicNetworkAccess: pointer.To(expandRegistryPublicNetworkAccess(model.PublicNetworkAccessEnabled)),
+					RegionDetails:       expandRegistryRegionDetails(model, nil),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.RegistriesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registry
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningRegistryModel{
+				Name:              id.RegistryName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.PublicNetworkAccessEnabled = !strings.EqualFold(pointer.From(props.PublicNetworkAccess), "Disabled")
+				state.DiscoveryUrl = pointer.From(props.DiscoveryURL)
+				state.MlFlowRegistryUri = pointer.From(props.MlFlowRegistryUri)
+				if v := props.ManagedResourceGroup; v != nil && v.ResourceId != nil {
+					resourceGroupId, err := commonids.ParseResourceGroupIDInsensitively(*v.ResourceId)
+					if err != nil {
+						return err
+					}
+					state.ManagedResourceGroupId = resourceGroupId.ID()
+				}
+
+				state.PrimaryRegion, state.ReplicationRegion = flattenRegistryRegionDetails(props.RegionDetails, state.Location)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registry
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = pointer.To(expandRegistryPublicNetworkAccess(model.PublicNetworkAccessEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if metadata.ResourceData.HasChange("replication_region") {
+				desired := expandRegistryRegionDetails(model, existing.Model.Properties.RegionDetails)
+
+				desiredLocations := make(map[string]struct{})
+				for _, v := range *desired {
+					desiredLocations[location.Normalize(pointer.From(v.Location))] = struct{}{}
+				}
+
+				remaining := make([]registrymanagement.RegistryRegionArmDetails, 0)
+				for _, v := range pointer.From(existing.Model.Properties.RegionDetails) {
+					if _, ok := desiredLocations[location.Normalize(pointer.From(v.Location))]; ok {
+						remaining = append(remaining, v)
+					}
+				}
+
+				// regions which are no longer configured can't be removed using a PUT and have to be removed through a separate operation
+				if len(remaining) < len(pointer.From(existing.Model.Properties.RegionDetails)) {
+					removal := payload
+					removal.Properties.RegionDetails = &remaining
+					if err := client.RegistriesRemoveRegionsThenPoll(ctx, *id, removal); err != nil {
+						return fmt.Errorf("removing regions from %s: %+v", *id, err)
+					}
+				}
+
+				payload.Properties.RegionDetails = desired
+			}
+
+			if err := client.RegistriesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registry
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RegistriesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func registryRegionSchema(includeLocation bool) map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"storage_account_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard_LRS",
+				"Standard_GRS",
+				"Standard_RAGRS",
+				"Standard_ZRS",
+				"Standard_GZRS",
+				"Standard_RAGZRS",
+				"Premium_LRS",
+				"Premium_ZRS",
+			}, false),
+		},
+
+		"hns_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"custom_storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"custom_container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"container_registry_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+
+	if includeLocation {
+		s["location"] = commonschema.LocationWithoutForceNew()
+	}
+
+	return s
+}
+
+func expandRegistryPublicNetworkAccess(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+// expandRegistryRegionDetails builds the region details for the primary and replication regions, re-using the details
+// of any region which already exists since the storage account and container registry of a region can't be changed
+func expandRegistryRegionDetails(input MachineLearningRegistryModel, existing *[]registrymanagement.RegistryRegionArmDetails) *[]registrymanagement.RegistryRegionArmDetails {
+	existingRegions := make(map[string]registrymanagement.RegistryRegionArmDetails)
+	if existing != nil {
+		for _, v := range *existing {
+			existingRegions[location.Normalize(pointer.From(v.Location))] = v
+		}
+	}
+
+	primaryLocation := location.Normalize(input.Location)
+	output := make([]registrymanagement.RegistryRegionArmDetails, 0)
+
+	if v, ok := existingRegions[primaryLocation]; ok {
+		output = append(output, v)
+	} else {
+		primary := RegistryPrimaryRegion{}
+		if len(input.PrimaryRegion) > 0 {
+			primary = input.PrimaryRegion[0]
+		}
+		output = append(output, expandRegistryRegion(primaryLocation, primary.StorageAccountType, primary.HnsEnabled, primary.CustomStorageAccountId, primary.CustomContainerRegistryId))
+	}
+
+	for _, region := range input.ReplicationRegion {
+		regionLocation := location.Normalize(region.Location)
+		if v, ok := existingRegions[regionLocation]; ok {
+			output = append(output, v)
+			continue
+		}
+		output = append(output, expandRegistryRegion(regionLocation, region.StorageAccountType, region.HnsEnabled, region.CustomStorageAccountId, region.CustomContainerRegistryId))
+	}
+
+	return &output
+}
+
+func expandRegistryRegion(regionLocation, storageAccountType string, hnsEnabled bool, customStorageAccountId, customContainerRegistryId string) registrymanagement.RegistryRegionArmDetails {
+	storageAccount := registrymanagement.StorageAccountDetails{}
+	if customStorageAccountId != "" {
+		storageAccount.UserCreatedStorageAccount = &registrymanagement.UserCreatedStorageAccount{
+			ArmResourceId: &registrymanagement.ArmResourceId{
+				ResourceId: pointer.To(customStorageAccountId),
+			},
+		}
+	} else {
+		if storageAccountType == "" {
+			storageAccountType = "Standard_LRS"
+		}
+		storageAccount.SystemCreatedStorageAccount = &registrymanagement.SystemCreatedStorageAccount{
+			AllowBlobPublicAccess:    pointer.To(false),
+			StorageAccountHnsEnabled: pointer.To(hnsEnabled),
+			StorageAccountType:       pointer.To(storageAccountType),
+		}
+	}
+
+	containerRegistry := registrymanagement.AcrDetails{}
+	if customContainerRegistryId != "" {
+		containerRegistry.UserCreatedAcrAccount = &registrymanagement.UserCreatedAcrAccount{
+			ArmResourceId: &registrymanagement.ArmResourceId{
+				ResourceId: pointer.To(customContainerRegistryId),
+			},
+		}
+	} else {
+		// registries require a Premium container registry so that the images can be geo-replicated
+		containerRegistry.SystemCreatedAcrAccount = &registrymanagement.SystemCreatedAcrAccount{
+			AcrAccountSku: pointer.To("Premium"),
+		}
+	}
+
+	return registrymanagement.RegistryRegionArmDetails{
+		Location:              pointer.To(regionLocation),
+		StorageAccountDetails: &[]registrymanagement.StorageAccountDetails{storageAccount},
+		AcrDetails:            &[]registrymanagement.AcrDetails{containerRegistry},
+	}
+}
+
+func flattenRegistryRegionDetails(input *[]registrymanagement.RegistryRegionArmDetails, primaryLocation string) ([]RegistryPrimaryRegion, []RegistryReplicationRegion) {
+	primary := make([]RegistryPrimaryRegion, 0)
+	replication := make([]RegistryReplicationRegion, 0)
+	if input == nil {
+		return primary, replication
+	}
+
+	for _, v := range *input {
+		region := RegistryReplicationRegion{
+			Location: location.Normalize(pointer.From(v.Location)),
+		}
+
+		if details := pointer.From(v.StorageAccountDetails); len(details) > 0 {
+			if sa := details[0].SystemCreatedStorageAccount; sa != nil {
+				region.StorageAccountType = pointer.From(sa.StorageAccountType)
+				region.HnsEnabled = pointer.From(sa.StorageAccountHnsEnabled)
+				if sa.ArmResourceId != nil {
+					region.StorageAccountId = pointer.From(sa.ArmResourceId.ResourceId)
+				}
+			}
+			if sa := details[0].UserCreatedStorageAccount; sa != nil && sa.ArmResourceId != nil {
+				region.CustomStorageAccountId = pointer.From(sa.ArmResourceId.ResourceId)
+				region.StorageAccountId = region.CustomStorageAccountId
+			}
+		}
+
+		if details := pointer.From(v.AcrDetails); len(details) > 0 {
+			if acr := details[0].SystemCreatedAcrAccount; acr != nil && acr.ArmResourceId != nil {
+				region.ContainerRegistryId = pointer.From(acr.ArmResourceId.ResourceId)
+			}
+			if acr := details[0].UserCreatedAcrAccount; acr != nil && acr.ArmResourceId != nil {
+				region.CustomContainerRegistryId = pointer.From(acr.ArmResourceId.ResourceId)
+				region.ContainerRegistryId = region.CustomContainerRegistryId
+			}
+		}
+
+		if region.Location == primaryLocation {
+			primary = append(primary, RegistryPrimaryRegion{
+				StorageAccountType:        region.StorageAccountType,
+				HnsEnabled:                region.HnsEnabled,
+				CustomStorageAccountId:    region.CustomStorageAccountId,
+				CustomContainerRegistryId: region.CustomContainerRegistryId,
+				StorageAccountId:          region.StorageAccountId,
+				ContainerRegistryId:       region.ContainerRegistryId,
+			})
+			continue
+		}
+
+		replication = append(replication, region)
+	}
+
+	return primary, replication
+}
@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningWorkspaceConnection struct{}
+
+type MachineLearningWorkspaceConnectionModel struct {
+	Name               string                                `tfschema:"name"`
+	WorkspaceId        string                                `tfschema:"workspace_id"`
+	Category           string                                `tfschema:"category"`
+	Target             string                                `tfschema:"target"`
+	AuthenticationType string                                `tfschema:"authentication_type"`
+	ApiKey             string                                `tfschema:"api_key"`
+	ManagedIdentity    []WorkspaceConnectionManagedIdentity  `tfschema:"managed_identity"`
+	ServicePrincipal   []WorkspaceConnectionServicePrincipal `tfschema:"service_principal"`
+	SharedToAll        bool                                  `tfschema:"shared_to_all"`
+	Metadata           map[string]string                     `tfschema:"metadata"`
+}
+
+type WorkspaceConnectionManagedIdentity struct {
+	ClientId   string `tfschema:"client_id"`
+	ResourceId string `tfschema:"resource_id"`
+}
+
+type WorkspaceConnectionServicePrincipal struct {
+	ClientId     string `tfschema:"client_id"`
+	ClientSecret string `tfschema:"client_secret"`
+	TenantId     string `tfschema:"tenant_id"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = MachineLearningWorkspaceConnection{}
+	_ sdk.ResourceWithCustomizeDiff = MachineLearningWorkspaceConnection{}
+)
+
+func (r MachineLearningWorkspaceConnection) ResourceType() string {
+	return "azurerm_machine_learning_workspace_connection"
+}
+
+func (r MachineLearningWorkspaceConnection) ModelObject() interface{} {
+	return &MachineLearningWorkspaceConnectionModel{}
+}
+
+func (r MachineLearningWorkspaceConnection) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return v2workspaceconnectionresource.ValidateConnectionID
+}
+
+func (r MachineLearningWorkspaceConnection) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: v2workspaceconnectionresource.ValidateWorkspaceID,
+		},
+
+		"category": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(v2workspaceconnectionresource.PossibleValuesForConnectionCategory(), false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAAD),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeApiKey),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeManagedIdentity),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeServicePrincipal),
+			}, false),
+		},
+
+		"api_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"managed_identity", "service_principal"},
+		},
+
+		"managed_identity": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"api_key", "service_principal"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+
+		"service_principal": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"api_key", "managed_identity"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"client_secret": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"tenant_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+			},
+		},
+
+		"shared_to_all": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MachineLearningWorkspaceConnection) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningWorkspaceConnection) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			authType := v2workspaceconnectionresource.ConnectionAuthType(metadata.ResourceDiff.Get("authentication_type").(string))
+
+			apiKeySet := metadata.ResourceDiff.Get("api_key").(string) != "" || !metadata.ResourceDiff.NewValueKnown("api_key")
+			managedIdentitySet := len(metadata.ResourceDiff.Get("managed_identity").([]interface{})) > 0
+			servicePrincipalSet := len(metadata.ResourceDiff.Get("service_principal").([]interface{})) > 0
+
+			switch authType {
+			case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+				if !apiKeySet {
+					return fmt.Errorf("`api_key` must be specified when `authentication_type` is `%s`", authType)
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeManagedIdentity:
+				if !managedIdentitySet {
+					return fmt.Errorf("a `managed_identity` block must be specified when `authentication_type` is `%s`", authType)
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeServicePrincipal:
+				if !servicePrincipalSet {
+					return fmt.Errorf("a `service_principal` block must be specified when `authentication_type` is `%s`", authType)
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeAAD:
+				if apiKeySet || managedIdentitySet || servicePrincipalSet {
+					return fmt.Errorf("`api_key`, `managed_identity` and `service_principal` cannot be specified when `authentication_type` is `%s`", authType)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningWorkspaceConnection) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnection
+
+			var model MachineLearningWorkspaceConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := v2workspaceconnectionresource.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := v2workspaceconnectionresource.NewConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.WorkspaceConnectionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandWorkspaceConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningWorkspaceConnection) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnection
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceConnectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningWorkspaceConnectionModel{
+				Name:        id.ConnectionName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties.WorkspaceConnectionPropertiesV2()
+				state.AuthenticationType = string(props.AuthType)
+				state.Category = string(pointer.From(props.Category))
+				state.Target = pointer.From(props.Target)
+				state.SharedToAll = pointer.From(props.IsSharedToAll)
+				state.Metadata = pointer.From(props.Metadata)
+
+				// secrets aren't returned by the API so these are retained from the config
+				switch v := model.Properties.(type) {
+				case v2workspaceconnectionresource.ApiKeyAuthWorkspaceConnectionProperties:
+					state.ApiKey = metadata.ResourceData.Get("api_key").(string)
+
+				case v2workspaceconnectionresource.ManagedIdentityAuthTypeWorkspaceConnectionProperties:
+					if creds := v.Credentials; creds != nil {
+						state.ManagedIdentity = []WorkspaceConnectionManagedIdentity{
+							{
+								ClientId:   pointer.From(creds.ClientId),
+								ResourceId: pointer.From(creds.ResourceId),
+							},
+						}
+					}
+
+				case v2workspaceconnectionresource.ServicePrincipalAuthTypeWorkspaceConnectionProperties:
+					if creds := v.Credentials; creds != nil {
+						state.ServicePrincipal = []WorkspaceConnectionServicePrincipal{
+							{
+								ClientId:     pointer.From(creds.ClientId),
+								ClientSecret: metadata.ResourceData.Get("service_principal.0.client_secret").(string),
+								TenantId:     pointer.From(creds.TenantId),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningWorkspaceConnection) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnection
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningWorkspaceConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API doesn't return the credentials, so the full payload is rebuilt from the config
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandWorkspaceConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningWorkspaceConnection) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnection
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkspaceConnectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWorkspaceConnectionProperties(input MachineLearningWorkspaceConnectionModel) v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2 {
+	authType := v2workspaceconnectionresource.ConnectionAuthType(input.AuthenticationType)
+	category := pointer.To(v2workspaceconnectionresource.ConnectionCategory(input.Category))
+	target := pointer.To(input.Target)
+	sharedToAll := pointer.To(input.SharedToAll)
+	metadata := pointer.To(input.Metadata)
+
+	switch authType {
+	case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+		return v2workspaceconnectionresource.ApiKeyAuthWorkspaceConnectionProperties{
+			AuthType:      authType,
+			Category:      category,
+			Target:        target,
+			IsSharedToAll: sharedToAll,
+			Metadata:      metadata,
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionApiKey{
+				Key: pointer.To(input.ApiKey),
+			},
+		}
+
+	case v2workspaceconnectionresource.ConnectionAuthTypeManagedIdentity:
+		output := v2workspaceconnectionresource.ManagedIdentityAuthTypeWorkspaceConnectionProperties{
+			AuthType:      authType,
+			Category:      category,
+			Target:        target,
+			IsSharedToAll: sharedToAll,
+			Metadata:      metadata,
+		}
+		if len(input.ManagedIdentity) > 0 {
+			output.Credentials = &v2workspaceconnectionresource.WorkspaceConnectionManagedIdentity{
+				ClientId:   pointer.To(input.ManagedIdentity[0].ClientId),
+				ResourceId: pointer.To(input.ManagedIdentity[0].ResourceId),
+			}
+		}
+		return output
+
+	case v2workspaceconnectionresource.ConnectionAuthTypeServicePrincipal:
+		output := v2workspaceconnectionresource.ServicePrincipalAuthTypeWorkspaceConnectionProperties{
+			AuthType:      authType,
+			Category:      category,
+			Target:        target,
+			IsSharedToAll: sharedToAll,
+			Metadata:      metadata,
+		}
+		if len(input.ServicePrincipal) > 0 {
+			output.Credentials = &v2workspaceconnectionresource.WorkspaceConnectionServicePrincipal{
+				ClientId:     pointer.To(input.ServicePrincipal[0].ClientId),
+				ClientSecret: pointer.To(input.ServicePrincipal[0].ClientSecret),
+				TenantId:     pointer.To(input.ServicePrincipal[0].TenantId),
+			}
+		}
+		return output
+	}
+
+	return v2workspaceconnectionresource.AADAuthTypeWorkspaceConnectionProperties{
+		AuthType:      authType,
+		Category:      category,
+		Target:        target,
+		IsSharedToAll: sharedToAll,
+		Metadata:      metadata,
+	}
+}
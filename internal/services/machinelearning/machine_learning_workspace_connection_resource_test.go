@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningWorkspaceConnectionResource struct{}
+
+func TestAccMachineLearningWorkspaceConnection_apiKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_connection", "test")
+	r := MachineLearningWorkspaceConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key"),
+	})
+}
+
+func TestAccMachineLearningWorkspaceConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_connection", "test")
+	r := MachineLearningWorkspaceConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningWorkspaceConnection_aad(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_connection", "test")
+	r := MachineLearningWorkspaceConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningWorkspaceConnection_managedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_connection", "test")
+	r := MachineLearningWorkspaceConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningWorkspaceConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_connection", "test")
+	r := MachineLearningWorkspaceConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key"),
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key"),
+	})
+}
+
+func (r MachineLearningWorkspaceConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := v2workspaceconnectionresource.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.WorkspaceConnection.WorkspaceConnectionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningWorkspaceConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_ai_services" "test" {
+  name                  = "acctestais%[2]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  sku_name              = "S0"
+  custom_subdomain_name = "acctestais%[2]d"
+}
+`, MachineLearningDataStoreBlobStorage{}.template(data), data.RandomInteger)
+}
+
+func (r MachineLearningWorkspaceConnectionResource) apiKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_connection" "test" {
+  name                = "acctestconn%s"
+  workspace_id        = azurerm_machine_learning_workspace.test.id
+  category            = "AIServices"
+  target              = azurerm_ai_services.test.endpoint
+  authentication_type = "ApiKey"
+  api_key             = azurerm_ai_services.test.primary_access_key
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MachineLearningWorkspaceConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_connection" "import" {
+  name                = azurerm_machine_learning_workspace_connection.test.name
+  workspace_id        = azurerm_machine_learning_workspace_connection.test.workspace_id
+  category            = azurerm_machine_learning_workspace_connection.test.category
+  target              = azurerm_machine_learning_workspace_connection.test.target
+  authentication_type = azurerm_machine_learning_workspace_connection.test.authentication_type
+  api_key             = azurerm_machine_learning_workspace_connection.test.api_key
+}
+`, r.apiKey(data))
+}
+
+func (r MachineLearningWorkspaceConnectionResource) aad(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_connection" "test" {
+  name                = "acctestconn%s"
+  workspace_id        = azurerm_machine_learning_workspace.test.id
+  category            = "AIServices"
+  target              = azurerm_ai_services.test.endpoint
+  authentication_type = "AAD"
+  shared_to_all       = false
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MachineLearningWorkspaceConnectionResource) managedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_machine_learning_workspace_connection" "test" {
+  name                = "acctestconn%[3]s"
+  workspace_id        = azurerm_machine_learning_workspace.test.id
+  category            = "AIServices"
+  target              = azurerm_ai_services.test.endpoint
+  authentication_type = "ManagedIdentity"
+
+  managed_identity {
+    client_id   = azurerm_user_assigned_identity.test.client_id
+    resource_id = azurerm_user_assigned_identity.test.id
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
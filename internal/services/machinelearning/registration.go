@@ -59,6 +59,10 @@ func (r Registration) Resources() []sdk.Resource {
 		MachineLearningDataStoreBlobStorage{},
 		MachineLearningDataStoreDataLakeGen2{},
 		MachineLearningDataStoreFileShare{},
+		MachineLearningOnlineDeployment{},
+		MachineLearningOnlineEndpoint{},
+		MachineLearningRegistry{},
+		MachineLearningWorkspaceConnection{},
 		WorkspaceNetworkOutboundRuleFqdn{},
 	}
 }
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+// OnlineDeploymentName validates the name of a Deployment within an Online Endpoint, which follows the same rules as the Endpoint name.
+func OnlineDeploymentName(i interface{}, k string) (warnings []string, errors []error) {
+	return OnlineEndpointName(i, k)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+// NOTE: the 7.4 Data Plane SDK doesn't expose the `useManagedIdentity` field (added in API Version 7.5) and
+// requires that a SAS Token is specified - as such these requests are sent using API Version 7.5 so that
+// the Backup/Restore can be authorised using either a SAS Token or the Managed Identity of the Managed HSM.
+//
+// The Status of the resulting operations remains available through the 7.4 SDK.
+
+const backupRestoreAPIVersion = "7.5"
+
+type BackupRestoreClient struct {
+	dataplane.BaseClient
+}
+
+type SASTokenParameter struct {
+	// StorageResourceURI - Azure Blob storage container Uri
+	StorageResourceURI *string `json:"storageResourceUri,omitempty"`
+	// Token - The SAS token pointing to an Azure Blob storage container
+	Token *string `json:"token,omitempty"`
+	// UseManagedIdentity - Indicates which authentication method should be used, when true the Managed HSM
+	// will use its configured User Assigned Managed Identity to access the storage account
+	UseManagedIdentity *bool `json:"useManagedIdentity,omitempty"`
+}
+
+type RestoreOperationParameters struct {
+	SasTokenParameters *SASTokenParameter `json:"sasTokenParameters,omitempty"`
+	// FolderToRestore - The Folder name of the blob where the previous successful full backup was stored
+	FolderToRestore *string `json:"folderToRestore,omitempty"`
+}
+
+type SelectiveKeyRestoreOperationParameters struct {
+	SasTokenParameters *SASTokenParameter `json:"sasTokenParameters,omitempty"`
+	// Folder - The Folder name of the blob where the previous successful full backup was stored
+	Folder *string `json:"folder,omitempty"`
+}
+
+// FullBackup starts a Full Backup of the Managed HSM, the returned operation contains the Job ID used to track it.
+func (client BackupRestoreClient) FullBackup(ctx context.Context, vaultBaseURL string, parameters SASTokenParameter) (result dataplane.FullBackupOperation, err error) {
+	req, err := client.prepare(ctx, vaultBaseURL, "/backup", nil, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullBackup", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullBackup", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.FullBackupResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullBackup", resp, "Failure responding to request")
+	}
+	return
+}
+
+// FullRestore starts a Full Restore of the Managed HSM from a previous Full Backup.
+func (client BackupRestoreClient) FullRestore(ctx context.Context, vaultBaseURL string, parameters RestoreOperationParameters) (result dataplane.RestoreOperation, err error) {
+	req, err := client.prepare(ctx, vaultBaseURL, "/restore", nil, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullRestore", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullRestore", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.FullRestoreOperationResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "FullRestore", resp, "Failure responding to request")
+	}
+	return
+}
+
+// SelectiveKeyRestore starts a Restore of a single Key from a previous Full Backup of the Managed HSM.
+func (client BackupRestoreClient) SelectiveKeyRestore(ctx context.Context, vaultBaseURL string, keyName string, parameters SelectiveKeyRestoreOperationParameters) (result dataplane.SelectiveKeyRestoreOperation, err error) {
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+	req, err := client.prepare(ctx, vaultBaseURL, "/keys/{key-name}/restore", pathParameters, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "SelectiveKeyRestore", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "SelectiveKeyRestore", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.SelectiveKeyRestoreOperationMethodResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BackupRestoreClient", "SelectiveKeyRestore", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client BackupRestoreClient) prepare(ctx context.Context, vaultBaseURL string, path string, pathParameters map[string]interface{}, body interface{}) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": backupRestoreAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(body))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client BackupRestoreClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
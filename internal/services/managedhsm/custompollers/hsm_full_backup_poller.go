@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+var _ pollers.PollerType = &hsmFullBackupPoller{}
+
+func NewHSMFullBackupPoller(client *dataplane.BaseClient, baseUrl string, jobId string) pollers.PollerType {
+	return &hsmFullBackupPoller{
+		client:  client,
+		baseUrl: baseUrl,
+		jobId:   jobId,
+	}
+}
+
+type hsmFullBackupPoller struct {
+	client  *dataplane.BaseClient
+	baseUrl string
+	jobId   string
+}
+
+func (p *hsmFullBackupPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	res, err := p.client.FullBackupStatus(ctx, p.baseUrl, p.jobId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the status of Full Backup %q within %s: %+v", p.jobId, p.baseUrl, err)
+	}
+
+	return pollResultForOperation(pointer.From(res.Status), pointer.From(res.StatusDetails), res.Error, fmt.Sprintf("Full Backup %q within %s", p.jobId, p.baseUrl))
+}
+
+// pollResultForOperation maps the status of a Managed HSM Backup/Restore operation to the PollResult
+func pollResultForOperation(status, statusDetails string, operationError *dataplane.Error, description string) (*pollers.PollResult, error) {
+	switch {
+	case strings.EqualFold(status, "Succeeded"), strings.EqualFold(status, string(dataplane.OperationStatusSuccess)):
+		return &pollers.PollResult{
+			Status:       pollers.PollingStatusSucceeded,
+			PollInterval: 10 * time.Second,
+		}, nil
+
+	case strings.EqualFold(status, string(dataplane.OperationStatusFailed)), strings.EqualFold(status, "Canceled"):
+		message := statusDetails
+		if operationError != nil && operationError.Message != nil {
+			message = fmt.Sprintf("%s: %s", pointer.From(operationError.Code), *operationError.Message)
+		}
+		return nil, pollers.PollingFailedError{
+			Message: fmt.Sprintf("%s finished with the status %q: %s", description, status, message),
+		}
+	}
+
+	// InProgress
+	return &pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 10 * time.Second,
+	}, nil
+}
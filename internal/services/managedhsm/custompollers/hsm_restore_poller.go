@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+var _ pollers.PollerType = &hsmRestorePoller{}
+
+// NewHSMRestorePoller polls both Full Restore and Selective Key Restore operations, since both are
+// tracked using the same restore status endpoint.
+func NewHSMRestorePoller(client *dataplane.BaseClient, baseUrl string, jobId string) pollers.PollerType {
+	return &hsmRestorePoller{
+		client:  client,
+		baseUrl: baseUrl,
+		jobId:   jobId,
+	}
+}
+
+type hsmRestorePoller struct {
+	client  *dataplane.BaseClient
+	baseUrl string
+	jobId   string
+}
+
+func (p *hsmRestorePoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	res, err := p.client.RestoreStatus(ctx, p.baseUrl, p.jobId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the status of Restore %q within %s: %+v", p.jobId, p.baseUrl, err)
+	}
+
+	return pollResultForOperation(pointer.From(res.Status), pointer.From(res.StatusDetails), res.Error, fmt.Sprintf("Restore %q within %s", p.jobId, p.baseUrl))
+}
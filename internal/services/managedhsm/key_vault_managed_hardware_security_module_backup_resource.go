@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultMHSMBackupResource struct{}
+
+var _ sdk.Resource = KeyVaultMHSMBackupResource{}
+
+type KeyVaultMHSMBackupResourceModel struct {
+	ManagedHSMId        string            `tfschema:"managed_hsm_id"`
+	StorageContainerUrl string            `tfschema:"storage_container_url"`
+	SasToken            string            `tfschema:"sas_token"`
+	UseManagedIdentity  bool              `tfschema:"use_managed_identity"`
+	Triggers            map[string]string `tfschema:"triggers"`
+	JobId               string            `tfschema:"job_id"`
+	BackupFolderUrl     string            `tfschema:"backup_folder_url"`
+}
+
+func (r KeyVaultMHSMBackupResource) ModelObject() interface{} {
+	return &KeyVaultMHSMBackupResourceModel{}
+}
+
+func (r KeyVaultMHSMBackupResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_backup"
+}
+
+func (r KeyVaultMHSMBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMDataPlaneBackupJobID
+}
+
+func (r KeyVaultMHSMBackupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		"storage_container_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"use_managed_identity"},
+		},
+
+		"use_managed_identity": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			ForceNew:      true,
+			Default:       false,
+			ConflictsWith: []string{"sas_token"},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeyVaultMHSMBackupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"job_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backup_folder_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultMHSMBackupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.DataPlaneKeysClient
+			domainSuffix, ok := metadata.Client.Account.Environment.ManagedHSM.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Managed HSM domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			var config KeyVaultMHSMBackupResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHsmId, err := managedhsms.ParseManagedHSMID(config.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+			baseUri, err := metadata.Client.ManagedHSMs.BaseUriForManagedHSM(ctx, *managedHsmId)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", *managedHsmId, err)
+			}
+			if baseUri == nil {
+				return fmt.Errorf("unable to determine the Data Plane Endpoint for %q", *managedHsmId)
+			}
+			endpoint, err := parse.ManagedHSMEndpoint(*baseUri, domainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing the Data Plane Endpoint %q: %+v", *baseUri, err)
+			}
+
+			storage, err := expandManagedHSMBackupStorage(config.StorageContainerUrl, config.SasToken, config.UseManagedIdentity)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+
+			backupClient := azuresdkhacks.BackupRestoreClient{BaseClient: *client}
+			resp, err := backupClient.FullBackup(ctx, endpoint.BaseURI(), *storage)
+			if err != nil {
+				return fmt.Errorf("starting a Full Backup of %s: %+v", *managedHsmId, err)
+			}
+			if resp.JobID == nil {
+				return fmt.Errorf("starting a Full Backup of %s: `jobId` was nil", *managedHsmId)
+			}
+
+			id := parse.NewManagedHSMDataPlaneBackupJobID(endpoint.ManagedHSMName, endpoint.DomainSuffix, *resp.JobID)
+
+			pollerType := custompollers.NewHSMFullBackupPoller(client, id.BaseUri(), id.JobId)
+			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r KeyVaultMHSMBackupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.DataPlaneKeysClient
+			domainSuffix, ok := metadata.Client.Account.Environment.ManagedHSM.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Managed HSM domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			id, err := parse.ManagedHSMDataPlaneBackupJobID(metadata.ResourceData.Id(), domainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var state KeyVaultMHSMBackupResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			resourceManagerId, err := metadata.Client.ManagedHSMs.ManagedHSMIDFromBaseUrl(ctx, subscriptionId, id.BaseUri(), domainSuffix)
+			if err != nil {
+				return fmt.Errorf("determining Resource Manager ID for %q: %+v", id, err)
+			}
+			if resourceManagerId == nil {
+				return metadata.MarkAsGone(*id)
+			}
+
+			state.ManagedHSMId = resourceManagerId.ID()
+			state.JobId = id.JobId
+
+			resp, err := client.FullBackupStatus(ctx, id.BaseUri(), id.JobId)
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				// the status of a Backup is only retained for a limited time, however the Backup itself remains
+				// available in the Storage Container, so the existing state is retained rather than running another Backup
+				log.Printf("[DEBUG] The status of %s is no longer available - retaining the existing state", *id)
+				return metadata.Encode(&state)
+			}
+
+			if v := resp.AzureStorageBlobContainerURI; v != nil {
+				state.BackupFolderUrl = *v
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMBackupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMDataPlaneBackupJobID(metadata.ResourceData.Id(), nil)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] %s can't be deleted - removing from state, the Backup remains available in the Storage Container", *id)
+			return nil
+		},
+	}
+}
+
+func expandManagedHSMBackupStorage(storageContainerUrl, sasToken string, useManagedIdentity bool) (*azuresdkhacks.SASTokenParameter, error) {
+	if sasToken == "" && !useManagedIdentity {
+		return nil, fmt.Errorf("one of `sas_token` or `use_managed_identity` must be specified")
+	}
+
+	output := azuresdkhacks.SASTokenParameter{
+		StorageResourceURI: pointer.To(storageContainerUrl),
+	}
+	if useManagedIdentity {
+		output.UseManagedIdentity = pointer.To(true)
+	} else {
+		output.Token = pointer.To(sasToken)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultMHSMBackupResource struct{}
+
+type KeyVaultMHSMRestoreResource struct{}
+
+func testAccKeyVaultMHSMBackup_sasToken(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_backup", "test")
+	r := KeyVaultMHSMBackupResource{}
+
+	// the Backup can't be deleted, it's retained in the Storage Container
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.sasToken(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_id").Exists(),
+				check.That(data.ResourceName).Key("backup_folder_url").Exists(),
+			),
+		},
+	})
+}
+
+func testAccKeyVaultMHSMRestore_full(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_restore", "test")
+	r := KeyVaultMHSMRestoreResource{}
+
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.full(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_id").Exists(),
+			),
+		},
+	})
+}
+
+func testAccKeyVaultMHSMRestore_key(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_restore", "test")
+	r := KeyVaultMHSMRestoreResource{}
+
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.key(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_id").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultMHSMBackupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	domainSuffix, ok := clients.Account.Environment.ManagedHSM.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("this Environment doesn't specify the Domain Suffix for Managed HSM")
+	}
+	id, err := parse.ManagedHSMDataPlaneBackupJobID(state.ID, domainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ManagedHSMs.DataPlaneKeysClient.FullBackupStatus(ctx, id.BaseUri(), id.JobId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Status != nil), nil
+}
+
+func (KeyVaultMHSMRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	domainSuffix, ok := clients.Account.Environment.ManagedHSM.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("this Environment doesn't specify the Domain Suffix for Managed HSM")
+	}
+	id, err := parse.ManagedHSMDataPlaneRestoreJobID(state.ID, domainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ManagedHSMs.DataPlaneKeysClient.RestoreStatus(ctx, id.BaseUri(), id.JobId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Status != nil), nil
+}
+
+func (r KeyVaultMHSMBackupResource) sasToken(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_backup" "test" {
+  managed_hsm_id        = azurerm_key_vault_managed_hardware_security_module.test.id
+  storage_container_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token             = trimprefix(data.azurerm_storage_account_blob_container_sas.test.sas, "?")
+}
+`, r.template(data))
+}
+
+func (KeyVaultMHSMBackupResource) template(data acceptance.TestData) string {
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format("2006-01-02")
+	endDate := utcNow.AddDate(0, 0, 2).Format("2006-01-02")
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "backups"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "%[3]s"
+  expiry = "%[4]s"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data, 3), data.RandomString, startDate, endDate)
+}
+
+func (r KeyVaultMHSMRestoreResource) full(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_restore" "test" {
+  managed_hsm_id    = azurerm_key_vault_managed_hardware_security_module.test.id
+  backup_folder_url = azurerm_key_vault_managed_hardware_security_module_backup.test.backup_folder_url
+  sas_token         = trimprefix(data.azurerm_storage_account_blob_container_sas.test.sas, "?")
+}
+`, KeyVaultMHSMBackupResource{}.sasToken(data))
+}
+
+func (r KeyVaultMHSMRestoreResource) key(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  name               = "1e243909-064c-6ac3-84e9-1c8bf8d6ad52"
+  scope              = "/keys"
+  role_definition_id = "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMK-%[2]s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type       = "EC-HSM"
+  curve          = "P-521"
+  key_opts       = ["sign"]
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.test]
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_backup" "test" {
+  managed_hsm_id        = azurerm_key_vault_managed_hardware_security_module.test.id
+  storage_container_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token             = trimprefix(data.azurerm_storage_account_blob_container_sas.test.sas, "?")
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_key.test]
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_restore" "test" {
+  managed_hsm_id    = azurerm_key_vault_managed_hardware_security_module.test.id
+  backup_folder_url = azurerm_key_vault_managed_hardware_security_module_backup.test.backup_folder_url
+  sas_token         = trimprefix(data.azurerm_storage_account_blob_container_sas.test.sas, "?")
+  key_name          = azurerm_key_vault_managed_hardware_security_module_key.test.name
+}
+`, KeyVaultMHSMBackupResource{}.template(data), data.RandomString)
+}
@@ -32,6 +32,15 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
 			"download": testAccKeyVaultManagedHardwareSecurityModule_download,
 		},
+		"securityDomain": {
+			"download":              testAccKeyVaultMHSMSecurityDomain_download,
+			"transferKeyDataSource": testAccKeyVaultMHSMSecurityDomainTransferKeyDataSource_basic,
+		},
+		"backupRestore": {
+			"backup":      testAccKeyVaultMHSMBackup_sasToken,
+			"restoreFull": testAccKeyVaultMHSMRestore_full,
+			"restoreKey":  testAccKeyVaultMHSMRestore_key,
+		},
 		"roleAssignments": {
 			"builtInRole": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_builtInRole,
 			"customRole":  testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_customRole,
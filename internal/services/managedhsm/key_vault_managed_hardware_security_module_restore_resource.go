@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultMHSMRestoreResource struct{}
+
+var _ sdk.Resource = KeyVaultMHSMRestoreResource{}
+
+type KeyVaultMHSMRestoreResourceModel struct {
+	ManagedHSMId       string            `tfschema:"managed_hsm_id"`
+	BackupFolderUrl    string            `tfschema:"backup_folder_url"`
+	SasToken           string            `tfschema:"sas_token"`
+	UseManagedIdentity bool              `tfschema:"use_managed_identity"`
+	KeyName            string            `tfschema:"key_name"`
+	Triggers           map[string]string `tfschema:"triggers"`
+	JobId              string            `tfschema:"job_id"`
+	Status             string            `tfschema:"status"`
+}
+
+func (r KeyVaultMHSMRestoreResource) ModelObject() interface{} {
+	return &KeyVaultMHSMRestoreResourceModel{}
+}
+
+func (r KeyVaultMHSMRestoreResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_restore"
+}
+
+func (r KeyVaultMHSMRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMDataPlaneRestoreJobID
+}
+
+func (r KeyVaultMHSMRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		"backup_folder_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"use_managed_identity"},
+		},
+
+		"use_managed_identity": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			ForceNew:      true,
+			Default:       false,
+			ConflictsWith: []string{"sas_token"},
+		},
+
+		"key_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeyVaultMHSMRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"job_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultMHSMRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.DataPlaneKeysClient
+			domainSuffix, ok := metadata.Client.Account.Environment.ManagedHSM.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Managed HSM domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			var config KeyVaultMHSMRestoreResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHsmId, err := managedhsms.ParseManagedHSMID(config.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+			baseUri, err := metadata.Client.ManagedHSMs.BaseUriForManagedHSM(ctx, *managedHsmId)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", *managedHsmId, err)
+			}
+			if baseUri == nil {
+				return fmt.Errorf("unable to determine the Data Plane Endpoint for %q", *managedHsmId)
+			}
+			endpoint, err := parse.ManagedHSMEndpoint(*baseUri, domainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing the Data Plane Endpoint %q: %+v", *baseUri, err)
+			}
+
+			storageContainerUrl, folderName, err := parseManagedHSMBackupFolderUrl(config.BackupFolderUrl)
+			if err != nil {
+				return fmt.Errorf("parsing `backup_folder_url`: %+v", err)
+			}
+
+			storage, err := expandManagedHSMBackupStorage(storageContainerUrl, config.SasToken, config.UseManagedIdentity)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+			defer locks.UnlockByName(managedHsmId.ID(), "azurerm_key_vault_managed_hardware_security_module")
+
+			restoreClient := azuresdkhacks.BackupRestoreClient{BaseClient: *client}
+
+			var jobId *string
+			if config.KeyName != "" {
+				parameters := azuresdkhacks.SelectiveKeyRestoreOperationParameters{
+					SasTokenParameters: storage,
+					Folder:             pointer.To(folderName),
+				}
+				resp, err := restoreClient.SelectiveKeyRestore(ctx, endpoint.BaseURI(), config.KeyName, parameters)
+				if err != nil {
+					return fmt.Errorf("starting a Restore of the Key %q for %s: %+v", config.KeyName, *managedHsmId, err)
+				}
+				jobId = resp.JobID
+			} else {
+				parameters := azuresdkhacks.RestoreOperationParameters{
+					SasTokenParameters: storage,
+					FolderToRestore:    pointer.To(folderName),
+				}
+				resp, err := restoreClient.FullRestore(ctx, endpoint.BaseURI(), parameters)
+				if err != nil {
+					return fmt.Errorf("starting a Full Restore of %s: %+v", *managedHsmId, err)
+				}
+				jobId = resp.JobID
+			}
+			if jobId == nil {
+				return fmt.Errorf("starting a Restore of %s: `jobId` was nil", *managedHsmId)
+			}
+
+			id := parse.NewManagedHSMDataPlaneRestoreJobID(endpoint.ManagedHSMName, endpoint.DomainSuffix, *jobId)
+
+			pollerType := custompollers.NewHSMRestorePoller(client, id.BaseUri(), id.JobId)
+			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r KeyVaultMHSMRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.DataPlaneKeysClient
+			domainSuffix, ok := metadata.Client.Account.Environment.ManagedHSM.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Managed HSM domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			id, err := parse.ManagedHSMDataPlaneRestoreJobID(metadata.ResourceData.Id(), domainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var state KeyVaultMHSMRestoreResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			resourceManagerId, err := metadata.Client.ManagedHSMs.ManagedHSMIDFromBaseUrl(ctx, subscriptionId, id.BaseUri(), domainSuffix)
+			if err != nil {
+				return fmt.Errorf("determining Resource Manager ID for %q: %+v", id, err)
+			}
+			if resourceManagerId == nil {
+				return metadata.MarkAsGone(*id)
+			}
+
+			state.ManagedHSMId = resourceManagerId.ID()
+			state.JobId = id.JobId
+
+			// the details of the restore request aren't returned by the status, so these are retained from the state
+			resp, err := client.RestoreStatus(ctx, id.BaseUri(), id.JobId)
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				// the status of a Restore is only retained for a limited time, so the existing state is retained
+				// rather than running the Restore again
+				log.Printf("[DEBUG] The status of %s is no longer available - retaining the existing state", *id)
+				return metadata.Encode(&state)
+			}
+
+			state.Status = pointer.From(resp.Status)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMDataPlaneRestoreJobID(metadata.ResourceData.Id(), nil)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+// parseManagedHSMBackupFolderUrl splits the URL of a Backup Folder (as returned from a Full Backup) into the
+// URL of the Storage Container and the name of the Folder within it.
+func parseManagedHSMBackupFolderUrl(input string) (storageContainerUrl string, folderName string, err error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	// the path should be in the format `/{containerName}/{folderName}`
+	containerName, folderName, ok := strings.Cut(strings.Trim(uri.Path, "/"), "/")
+	if !ok || containerName == "" || folderName == "" {
+		return "", "", fmt.Errorf("expected %q to be in the format `https://{account}.blob.{domain}/{containerName}/{folderName}`", input)
+	}
+
+	return fmt.Sprintf("%s://%s/%s", uri.Scheme, uri.Host, containerName), folderName, nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidation "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultMHSMSecurityDomainResource struct{}
+
+var _ sdk.Resource = KeyVaultMHSMSecurityDomainResource{}
+
+type KeyVaultMHSMSecurityDomainResourceModel struct {
+	ManagedHSMId                string                               `tfschema:"managed_hsm_id"`
+	Download                    []KeyVaultMHSMSecurityDomainDownload `tfschema:"download"`
+	Upload                      []KeyVaultMHSMSecurityDomainUpload   `tfschema:"upload"`
+	Triggers                    map[string]string                    `tfschema:"triggers"`
+	SecurityDomainEncryptedData string                               `tfschema:"security_domain_encrypted_data"`
+	ActivationStatus            string                               `tfschema:"activation_status"`
+	ActivationStatusMessage     string                               `tfschema:"activation_status_message"`
+}
+
+type KeyVaultMHSMSecurityDomainDownload struct {
+	CertificateIds []string `tfschema:"certificate_ids"`
+	Quorum         int64    `tfschema:"quorum"`
+}
+
+type KeyVaultMHSMSecurityDomainUpload struct {
+	RestoreBlob string `tfschema:"restore_blob"`
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) ModelObject() interface{} {
+	return &KeyVaultMHSMSecurityDomainResourceModel{}
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain"
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedhsms.ValidateManagedHSMID
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		"download": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"download", "upload"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"certificate_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 3,
+						MaxItems: 10,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: keyVaultValidation.NestedItemId,
+						},
+					},
+
+					"quorum": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(2, 10),
+					},
+				},
+			},
+		},
+
+		"upload": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"download", "upload"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"restore_blob": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsJSON,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"security_domain_encrypted_data": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"activation_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"activation_status_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+
+			var config KeyVaultMHSMSecurityDomainResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := managedhsms.ParseManagedHSMID(config.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+
+			baseUri, err := client.BaseUriForManagedHSM(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane URI for %s: %+v", id, err)
+			}
+
+			state := config
+
+			if len(config.Download) > 0 {
+				download := config.Download[0]
+				certificateIds := make([]interface{}, 0, len(download.CertificateIds))
+				for _, v := range download.CertificateIds {
+					certificateIds = append(certificateIds, v)
+				}
+
+				encryptedData, err := securityDomainDownload(ctx, client.DataPlaneSecurityDomainsClient, *metadata.Client.KeyVault.ManagementClient, *baseUri, certificateIds, int(download.Quorum))
+				if err != nil {
+					return fmt.Errorf("downloading the Security Domain for %s: %+v", id, err)
+				}
+				state.SecurityDomainEncryptedData = encryptedData
+			}
+
+			if len(config.Upload) > 0 {
+				// the Transfer Key is only available whilst the Managed HSM is awaiting a Security Domain, so retrieving it
+				// first both confirms the Managed HSM is ready to be restored and that the Security Domain can be exchanged
+				if _, err := client.DataPlaneSecurityDomainsClient.TransferKeyMethod(ctx, *baseUri); err != nil {
+					return fmt.Errorf("retrieving the Transfer Key for %s: %+v", id, err)
+				}
+
+				if err := securityDomainUpload(ctx, client.DataPlaneSecurityDomainsClient, *baseUri, config.Upload[0].RestoreBlob); err != nil {
+					return fmt.Errorf("uploading the Security Domain for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+
+			// the Read function will populate the Activation Status, but the encrypted data can't be retrieved again
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.ManagedHsmClient
+
+			id, err := managedhsms.ParseManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state KeyVaultMHSMSecurityDomainResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.ManagedHSMId = id.ID()
+			state.ActivationStatus = ""
+			state.ActivationStatusMessage = ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if props := model.Properties.SecurityDomainProperties; props != nil {
+					state.ActivationStatus = string(pointer.From(props.ActivationStatus))
+					state.ActivationStatusMessage = pointer.From(props.ActivationStatusMessage)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := managedhsms.ParseManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] The Security Domain for %s can't be deleted - removing from state", id)
+			return nil
+		},
+	}
+}
+
+func securityDomainUpload(ctx context.Context, sdClient *keyvault.HSMSecurityDomainClient, vaultBaseUrl string, restoreBlob string) error {
+	if _, err := sdClient.Upload(ctx, vaultBaseUrl, keyvault.SecurityDomainObject{Value: pointer.To(restoreBlob)}); err != nil {
+		return fmt.Errorf("uploading for %s: %+v", vaultBaseUrl, err)
+	}
+
+	pollerType := custompollers.NewHSMUploadPoller(sdClient, vaultBaseUrl)
+	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the Security Domain to upload: %+v", err)
+	}
+
+	return nil
+}
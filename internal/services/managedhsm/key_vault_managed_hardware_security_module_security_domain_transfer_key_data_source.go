@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultMHSMSecurityDomainTransferKeyDataSourceModel struct {
+	ManagedHSMId              string `tfschema:"managed_hsm_id"`
+	KeyFormat                 string `tfschema:"key_format"`
+	TransferKeyJwk            string `tfschema:"transfer_key_jwk"`
+	TransferKeyCertificatePem string `tfschema:"transfer_key_certificate_pem"`
+}
+
+type KeyVaultMHSMSecurityDomainTransferKeyDataSource struct{}
+
+var _ sdk.DataSource = KeyVaultMHSMSecurityDomainTransferKeyDataSource{}
+
+func (k KeyVaultMHSMSecurityDomainTransferKeyDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+	}
+}
+
+func (k KeyVaultMHSMSecurityDomainTransferKeyDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key_format": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"transfer_key_jwk": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"transfer_key_certificate_pem": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (k KeyVaultMHSMSecurityDomainTransferKeyDataSource) ModelObject() interface{} {
+	return &KeyVaultMHSMSecurityDomainTransferKeyDataSourceModel{}
+}
+
+func (k KeyVaultMHSMSecurityDomainTransferKeyDataSource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain_transfer_key"
+}
+
+func (k KeyVaultMHSMSecurityDomainTransferKeyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+
+			var state KeyVaultMHSMSecurityDomainTransferKeyDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := managedhsms.ParseManagedHSMID(state.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+
+			baseUri, err := client.BaseUriForManagedHSM(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane URI for %s: %+v", id, err)
+			}
+
+			resp, err := client.DataPlaneSecurityDomainsClient.TransferKeyMethod(ctx, *baseUri)
+			if err != nil {
+				return fmt.Errorf("retrieving the Security Domain Transfer Key for %s: %+v", id, err)
+			}
+
+			state.KeyFormat = pointer.From(resp.KeyFormat)
+			if key := resp.TransferKey; key != nil {
+				jwk, err := json.Marshal(key)
+				if err != nil {
+					return fmt.Errorf("serializing the Transfer Key for %s: %+v", id, err)
+				}
+				state.TransferKeyJwk = string(jwk)
+
+				if key.X5c != nil && len(*key.X5c) > 0 {
+					der, err := base64.StdEncoding.DecodeString((*key.X5c)[0])
+					if err != nil {
+						return fmt.Errorf("decoding the Transfer Key certificate for %s: %+v", id, err)
+					}
+					state.TransferKeyCertificatePem = string(pem.EncodeToMemory(&pem.Block{
+						Type:  "CERTIFICATE",
+						Bytes: der,
+					}))
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ManagedHSMDataPlaneRestoreJobId defines the Data Plane ID for a Managed HSM Restore Job.
+// Example format: `https://{name}.{domainSuffix}/restore/{jobId}`
+// Example value:  `https://example.managedhsm.azure.net/restore/00000000000000000000000000000000`
+type ManagedHSMDataPlaneRestoreJobId struct {
+	// ManagedHSMName specifies the Name of this Managed HSM.
+	ManagedHSMName string
+
+	// DomainSuffix specifies the Domain Suffix used for Managed HSMs in the Azure Environment
+	// where the Managed HSM exists - in the format `managedhsm.azure.net`.
+	DomainSuffix string
+
+	// JobId specifies the ID of this Managed HSM Restore Job.
+	JobId string
+}
+
+// NewManagedHSMDataPlaneRestoreJobID returns a new instance of ManagedHSMDataPlaneRestoreJobId with the specified values.
+func NewManagedHSMDataPlaneRestoreJobID(managedHsmName, domainSuffix, jobId string) ManagedHSMDataPlaneRestoreJobId {
+	return ManagedHSMDataPlaneRestoreJobId{
+		ManagedHSMName: managedHsmName,
+		DomainSuffix:   domainSuffix,
+		JobId:          jobId,
+	}
+}
+
+// ManagedHSMDataPlaneRestoreJobID parses the Data Plane Managed HSM Restore Job ID.
+func ManagedHSMDataPlaneRestoreJobID(input string, domainSuffix *string) (*ManagedHSMDataPlaneRestoreJobId, error) {
+	if input == "" {
+		return nil, fmt.Errorf("`input` was empty")
+	}
+	if domainSuffix != nil && !strings.HasPrefix(strings.ToLower(*domainSuffix), "managedhsm.") {
+		return nil, fmt.Errorf("internal-error: the domainSuffix for Managed HSM %q didn't contain `managedhsm.`", *domainSuffix)
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	endpoint, err := parseDataPlaneEndpoint(uri, domainSuffix)
+	if err != nil {
+		// intentionally not wrapping this
+		return nil, err
+	}
+
+	const requireVersion = false
+	resource, err := parseDataPlaneResource(uri, "restore", requireVersion)
+	if err != nil {
+		// intentionally not wrapping this
+		return nil, err
+	}
+
+	return &ManagedHSMDataPlaneRestoreJobId{
+		ManagedHSMName: endpoint.ManagedHSMName,
+		DomainSuffix:   endpoint.DomainSuffix,
+		JobId:          resource.itemName,
+	}, nil
+}
+
+// BaseUri returns the Base URI for this Managed HSM Data Plane Restore Job
+func (id ManagedHSMDataPlaneRestoreJobId) BaseUri() string {
+	return fmt.Sprintf("https://%s.%s/", id.ManagedHSMName, id.DomainSuffix)
+}
+
+// ID returns the full Resource ID for this Managed HSM Data Plane Restore Job
+func (id ManagedHSMDataPlaneRestoreJobId) ID() string {
+	return fmt.Sprintf("https://%s.%s/restore/%s", id.ManagedHSMName, id.DomainSuffix, id.JobId)
+}
+
+// String returns a human-readable description of this Managed HSM Restore Job ID
+func (id ManagedHSMDataPlaneRestoreJobId) String() string {
+	components := []string{
+		fmt.Sprintf("Managed HSM Name: %q", id.ManagedHSMName),
+		fmt.Sprintf("Domain Suffix: %q", id.DomainSuffix),
+		fmt.Sprintf("Job ID: %q", id.JobId),
+	}
+	return fmt.Sprintf("Managed HSM Restore Job (%s)", strings.Join(components, "\n"))
+}
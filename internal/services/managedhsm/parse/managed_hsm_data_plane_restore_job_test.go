@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestParseManagedHSMDataPlaneRestoreJobID_InvalidValuesFail(t *testing.T) {
+	values := []string{
+		"",                                   // empty = invalid
+		"https://example.com/restore/abc123", // Hostname is incomplete
+		"https://example.keyvault.azure.net/restore/abc123",          // Key Vault
+		"https://example.managedhsm.azure.net/",                      // no path
+		"https://example.managedhsm.azure.net/restore/",              // trailing slash - no job
+		"https://example.managedhsm.azure.net/restore/abc123/status", // nested path
+		"https://example.managedhsm.azure.net/backup/abc123",         // wrong type
+		"http://example.managedhsm.azure.net:80/restore/abc123",      // HTTP rather than HTTPS
+	}
+	for _, input := range values {
+		t.Logf("Validating %q", input)
+		actual, err := ManagedHSMDataPlaneRestoreJobID(input, nil)
+		if err == nil {
+			t.Fatalf("unexpected value for %q: %q", input, actual.ID())
+		}
+	}
+}
+
+func TestParseManagedHSMDataPlaneRestoreJobID_WithDomainSuffix_InvalidValuesFail(t *testing.T) {
+	values := []string{
+		"https://managedhsm.azure.net/restore/abc123",           // hostname is only the domainSuffix
+		"https://example.managedhsm.some.domain/restore/abc123", // hostname doesn't contain the domainSuffix
+	}
+	for _, input := range values {
+		t.Logf("Validating %q", input)
+		actual, err := ManagedHSMDataPlaneRestoreJobID(input, pointer.To("managedhsm.azure.net"))
+		if err == nil {
+			t.Fatalf("unexpected value for %q: %q", input, actual.ID())
+		}
+	}
+}
+
+func TestParseManagedHSMDataPlaneRestoreJobID_ValidValues(t *testing.T) {
+	values := map[string]ManagedHSMDataPlaneRestoreJobId{
+		"https://example.managedhsm.azure.net/restore/abc123": {
+			// Public
+			ManagedHSMName: "example",
+			DomainSuffix:   "managedhsm.azure.net",
+			JobId:          "abc123",
+		},
+		"https://EXAMPLE.managedhsm.azure.net/restore/abc123": {
+			// Public but the uppercase name should be normalised
+			ManagedHSMName: "example",
+			DomainSuffix:   "managedhsm.azure.net",
+			JobId:          "abc123",
+		},
+		"https://example.managedhsm.azure.net:443/restore/abc123": {
+			// the port should be removed
+			ManagedHSMName: "example",
+			DomainSuffix:   "managedhsm.azure.net",
+			JobId:          "abc123",
+		},
+		"https://example.managedhsm.azure.cn/restore/abc123": {
+			// China
+			ManagedHSMName: "example",
+			DomainSuffix:   "managedhsm.azure.cn",
+			JobId:          "abc123",
+		},
+		"https://example.managedhsm.usgovcloudapi.net/restore/abc123": {
+			// US Gov
+			ManagedHSMName: "example",
+			DomainSuffix:   "managedhsm.usgovcloudapi.net",
+			JobId:          "abc123",
+		},
+	}
+	for input, expected := range values {
+		t.Logf("Validating %q", input)
+		actual, err := ManagedHSMDataPlaneRestoreJobID(input, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err.Error())
+		}
+
+		if actual.ManagedHSMName != expected.ManagedHSMName {
+			t.Fatalf("expected `ManagedHSMName` to be %q but got %q", expected.ManagedHSMName, actual.ManagedHSMName)
+		}
+		if actual.DomainSuffix != expected.DomainSuffix {
+			t.Fatalf("expected `DomainSuffix` to be %q but got %q", expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.JobId != expected.JobId {
+			t.Fatalf("expected `JobId` to be %q but got %q", expected.JobId, actual.JobId)
+		}
+
+		withDomainSuffix, err := ManagedHSMDataPlaneRestoreJobID(input, pointer.To(expected.DomainSuffix))
+		if err != nil {
+			t.Fatalf("unexpected error with the domain suffix %q: %+v", expected.DomainSuffix, err.Error())
+		}
+		if withDomainSuffix.ID() != expected.ID() {
+			t.Fatalf("expected the ID to be %q but got %q", expected.ID(), withDomainSuffix.ID())
+		}
+	}
+}
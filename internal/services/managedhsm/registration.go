@@ -50,6 +50,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		KeyvaultMHSMRoleDefinitionDataSource{},
 		KeyvaultMHSMKeyDataSource{},
+		KeyVaultMHSMSecurityDomainTransferKeyDataSource{},
 	}
 }
 
@@ -59,5 +60,8 @@ func (r Registration) Resources() []sdk.Resource {
 		KeyVaultMHSMRoleDefinitionResource{},
 		KeyVaultManagedHSMRoleAssignmentResource{},
 		KeyVaultMHSMKeyRotationPolicyResource{},
+		KeyVaultMHSMSecurityDomainResource{},
+		KeyVaultMHSMBackupResource{},
+		KeyVaultMHSMRestoreResource{},
 	}
 }
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestManagedHSMDataPlaneBackupJobID(t *testing.T) {
+	testData := []struct {
+		input string
+		valid bool
+	}{
+		{
+			// empty = invalid
+			input: "",
+			valid: false,
+		},
+		{
+			// key vault domain
+			input: "https://example.keyvault.azure.net/backup/abc123",
+			valid: false,
+		},
+		{
+			// domain but no uri
+			input: "https://example.managedhsm.azure.net/",
+			valid: false,
+		},
+		{
+			// managed hsm domain but wrong type
+			input: "https://example.managedhsm.azure.net/restore/abc123",
+			valid: false,
+		},
+		{
+			// managed hsm backup job id
+			input: "https://example.managedhsm.azure.net/backup/abc123",
+			valid: true,
+		},
+	}
+	for _, item := range testData {
+		t.Logf("Testing %q", item.input)
+		warnings, errs := ManagedHSMDataPlaneBackupJobID(item.input, "some_field")
+		actual := len(warnings) == 0 && len(errs) == 0
+		if item.valid != actual {
+			t.Fatalf("expected %t but got %t", item.valid, actual)
+		}
+	}
+}
@@ -202,6 +202,36 @@ func TestAccVirtualNetwork_withTags(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetwork_defaultTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
+	r := VirtualNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultTags(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.cost_center").HasValue("MSFT"),
+				check.That(data.ResourceName).Key("tags_all.%").HasValue("2"),
+				check.That(data.ResourceName).Key("tags_all.environment").HasValue("Production"),
+				check.That(data.ResourceName).Key("tags_all.cost_center").HasValue("MSFT"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultTags(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags_all.%").HasValue("2"),
+				check.That(data.ResourceName).Key("tags_all.environment").HasValue("staging"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccVirtualNetwork_deleteSubnet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
 	r := VirtualNetworkResource{}
@@ -574,6 +604,36 @@ resource "azurerm_virtual_network" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (VirtualNetworkResource) defaultTags(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  default_tags {
+    tags = {
+      environment = "%s"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  tags = {
+    cost_center = "MSFT"
+  }
+}
+`, environment, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (VirtualNetworkResource) noSubnet(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
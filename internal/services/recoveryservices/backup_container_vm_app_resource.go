@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceBackupProtectionContainerVMApp() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionContainerVMAppCreate,
+		Read:   resourceBackupProtectionContainerVMAppRead,
+		Delete: resourceBackupProtectionContainerVMAppDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := protectioncontainers.ParseProtectionContainerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateVirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(protectioncontainers.WorkloadTypeSQLDataBase),
+					string(protectioncontainers.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceBackupProtectionContainerVMAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	opResultClient := meta.(*clients.Client).RecoveryServices.ProtectionContainerOperationResultsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := commonids.ParseVirtualMachineID(d.Get("source_resource_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := protectioncontainers.NewProtectionContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), "Azure", vmAppContainerName(*virtualMachineId))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_backup_container_vm_app", id.ID())
+	}
+
+	parameters := protectioncontainers.ProtectionContainerResource{
+		Properties: &protectioncontainers.AzureVMAppContainerProtectionContainer{
+			SourceResourceId:     pointer.To(virtualMachineId.ID()),
+			FriendlyName:         pointer.To(virtualMachineId.VirtualMachineName),
+			BackupManagementType: pointer.To(protectioncontainers.BackupManagementTypeAzureWorkload),
+			WorkloadType:         pointer.To(protectioncontainers.WorkloadType(d.Get("workload_type").(string))),
+		},
+	}
+
+	resp, err := client.Register(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return fmt.Errorf("unable to determine operation URL for %s: Location header missing or empty", id)
+	}
+
+	opResourceID := handleAzureSdkForGoBug2824(locationURL.Path)
+
+	parsedLocation, err := azure.ParseAzureResourceID(opResourceID)
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opStatusClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for registration of %s: %+v", id, err)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(id.ID()))
+
+	// once registered, the workloads (databases, instances and availability groups) on the Virtual Machine need to be
+	// discovered via the `inquire` API before they can be protected
+	if err := inquireBackupProtectionContainerWorkloads(ctx, client, opResultClient, id, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceBackupProtectionContainerVMAppRead(d, meta)
+}
+
+func resourceBackupProtectionContainerVMAppRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protectioncontainers.ParseProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if model := resp.Model; model != nil {
+		if properties, ok := model.Properties.(protectioncontainers.AzureVMAppContainerProtectionContainer); ok {
+			sourceResourceId := ""
+			if properties.SourceResourceId != nil {
+				parsed, err := commonids.ParseVirtualMachineIDInsensitively(*properties.SourceResourceId)
+				if err != nil {
+					return err
+				}
+				sourceResourceId = parsed.ID()
+			}
+			d.Set("source_resource_id", sourceResourceId)
+			d.Set("workload_type", string(pointer.From(properties.WorkloadType)))
+		}
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionContainerVMAppDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protectioncontainers.ParseProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Unregister(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("unregistering %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("unregistering %s: Location header missing or empty", id)
+	}
+
+	opResourceID := handleAzureSdkForGoBug2824(locationURL.Path)
+
+	parsedLocation, err := azure.ParseAzureResourceID(opResourceID)
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["backupOperationResults"]
+
+	if err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for unregistration of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// vmAppContainerName returns the name of the Protection Container used by Azure Backup for workloads within a Virtual Machine
+func vmAppContainerName(id commonids.VirtualMachineId) string {
+	return fmt.Sprintf("VMAppContainer;Compute;%s;%s", id.ResourceGroupName, id.VirtualMachineName)
+}
+
+// inquireBackupProtectionContainerWorkloads triggers the discovery of the workloads within a Protection Container and
+// waits for it to complete, the `inquire` API is an async operation which is tracked using the Location header.
+func inquireBackupProtectionContainerWorkloads(ctx context.Context, client *protectioncontainers.ProtectionContainersClient, opResultClient *backup.ProtectionContainerOperationResultsClient, id protectioncontainers.ProtectionContainerId, timeout time.Duration) error {
+	resp, err := client.Inquire(ctx, id, protectioncontainers.DefaultInquireOperationOptions())
+	if err != nil {
+		return fmt.Errorf("inquiring workloads for %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("inquiring workloads for %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"202"},
+		Target:     []string{"200", "204"},
+		Refresh:    protectionContainerOperationResultsRefreshFunc(ctx, opResultClient, id.VaultName, id.ResourceGroupName, id.ProtectionContainerName, operationID),
+		Timeout:    timeout,
+	}
+
+	if _, err := state.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the inquiry of workloads for %s: %+v", id, err)
+	}
+
+	return nil
+}
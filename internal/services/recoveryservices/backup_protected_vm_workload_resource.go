@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotectableitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// workloadItemTypeSQLAvailabilityGroupContainer isn't defined in the Azure SDK, but is accepted by the API for the
+// auto-protection of SQL Availability Groups
+const workloadItemTypeSQLAvailabilityGroupContainer backup.WorkloadItemType = "SQLAvailabilityGroupContainer"
+
+func resourceBackupProtectedVMWorkload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectedVMWorkloadCreate,
+		Read:   resourceBackupProtectedVMWorkloadRead,
+		Update: resourceBackupProtectedVMWorkloadUpdate,
+		Delete: resourceBackupProtectedVMWorkloadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := parse.BackupProtectionIntentID(id); err == nil {
+				return nil
+			}
+			_, err := protecteditems.ParseProtectedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(80 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_vm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateVirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(protecteditems.DataSourceTypeSQLDataBase),
+					string(protecteditems.DataSourceTypeSAPHanaDatabase),
+				}, false),
+			},
+
+			"instance_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"auto_protection_enabled"},
+			},
+
+			"auto_protection_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"database_name"},
+			},
+
+			"backup_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceBackupProtectedVMWorkloadCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	protectableClient := meta.(*clients.Client).RecoveryServices.ProtectableItemsClient
+	protectionContainerClient := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opResultClient := meta.(*clients.Client).RecoveryServices.ProtectionContainerOperationResultsClient
+	intentClient := meta.(*clients.Client).RecoveryServices.ProtectionIntentClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	workloadType := d.Get("workload_type").(string)
+	instanceName := d.Get("instance_name").(string)
+	databaseName := d.Get("database_name").(string)
+	autoProtectionEnabled := d.Get("auto_protection_enabled").(bool)
+
+	if databaseName == "" && !autoProtectionEnabled {
+		return fmt.Errorf("one of `database_name` or `auto_protection_enabled` must be specified")
+	}
+	if autoProtectionEnabled && workloadType != string(protecteditems.DataSourceTypeSQLDataBase) {
+		return fmt.Errorf("`auto_protection_enabled` can only be set when `workload_type` is `%s`", protecteditems.DataSourceTypeSQLDataBase)
+	}
+
+	virtualMachineId, err := commonids.ParseVirtualMachineID(d.Get("source_vm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// new databases may not have been discovered yet, so the workloads within the container are inquired first
+	containerId := protectioncontainers.NewProtectionContainerID(subscriptionId, resourceGroup, vaultName, "Azure", vmAppContainerName(*virtualMachineId))
+	if err := inquireBackupProtectionContainerWorkloads(ctx, protectionContainerClient, opResultClient, containerId, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	vaultId := backupprotectableitems.NewVaultID(subscriptionId, resourceGroup, vaultName)
+	item, err := findBackupProtectableVMWorkloadItem(ctx, protectableClient, vaultId, containerId.ProtectionContainerName, workloadType, instanceName, databaseName)
+	if err != nil {
+		return err
+	}
+
+	if autoProtectionEnabled {
+		if item.isAutoProtected {
+			return fmt.Errorf("auto-protection is already enabled for %q on Virtual Machine %q in Recovery Service Vault %q (Resource Group %q) - to be managed via Terraform this needs to be imported into the State. Please see the resource documentation for %q for more information", instanceName, virtualMachineId.VirtualMachineName, vaultName, resourceGroup, "azurerm_backup_protected_vm_workload")
+		}
+
+		intentName, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating name for the Protection Intent: %+v", err)
+		}
+
+		id := parse.NewBackupProtectionIntentID(subscriptionId, resourceGroup, vaultName, "Azure", intentName)
+		if err := createOrUpdateBackupProtectionIntent(ctx, intentClient, id, virtualMachineId.ID(), item.id, item.workloadItemType, d.Get("backup_policy_id").(string)); err != nil {
+			return err
+		}
+
+		d.SetId(id.ID())
+
+		return resourceBackupProtectedVMWorkloadRead(d, meta)
+	}
+
+	id := protecteditems.NewProtectedItemID(subscriptionId, resourceGroup, vaultName, "Azure", item.containerName, item.name)
+
+	if item.isProtected {
+		return tf.ImportAsExistsError("azurerm_backup_protected_vm_workload", id.ID())
+	}
+
+	if err := createOrUpdateBackupProtectedVMWorkloadItem(ctx, meta, d, id, virtualMachineId.ID()); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBackupProtectedVMWorkloadRead(d, meta)
+}
+
+func resourceBackupProtectedVMWorkloadUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	intentClient := meta.(*clients.Client).RecoveryServices.ProtectionIntentClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if intentId, err := parse.BackupProtectionIntentID(d.Id()); err == nil {
+		existing, err := intentClient.Get(ctx, intentId.VaultName, intentId.ResourceGroup, intentId.BackupFabricName, intentId.BackupProtectionIntentName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *intentId, err)
+		}
+
+		intent, ok := existing.Properties.AsAzureWorkloadSQLAutoProtectionIntent()
+		if !ok || intent == nil {
+			return fmt.Errorf("retrieving %s: expected an Azure Workload SQL Auto Protection Intent", *intentId)
+		}
+
+		if err := createOrUpdateBackupProtectionIntent(ctx, intentClient, *intentId, pointer.From(intent.SourceResourceID), pointer.From(intent.ItemID), intent.WorkloadItemType, d.Get("backup_policy_id").(string)); err != nil {
+			return err
+		}
+
+		return resourceBackupProtectedVMWorkloadRead(d, meta)
+	}
+
+	id, err := protecteditems.ParseProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := createOrUpdateBackupProtectedVMWorkloadItem(ctx, meta, d, *id, d.Get("source_vm_id").(string)); err != nil {
+		return err
+	}
+
+	return resourceBackupProtectedVMWorkloadRead(d, meta)
+}
+
+func resourceBackupProtectedVMWorkloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	intentClient := meta.(*clients.Client).RecoveryServices.ProtectionIntentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if intentId, err := parse.BackupProtectionIntentID(d.Id()); err == nil {
+		resp, err := intentClient.Get(ctx, intentId.VaultName, intentId.ResourceGroup, intentId.BackupFabricName, intentId.BackupProtectionIntentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from state", *intentId)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", *intentId, err)
+		}
+
+		d.Set("resource_group_name", intentId.ResourceGroup)
+		d.Set("recovery_vault_name", intentId.VaultName)
+		d.Set("auto_protection_enabled", true)
+		d.Set("workload_type", string(protecteditems.DataSourceTypeSQLDataBase))
+
+		if resp.Properties != nil {
+			if intent, ok := resp.Properties.AsAzureWorkloadSQLAutoProtectionIntent(); ok && intent != nil {
+				if v := intent.SourceResourceID; v != nil {
+					if vmId, err := commonids.ParseVirtualMachineIDInsensitively(*v); err == nil {
+						d.Set("source_vm_id", vmId.ID())
+					}
+				}
+				if v := intent.PolicyID; v != nil {
+					d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+				}
+			}
+		}
+
+		return nil
+	}
+
+	id, err := protecteditems.ParseProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, protecteditems.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("recovery_vault_name", id.VaultName)
+	d.Set("auto_protection_enabled", false)
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		var sourceResourceId, policyId, friendlyName, parentName *string
+		var workloadType *protecteditems.DataSourceType
+		switch item := model.Properties.(type) {
+		case protecteditems.AzureVMWorkloadSQLDatabaseProtectedItem:
+			sourceResourceId, policyId, friendlyName, parentName, workloadType = item.SourceResourceId, item.PolicyId, item.FriendlyName, item.ParentName, item.WorkloadType
+		case protecteditems.AzureVMWorkloadSAPHanaDatabaseProtectedItem:
+			sourceResourceId, policyId, friendlyName, parentName, workloadType = item.SourceResourceId, item.PolicyId, item.FriendlyName, item.ParentName, item.WorkloadType
+		}
+
+		// databases within an Availability Group are sourced from the Availability Group rather than the Virtual Machine
+		if sourceResourceId != nil {
+			if vmId, err := commonids.ParseVirtualMachineIDInsensitively(*sourceResourceId); err == nil {
+				d.Set("source_vm_id", vmId.ID())
+			}
+		}
+		if policyId != nil {
+			d.Set("backup_policy_id", strings.Replace(*policyId, "Subscriptions", "subscriptions", 1))
+		}
+		d.Set("database_name", pointer.From(friendlyName))
+		d.Set("instance_name", pointer.From(parentName))
+		d.Set("workload_type", string(pointer.From(workloadType)))
+	}
+
+	return nil
+}
+
+func resourceBackupProtectedVMWorkloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	intentClient := meta.(*clients.Client).RecoveryServices.ProtectionIntentClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if intentId, err := parse.BackupProtectionIntentID(d.Id()); err == nil {
+		// removing the Protection Intent disables auto-protection, any databases already protected remain protected
+		resp, err := intentClient.Delete(ctx, intentId.VaultName, intentId.ResourceGroup, intentId.BackupFabricName, intentId.BackupProtectionIntentName)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *intentId, err)
+		}
+
+		return nil
+	}
+
+	id, err := protecteditems.ParseProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("deleting %s: Location header missing or empty", *id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["backupOperationResults"]
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+type backupProtectableVMWorkloadItem struct {
+	id               string
+	name             string
+	containerName    string
+	workloadItemType backup.WorkloadItemType
+	isAutoProtected  bool
+	isProtected      bool
+}
+
+// findBackupProtectableVMWorkloadItem locates the database, instance or availability group to protect, since the
+// `$filter` only supports filtering on the backup management type the matching has to be done client side.
+func findBackupProtectableVMWorkloadItem(ctx context.Context, client *backupprotectableitems.BackupProtectableItemsClient, vaultId backupprotectableitems.VaultId, vmContainerName, workloadType, instanceName, databaseName string) (*backupProtectableVMWorkloadItem, error) {
+	options := backupprotectableitems.ListOperationOptions{
+		Filter: pointer.To("backupManagementType eq 'AzureWorkload'"),
+	}
+	items, err := client.ListComplete(ctx, vaultId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing protectable items in %s: %+v", vaultId, err)
+	}
+
+	for _, v := range items.Items {
+		if v.Id == nil || v.Name == nil || v.Properties == nil {
+			continue
+		}
+
+		parsed, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(*v.Id))
+		if err != nil {
+			return nil, err
+		}
+		containerName := parsed.Path["protectionContainers"]
+
+		// databases within an Availability Group are registered against the Availability Group's container
+		isVMContainer := strings.EqualFold(containerName, vmContainerName)
+		isAGContainer := strings.HasPrefix(strings.ToLower(containerName), "sqlagworkloadcontainer;")
+		if !isVMContainer && !isAGContainer {
+			continue
+		}
+
+		result := backupProtectableVMWorkloadItem{
+			id:            *v.Id,
+			name:          *v.Name,
+			containerName: containerName,
+		}
+
+		var friendlyName, parentName *string
+		var protectionState *backupprotectableitems.ProtectionStatus
+		matchesType := false
+		switch item := v.Properties.(type) {
+		case backupprotectableitems.AzureVMWorkloadSQLDatabaseProtectableItem:
+			matchesType = databaseName != "" && workloadType == string(protecteditems.DataSourceTypeSQLDataBase)
+			friendlyName, parentName, protectionState = item.FriendlyName, item.ParentName, item.ProtectionState
+
+		case backupprotectableitems.AzureVMWorkloadSAPHanaDatabaseProtectableItem:
+			matchesType = databaseName != "" && workloadType == string(protecteditems.DataSourceTypeSAPHanaDatabase)
+			friendlyName, parentName, protectionState = item.FriendlyName, item.ParentName, item.ProtectionState
+
+		case backupprotectableitems.AzureVMWorkloadSQLInstanceProtectableItem:
+			matchesType = databaseName == "" && isVMContainer
+			friendlyName, parentName = item.FriendlyName, item.FriendlyName
+			result.workloadItemType = backup.WorkloadItemTypeSQLInstance
+			result.isAutoProtected = pointer.From(item.IsAutoProtected)
+
+		case backupprotectableitems.AzureVMWorkloadSQLAvailabilityGroupProtectableItem:
+			matchesType = databaseName == ""
+			friendlyName, parentName = item.FriendlyName, item.FriendlyName
+			result.workloadItemType = workloadItemTypeSQLAvailabilityGroupContainer
+			result.isAutoProtected = pointer.From(item.IsAutoProtected)
+		}
+
+		if !matchesType || !strings.EqualFold(pointer.From(parentName), instanceName) {
+			continue
+		}
+		if databaseName != "" && !strings.EqualFold(pointer.From(friendlyName), databaseName) {
+			continue
+		}
+
+		result.isProtected = pointer.From(protectionState) == backupprotectableitems.ProtectionStatusProtected
+		return &result, nil
+	}
+
+	if databaseName != "" {
+		return nil, fmt.Errorf("database %q within %q was not found in the protectable items of %s, make sure the Virtual Machine is registered with the Recovery Service Vault", databaseName, instanceName, vaultId)
+	}
+	return nil, fmt.Errorf("%q was not found in the protectable items of %s, make sure the Virtual Machine is registered with the Recovery Service Vault", instanceName, vaultId)
+}
+
+func createOrUpdateBackupProtectedVMWorkloadItem(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData, id protecteditems.ProtectedItemId, sourceResourceId string) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+
+	policyId := d.Get("backup_policy_id").(string)
+	databaseName := d.Get("database_name").(string)
+
+	item := protecteditems.ProtectedItemResource{}
+	switch workloadType := protecteditems.DataSourceType(d.Get("workload_type").(string)); workloadType {
+	case protecteditems.DataSourceTypeSAPHanaDatabase:
+		item.Properties = &protecteditems.AzureVMWorkloadSAPHanaDatabaseProtectedItem{
+			PolicyId:         pointer.To(policyId),
+			WorkloadType:     pointer.To(workloadType),
+			SourceResourceId: pointer.To(sourceResourceId),
+			FriendlyName:     pointer.To(databaseName),
+		}
+	default:
+		item.Properties = &protecteditems.AzureVMWorkloadSQLDatabaseProtectedItem{
+			PolicyId:         pointer.To(policyId),
+			WorkloadType:     pointer.To(workloadType),
+			SourceResourceId: pointer.To(sourceResourceId),
+			FriendlyName:     pointer.To(databaseName),
+		}
+	}
+
+	resp, err := client.CreateOrUpdate(ctx, id, item)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("creating/updating %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func createOrUpdateBackupProtectionIntent(ctx context.Context, client *backup.ProtectionIntentClient, id parse.BackupProtectionIntentId, sourceResourceId, itemId string, workloadItemType backup.WorkloadItemType, policyId string) error {
+	intent := backup.ProtectionIntentResource{
+		Properties: &backup.AzureWorkloadSQLAutoProtectionIntent{
+			WorkloadItemType:     workloadItemType,
+			BackupManagementType: backup.ManagementTypeAzureWorkload,
+			SourceResourceID:     utils.String(sourceResourceId),
+			ItemID:               utils.String(itemId),
+			PolicyID:             utils.String(policyId),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.BackupProtectionIntentName, intent); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
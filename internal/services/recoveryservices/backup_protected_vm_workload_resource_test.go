@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectedVMWorkloadResource struct{}
+
+func TestAccBackupProtectedVMWorkload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload", "test")
+	r := BackupProtectedVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectedVMWorkload_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload", "test")
+	r := BackupProtectedVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccBackupProtectedVMWorkload_autoProtection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload", "test")
+	r := BackupProtectedVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoProtection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("instance_name"),
+	})
+}
+
+func (t BackupProtectedVMWorkloadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if intentId, err := parse.BackupProtectionIntentID(state.ID); err == nil {
+		resp, err := clients.RecoveryServices.ProtectionIntentClient.Get(ctx, intentId.VaultName, intentId.ResourceGroup, intentId.BackupFabricName, intentId.BackupProtectionIntentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *intentId, err)
+		}
+
+		return pointer.To(resp.Properties != nil), nil
+	}
+
+	id, err := protecteditems.ParseProtectedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectedItemsClient.Get(ctx, *id, protecteditems.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (BackupProtectedVMWorkloadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_vm_app" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_resource_id  = azurerm_mssql_virtual_machine.test.virtual_machine_id
+  workload_type       = "SQLDataBase"
+}
+`, BackupProtectionContainerVMAppResource{}.template(data))
+}
+
+func (r BackupProtectedVMWorkloadResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_vm_id        = azurerm_backup_container_vm_app.test.source_resource_id
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = "${azurerm_recovery_services_vault.test.id}/backupPolicies/HourlyLogBackup"
+}
+`, r.template(data))
+}
+
+func (r BackupProtectedVMWorkloadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload" "import" {
+  resource_group_name = azurerm_backup_protected_vm_workload.test.resource_group_name
+  recovery_vault_name = azurerm_backup_protected_vm_workload.test.recovery_vault_name
+  source_vm_id        = azurerm_backup_protected_vm_workload.test.source_vm_id
+  workload_type       = azurerm_backup_protected_vm_workload.test.workload_type
+  instance_name       = azurerm_backup_protected_vm_workload.test.instance_name
+  database_name       = azurerm_backup_protected_vm_workload.test.database_name
+  backup_policy_id    = azurerm_backup_protected_vm_workload.test.backup_policy_id
+}
+`, r.basic(data))
+}
+
+func (r BackupProtectedVMWorkloadResource) autoProtection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload" "test" {
+  resource_group_name     = azurerm_resource_group.test.name
+  recovery_vault_name     = azurerm_recovery_services_vault.test.name
+  source_vm_id            = azurerm_backup_container_vm_app.test.source_resource_id
+  workload_type           = "SQLDataBase"
+  instance_name           = "MSSQLSERVER"
+  auto_protection_enabled = true
+  backup_policy_id        = "${azurerm_recovery_services_vault.test.id}/backupPolicies/HourlyLogBackup"
+}
+`, r.template(data))
+}
@@ -39,6 +39,7 @@ type Client struct {
 	ProtectedItemsGroupClient                 *backupprotecteditems.BackupProtectedItemsClient
 	ProtectionPoliciesClient                  *protectionpolicies.ProtectionPoliciesClient
 	ProtectionContainerOperationResultsClient *backup.ProtectionContainerOperationResultsClient
+	ProtectionIntentClient                    *backup.ProtectionIntentClient
 	BackupProtectionContainersClient          *protectioncontainers.ProtectionContainersClient
 	BackupOperationStatusesClient             *backup.OperationStatusesClient
 	BackupOperationResultsClient              *backup.OperationResultsClient
@@ -106,6 +107,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	backupProtectionContainerOperationResultsClient := backup.NewProtectionContainerOperationResultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupProtectionContainerOperationResultsClient.Client, o.ResourceManagerAuthorizer)
 
+	// the Protection Intent API isn't available in hashicorp/go-azure-sdk, so we are using the track-1 sdk
+	protectionIntentClient := backup.NewProtectionIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectionIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	fabricClient, err := replicationfabrics.NewReplicationFabricsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building ReplicationFabrics client: %+v", err)
@@ -175,6 +180,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ProtectedItemsGroupClient:                 &protectedItemsGroupClient,
 		ProtectionPoliciesClient:                  &protectionPoliciesClient,
 		ProtectionContainerOperationResultsClient: &backupProtectionContainerOperationResultsClient,
+		ProtectionIntentClient:                    &protectionIntentClient,
 		BackupProtectionContainersClient:          &backupProtectionContainersClient,
 		ProtectedItemOperationResultsClient:       &protectedItemOperationResultClient,
 		BackupOperationStatusesClient:             &backupOperationStatusesClient,
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type BackupProtectionIntentId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VaultName                  string
+	BackupFabricName           string
+	BackupProtectionIntentName string
+}
+
+func NewBackupProtectionIntentID(subscriptionId, resourceGroup, vaultName, backupFabricName, backupProtectionIntentName string) BackupProtectionIntentId {
+	return BackupProtectionIntentId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VaultName:                  vaultName,
+		BackupFabricName:           backupFabricName,
+		BackupProtectionIntentName: backupProtectionIntentName,
+	}
+}
+
+func (id BackupProtectionIntentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Backup Protection Intent Name %q", id.BackupProtectionIntentName),
+		fmt.Sprintf("Backup Fabric Name %q", id.BackupFabricName),
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Backup Protection Intent", segmentsStr)
+}
+
+func (id BackupProtectionIntentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/%s/backupProtectionIntent/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.BackupFabricName, id.BackupProtectionIntentName)
+}
+
+// BackupProtectionIntentID parses a BackupProtectionIntent ID into an BackupProtectionIntentId struct
+func BackupProtectionIntentID(input string) (*BackupProtectionIntentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an BackupProtectionIntent ID: %+v", input, err)
+	}
+
+	resourceId := BackupProtectionIntentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackupFabricName, err = id.PopSegment("backupFabrics"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackupProtectionIntentName, err = id.PopSegment("backupProtectionIntent"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
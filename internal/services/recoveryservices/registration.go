@@ -69,9 +69,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	// todo - this package should probably be split into backup, recovery, and site recovery?
 	return map[string]*pluginsdk.Resource{
 		"azurerm_backup_container_storage_account":           resourceBackupProtectionContainerStorageAccount(),
+		"azurerm_backup_container_vm_app":                    resourceBackupProtectionContainerVMApp(),
 		"azurerm_backup_policy_file_share":                   resourceBackupProtectionPolicyFileShare(),
 		"azurerm_backup_protected_file_share":                resourceBackupProtectedFileShare(),
 		"azurerm_backup_protected_vm":                        resourceRecoveryServicesBackupProtectedVM(),
+		"azurerm_backup_protected_vm_workload":               resourceBackupProtectedVMWorkload(),
 		"azurerm_backup_policy_vm":                           resourceBackupProtectionPolicyVM(),
 		"azurerm_recovery_services_vault":                    resourceRecoveryServicesVault(),
 		"azurerm_site_recovery_fabric":                       resourceSiteRecoveryFabric(),
@@ -12,3 +12,4 @@ package recoveryservices
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ProtectionContainer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/fabric1/protectionContainers/container1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackupPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/policy1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ProtectedItem -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/protectedItem1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackupProtectionIntent -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/backupProtectionIntent/intent1
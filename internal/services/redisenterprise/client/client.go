@@ -9,11 +9,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	databasesV20250401 "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
 )
 
 type Client struct {
 	Client         *redisenterprise.RedisEnterpriseClient
 	DatabaseClient *databases.DatabasesClient
+
+	// DatabaseAccessClient manages the access keys authentication and the access policy assignments of a Database
+	DatabaseAccessClient *databasesV20250401.DatabasesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -29,8 +33,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(databaseClient.Client, o.Authorizers.ResourceManager)
 
+	databaseAccessClient, err := databasesV20250401.NewDatabasesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Database Access client: %+v", err)
+	}
+	o.Configure(databaseAccessClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		Client:         client,
-		DatabaseClient: databaseClient,
+		Client:               client,
+		DatabaseClient:       databaseClient,
+		DatabaseAccessClient: databaseAccessClient,
 	}, nil
 }
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redisenterprise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RedisEnterpriseDatabaseAccessKeysDataSource struct{}
+
+var _ sdk.DataSource = RedisEnterpriseDatabaseAccessKeysDataSource{}
+
+type RedisEnterpriseDatabaseAccessKeysDataSourceModel struct {
+	DatabaseId                      string `tfschema:"database_id"`
+	AccessKeysAuthenticationEnabled bool   `tfschema:"access_keys_authentication_enabled"`
+	PrimaryAccessKey                string `tfschema:"primary_access_key"`
+	SecondaryAccessKey              string `tfschema:"secondary_access_key"`
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: databases.ValidateDatabaseID,
+		},
+	}
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access_keys_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"primary_access_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_access_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) ModelObject() interface{} {
+	return &RedisEnterpriseDatabaseAccessKeysDataSourceModel{}
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) ResourceType() string {
+	return "azurerm_redis_enterprise_database_access_keys"
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RedisEnterprise.DatabaseAccessClient
+
+			var model RedisEnterpriseDatabaseAccessKeysDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := databases.ParseDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := RedisEnterpriseDatabaseAccessKeysDataSourceModel{
+				DatabaseId:                      id.ID(),
+				AccessKeysAuthenticationEnabled: accessKeysAuthenticationEnabled(resp.Model),
+			}
+
+			// the access keys can't be listed when access keys authentication is disabled
+			if state.AccessKeysAuthenticationEnabled {
+				keysResp, err := client.ListKeys(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("listing keys for %s: %+v", id, err)
+				}
+
+				if keys := keysResp.Model; keys != nil {
+					state.PrimaryAccessKey = pointer.From(keys.PrimaryKey)
+					state.SecondaryAccessKey = pointer.From(keys.SecondaryKey)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// accessKeysAuthenticationEnabled returns whether access keys authentication is enabled for the Database, which is
+// the case unless it has been explicitly disabled
+func accessKeysAuthenticationEnabled(input *databases.Database) bool {
+	if input == nil || input.Properties == nil || input.Properties.AccessKeysAuthentication == nil {
+		return true
+	}
+
+	return *input.Properties.AccessKeysAuthentication != databases.AccessKeysAuthenticationDisabled
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redisenterprise_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RedisEnterpriseDatabaseAccessKeysDataSource struct{}
+
+func TestAccRedisEnterpriseDatabaseAccessKeysDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_redis_enterprise_database_access_keys", "test")
+	r := RedisEnterpriseDatabaseAccessKeysDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access_keys_authentication_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("primary_access_key").IsNotEmpty(),
+				check.That(data.ResourceName).Key("secondary_access_key").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccRedisEnterpriseDatabaseAccessKeysDataSource_accessKeysDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_redis_enterprise_database_access_keys", "test")
+	r := RedisEnterpriseDatabaseAccessKeysDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access_keys_authentication_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("primary_access_key").IsEmpty(),
+				check.That(data.ResourceName).Key("secondary_access_key").IsEmpty(),
+			),
+		},
+	})
+}
+
+func (r RedisEnterpriseDatabaseAccessKeysDataSource) basic(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_redis_enterprise_database_access_keys" "test" {
+  database_id = azurerm_redis_enterprise_database.test.id
+}
+`, RedisEnterpriseDatabaseResource{}.accessKeysAuthentication(data, enabled))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redisenterprise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RedisEnterpriseDatabaseAccessPolicyAssignmentResource struct{}
+
+var _ sdk.Resource = RedisEnterpriseDatabaseAccessPolicyAssignmentResource{}
+
+type RedisEnterpriseDatabaseAccessPolicyAssignmentResourceModel struct {
+	Name             string `tfschema:"name"`
+	DatabaseId       string `tfschema:"database_id"`
+	AccessPolicyName string `tfschema:"access_policy_name"`
+	ObjectId         string `tfschema:"object_id"`
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RedisEnterpriseDatabaseAccessPolicyAssignmentName,
+		},
+
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: databases.ValidateDatabaseID,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		// NOTE: `default` is currently the only Access Policy available for Redis Enterprise Databases
+		"access_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "default",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) ModelObject() interface{} {
+	return &RedisEnterpriseDatabaseAccessPolicyAssignmentResourceModel{}
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) ResourceType() string {
+	return "azurerm_redis_enterprise_database_access_policy_assignment"
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return databases.ValidateAccessPolicyAssignmentID
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RedisEnterprise.DatabaseAccessClient
+
+			var model RedisEnterpriseDatabaseAccessPolicyAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := databases.ParseDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := databases.NewAccessPolicyAssignmentID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.RedisEnterpriseName, databaseId.DatabaseName, model.Name)
+
+			existing, err := client.AccessPolicyAssignmentGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := databases.AccessPolicyAssignment{
+				Properties: &databases.AccessPolicyAssignmentProperties{
+					AccessPolicyName: model.AccessPolicyName,
+					User: databases.AccessPolicyAssignmentPropertiesUser{
+						ObjectId: pointer.To(model.ObjectId),
+					},
+				},
+			}
+
+			// the Database can only process a single change to its Access Policy Assignments at a time
+			locks.ByID(databaseId.ID())
+			defer locks.UnlockByID(databaseId.ID())
+
+			if err := client.AccessPolicyAssignmentCreateUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RedisEnterprise.DatabaseAccessClient
+
+			id, err := databases.ParseAccessPolicyAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AccessPolicyAssignmentGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := RedisEnterpriseDatabaseAccessPolicyAssignmentResourceModel{
+				Name:       id.AccessPolicyAssignmentName,
+				DatabaseId: databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName, id.DatabaseName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AccessPolicyName = props.AccessPolicyName
+					state.ObjectId = pointer.From(props.User.ObjectId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RedisEnterprise.DatabaseAccessClient
+
+			id, err := databases.ParseAccessPolicyAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName, id.DatabaseName)
+			locks.ByID(databaseId.ID())
+			defer locks.UnlockByID(databaseId.ID())
+
+			if err := client.AccessPolicyAssignmentDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redisenterprise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RedisEnterpriseDatabaseAccessPolicyAssignmentResource struct{}
+
+func TestAccRedisEnterpriseDatabaseAccessPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database_access_policy_assignment", "test")
+	r := RedisEnterpriseDatabaseAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_policy_name").HasValue("default"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRedisEnterpriseDatabaseAccessPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database_access_policy_assignment", "test")
+	r := RedisEnterpriseDatabaseAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRedisEnterpriseDatabaseAccessPolicyAssignment_accessKeysDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database_access_policy_assignment", "test")
+	r := RedisEnterpriseDatabaseAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessKeysDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := databases.ParseAccessPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.RedisEnterprise.DatabaseAccessClient.AccessPolicyAssignmentGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name       = "default"
+  cluster_id = azurerm_redis_enterprise_cluster.test.id
+}
+
+resource "azurerm_redis_enterprise_database_access_policy_assignment" "test" {
+  name        = "acctest%d"
+  database_id = azurerm_redis_enterprise_database.test.id
+  object_id   = data.azurerm_client_config.test.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database_access_policy_assignment" "import" {
+  name        = azurerm_redis_enterprise_database_access_policy_assignment.test.name
+  database_id = azurerm_redis_enterprise_database_access_policy_assignment.test.database_id
+  object_id   = azurerm_redis_enterprise_database_access_policy_assignment.test.object_id
+}
+`, r.basic(data))
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) accessKeysDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name       = "default"
+  cluster_id = azurerm_redis_enterprise_cluster.test.id
+
+  access_keys_authentication_enabled = false
+}
+
+resource "azurerm_redis_enterprise_database_access_policy_assignment" "test" {
+  name               = "acctest%d"
+  database_id        = azurerm_redis_enterprise_database.test.id
+  object_id          = data.azurerm_client_config.test.object_id
+  access_policy_name = "default"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RedisEnterpriseDatabaseAccessPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-redisEnterprise-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test" {
+  name                = "acctest-rec-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Enterprise_E10-2"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	databasesV20250401 "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -79,11 +81,6 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 
 	id := databases.NewDatabaseID(subscriptionId, clusterId.ResourceGroupName, clusterId.RedisEnterpriseName, d.Get("name").(string))
 
-	keysResp, err := client.ListKeys(ctx, id)
-	if err != nil {
-		return fmt.Errorf("listing keys for %s: %+v", id, err)
-	}
-
 	resp, err := client.Get(ctx, id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
@@ -92,6 +89,25 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	accessResp, err := meta.(*clients.Client).RedisEnterprise.DatabaseAccessClient.Get(ctx, databasesV20250401.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName, id.DatabaseName))
+	if err != nil {
+		return fmt.Errorf("retrieving access keys authentication for %s: %+v", id, err)
+	}
+
+	// the access keys can't be listed when access keys authentication is disabled
+	primaryAccessKey, secondaryAccessKey := "", ""
+	if accessKeysAuthenticationEnabled(accessResp.Model) {
+		keysResp, err := client.ListKeys(ctx, id)
+		if err != nil {
+			return fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+
+		if model := keysResp.Model; model != nil {
+			primaryAccessKey = pointer.From(model.PrimaryKey)
+			secondaryAccessKey = pointer.From(model.SecondaryKey)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	d.Set("name", id.DatabaseName)
@@ -108,10 +124,8 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	if model := keysResp.Model; model != nil {
-		d.Set("primary_access_key", model.PrimaryKey)
-		d.Set("secondary_access_key", model.SecondaryKey)
-	}
+	d.Set("primary_access_key", primaryAccessKey)
+	d.Set("secondary_access_key", secondaryAccessKey)
 
 	return nil
 }
@@ -10,11 +10,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-06-01-preview/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	databasesV20250401 "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2025-04-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -64,6 +66,12 @@ func redisEnterpriseDatabaseSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: redisenterprise.ValidateRedisEnterpriseID,
 		},
 
+		"access_keys_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"client_protocol": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -305,6 +313,13 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
+	// `accessKeysAuthentication` isn't available in the 2024-06-01-preview API, and is enabled by default
+	if !d.Get("access_keys_authentication_enabled").(bool) {
+		if err := updateRedisEnterpriseDatabaseAccessKeysAuthentication(ctx, meta, id, false); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceRedisEnterpriseDatabaseRead(d, meta)
 }
@@ -329,10 +344,28 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	keysResp, err := client.ListKeys(ctx, *id)
+	accessResp, err := meta.(*clients.Client).RedisEnterprise.DatabaseAccessClient.Get(ctx, databasesV20250401.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName, id.DatabaseName))
 	if err != nil {
-		return fmt.Errorf("listing keys for %s: %+v", *id, err)
+		return fmt.Errorf("retrieving access keys authentication for %s: %+v", *id, err)
 	}
+	keysEnabled := accessKeysAuthenticationEnabled(accessResp.Model)
+	d.Set("access_keys_authentication_enabled", keysEnabled)
+
+	// the access keys can't be listed when access keys authentication is disabled
+	primaryAccessKey, secondaryAccessKey := "", ""
+	if keysEnabled {
+		keysResp, err := client.ListKeys(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("listing keys for %s: %+v", *id, err)
+		}
+
+		if model := keysResp.Model; model != nil {
+			primaryAccessKey = pointer.From(model.PrimaryKey)
+			secondaryAccessKey = pointer.From(model.SecondaryKey)
+		}
+	}
+	d.Set("primary_access_key", primaryAccessKey)
+	d.Set("secondary_access_key", secondaryAccessKey)
 
 	d.Set("name", id.DatabaseName)
 	clusterId := redisenterprise.NewRedisEnterpriseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName)
@@ -375,11 +408,6 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	if model := keysResp.Model; model != nil {
-		d.Set("primary_access_key", model.PrimaryKey)
-		d.Set("secondary_access_key", model.SecondaryKey)
-	}
-
 	return nil
 }
 
@@ -438,6 +466,12 @@ func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("updatig %s: %+v", id, err)
 	}
 
+	if d.HasChange("access_keys_authentication_enabled") {
+		if err := updateRedisEnterpriseDatabaseAccessKeysAuthentication(ctx, meta, id, d.Get("access_keys_authentication_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceRedisEnterpriseDatabaseRead(d, meta)
 }
@@ -476,6 +510,27 @@ func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta inter
 	return nil
 }
 
+func updateRedisEnterpriseDatabaseAccessKeysAuthentication(ctx context.Context, meta interface{}, id databases.DatabaseId, enabled bool) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseAccessClient
+
+	accessKeysAuthentication := databasesV20250401.AccessKeysAuthenticationDisabled
+	if enabled {
+		accessKeysAuthentication = databasesV20250401.AccessKeysAuthenticationEnabled
+	}
+
+	parameters := databasesV20250401.DatabaseUpdate{
+		Properties: &databasesV20250401.DatabaseProperties{
+			AccessKeysAuthentication: pointer.To(accessKeysAuthentication),
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, databasesV20250401.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName, id.DatabaseName), parameters); err != nil {
+		return fmt.Errorf("updating `access_keys_authentication_enabled` for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func redisEnterpriseDatabaseDeleteRefreshFunc(ctx context.Context, databaseClient *databases.DatabasesClient, clusterClient *redisenterprise.RedisEnterpriseClient, clusterId redisenterprise.RedisEnterpriseId, databaseId databases.DatabaseId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := clusterClient.Get(ctx, clusterId)
@@ -138,6 +138,36 @@ func TestAccRedisEnterpriseDatabase_unlinkDatabase(t *testing.T) {
 	})
 }
 
+func TestAccRedisEnterpriseDatabase_accessKeysAuthentication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisEnterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessKeysAuthentication(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_access_key").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessKeysAuthentication(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_access_key").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessKeysAuthentication(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r RedisEnterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := databases.ParseDatabaseID(state.ID)
 	if err != nil {
@@ -201,6 +231,19 @@ resource "azurerm_redis_enterprise_database" "test" {
 `, template)
 }
 
+func (r RedisEnterpriseDatabaseResource) accessKeysAuthentication(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name       = "default"
+  cluster_id = azurerm_redis_enterprise_cluster.test.id
+
+  access_keys_authentication_enabled = %t
+}
+`, r.template(data), enabled)
+}
+
 func (r RedisEnterpriseDatabaseResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
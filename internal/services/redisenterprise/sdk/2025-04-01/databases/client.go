@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databases
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DatabasesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDatabasesClientWithBaseURI(sdkApi sdkEnv.Api) (*DatabasesClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "databases", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DatabasesClient: %+v", err)
+	}
+
+	return &DatabasesClient{
+		Client: client,
+	}, nil
+}
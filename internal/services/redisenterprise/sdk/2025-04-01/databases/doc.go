@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package databases is an unmodified copy of the `redisenterprise/2025-04-01/databases` package generated into
+// `hashicorp/go-azure-sdk`, which isn't available in the version of the SDK vendored by the provider. It should be
+// removed in favour of the upstream package when the vendored version of `hashicorp/go-azure-sdk` is next updated.
+package databases
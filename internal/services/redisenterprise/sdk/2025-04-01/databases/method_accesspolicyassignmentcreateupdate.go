@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AccessPolicyAssignmentCreateUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *AccessPolicyAssignment
+}
+
+// AccessPolicyAssignmentCreateUpdate ...
+func (c DatabasesClient) AccessPolicyAssignmentCreateUpdate(ctx context.Context, id AccessPolicyAssignmentId, input AccessPolicyAssignment) (result AccessPolicyAssignmentCreateUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// AccessPolicyAssignmentCreateUpdateThenPoll performs AccessPolicyAssignmentCreateUpdate then polls until it's completed
+func (c DatabasesClient) AccessPolicyAssignmentCreateUpdateThenPoll(ctx context.Context, id AccessPolicyAssignmentId, input AccessPolicyAssignment) error {
+	return c.AccessPolicyAssignmentCreateUpdateCallbackThenPoll(ctx, id, input, nil)
+}
+
+// AccessPolicyAssignmentCreateUpdateCallbackThenPoll performs AccessPolicyAssignmentCreateUpdate, runs the optional callback function, then polls until it's completed
+func (c DatabasesClient) AccessPolicyAssignmentCreateUpdateCallbackThenPoll(ctx context.Context, id AccessPolicyAssignmentId, input AccessPolicyAssignment, callback func() error) error {
+	result, err := c.AccessPolicyAssignmentCreateUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing AccessPolicyAssignmentCreateUpdate: %+v", err)
+	}
+
+	if callback != nil {
+		if err := callback(); err != nil {
+			return fmt.Errorf("executing callback function: %+v", err)
+		}
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after AccessPolicyAssignmentCreateUpdate: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DatabasePropertiesGeoReplication struct {
+	GroupNickname   *string           `json:"groupNickname,omitempty"`
+	LinkedDatabases *[]LinkedDatabase `json:"linkedDatabases,omitempty"`
+}
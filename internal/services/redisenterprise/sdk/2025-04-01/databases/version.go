@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2025-04-01"
+
+func userAgent() string {
+	return "hashicorp/go-azure-sdk/databases/2025-04-01"
+}
+
+func AzureAPIVersion() string {
+	return defaultApiVersion
+}
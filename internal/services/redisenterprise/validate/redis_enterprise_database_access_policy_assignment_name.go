@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// RedisEnterpriseDatabaseAccessPolicyAssignmentName - validates that the passed interface contains a valid Redis Enterprise Database Access Policy Assignment Name
+func RedisEnterpriseDatabaseAccessPolicyAssignmentName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q expected type of to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]{1,60}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 60 characters in length and may only contain letters and numbers, got %v", k, v))
+		return
+	}
+
+	return
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedisEnterpriseDatabaseAccessPolicyAssignmentName(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Invalid empty name",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "Invalid name with a hyphen",
+			input:    "my-assignment",
+			expected: false,
+		},
+		{
+			name:     "Invalid name with a space",
+			input:    "my assignment",
+			expected: false,
+		},
+		{
+			name:     "Valid name",
+			input:    "myAssignment01",
+			expected: true,
+		},
+		{
+			name:     "Valid name with 60 characters",
+			input:    strings.Repeat("a", 60),
+			expected: true,
+		},
+		{
+			name:     "Invalid name with 61 characters",
+			input:    strings.Repeat("a", 61),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		_, errors := RedisEnterpriseDatabaseAccessPolicyAssignmentName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/querykeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/sharedprivatelinkresources"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -18,6 +21,10 @@ type Client struct {
 	QueryKeysClient                       *querykeys.QueryKeysClient
 	ServicesClient                        *services.ServicesClient
 	SearchSharedPrivateLinkResourceClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
+
+	authorizerFunc common.ApiAuthorizerFunc
+	configureFunc  func(c client.BaseClient, authorizer auth.Authorizer)
+	environment    environments.Environment
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -50,5 +57,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		QueryKeysClient:                       queryKeysClient,
 		ServicesClient:                        servicesClient,
 		SearchSharedPrivateLinkResourceClient: searchSharedPrivateLinkResourceClient,
+
+		authorizerFunc: o.Authorizers.AuthorizerFunc,
+		configureFunc:  o.Configure,
+		environment:    o.Environment,
 	}, nil
 }
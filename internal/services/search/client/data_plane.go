@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/adminkeys"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/datasources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/skillsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/synonymmaps"
+)
+
+type DataPlaneClient struct {
+	DataSourcesClient *datasources.DataSourcesClient
+	IndexersClient    *indexers.IndexersClient
+	IndexesClient     *indexes.IndexesClient
+	SkillsetsClient   *skillsets.SkillsetsClient
+	SynonymMapsClient *synonymmaps.SynonymMapsClient
+}
+
+// DataPlaneClient returns the clients for the Data Plane API of the specified Search Service.
+//
+// When Local Authentication is enabled for the Search Service the Admin Key is used to authenticate, otherwise
+// an access token for Azure AI Search is obtained using the credentials configured for the Provider.
+//
+// When the Search Service doesn't exist, nil is returned for both the client and the error.
+func (c *Client) DataPlaneClient(ctx context.Context, id services.SearchServiceId) (*DataPlaneClient, error) {
+	resp, err := c.ServicesClient.Get(ctx, id, services.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	localAuthDisabled := false
+	if model := resp.Model; model != nil && model.Properties != nil {
+		localAuthDisabled = pointer.From(model.Properties.DisableLocalAuth)
+	}
+
+	domainSuffix, audience := c.dataPlaneDomainSuffixAndAudience()
+	endpoint := fmt.Sprintf("https://%s.%s", id.SearchServiceName, domainSuffix)
+
+	var authorizer auth.Authorizer
+	apiKey := ""
+	if localAuthDisabled {
+		api := environments.NewApiEndpoint("AzureSearch", audience, nil)
+		authorizer, err = c.authorizerFunc(api)
+		if err != nil {
+			return nil, fmt.Errorf("obtaining auth token for %q: %+v", api.Name(), err)
+		}
+	} else {
+		adminKeysId := adminkeys.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+		keys, err := c.AdminKeysClient.Get(ctx, adminKeysId, adminkeys.DefaultGetOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the Admin Keys for %s: %+v", id, err)
+		}
+		if keys.Model == nil || keys.Model.PrimaryKey == nil {
+			return nil, fmt.Errorf("retrieving the Admin Keys for %s: `primaryKey` was nil", id)
+		}
+		apiKey = *keys.Model.PrimaryKey
+	}
+
+	configure := func(client *searchclient.Client) {
+		c.configureFunc(client, authorizer)
+		if apiKey != "" {
+			client.WithApiKey(apiKey)
+		}
+	}
+
+	dataSourcesClient, err := datasources.NewDataSourcesClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building DataSources client: %+v", err)
+	}
+	configure(dataSourcesClient.Client)
+
+	indexersClient, err := indexers.NewIndexersClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building Indexers client: %+v", err)
+	}
+	configure(indexersClient.Client)
+
+	indexesClient, err := indexes.NewIndexesClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building Indexes client: %+v", err)
+	}
+	configure(indexesClient.Client)
+
+	skillsetsClient, err := skillsets.NewSkillsetsClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building Skillsets client: %+v", err)
+	}
+	configure(skillsetsClient.Client)
+
+	synonymMapsClient, err := synonymmaps.NewSynonymMapsClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building SynonymMaps client: %+v", err)
+	}
+	configure(synonymMapsClient.Client)
+
+	return &DataPlaneClient{
+		DataSourcesClient: dataSourcesClient,
+		IndexersClient:    indexersClient,
+		IndexesClient:     indexesClient,
+		SkillsetsClient:   skillsetsClient,
+		SynonymMapsClient: synonymMapsClient,
+	}, nil
+}
+
+// dataPlaneDomainSuffixAndAudience returns the Domain Suffix used for Search Services and the Audience used to obtain
+// an access token for the Data Plane API, since these aren't currently defined in the Environment.
+func (c *Client) dataPlaneDomainSuffixAndAudience() (string, string) {
+	switch c.environment.Name {
+	case environments.AzureChinaCloud:
+		return "search.azure.cn", "https://search.azure.cn"
+	case environments.AzureUSGovernmentCloud:
+		return "search.azure.us", "https://search.azure.us"
+	}
+
+	return "search.windows.net", "https://search.azure.com"
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+)
+
+const defaultApiVersion = "2024-07-01"
+
+type DataSourcesClient struct {
+	Client *searchclient.Client
+}
+
+func NewDataSourcesClientWithBaseURI(endpoint string) (*DataSourcesClient, error) {
+	client, err := searchclient.NewClient(endpoint, "datasources", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DataSourcesClient: %+v", err)
+	}
+
+	return &DataSourcesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+type DataSourceType string
+
+const (
+	DataSourceTypeAdlsgenTwo DataSourceType = "adlsgen2"
+	DataSourceTypeAzureblob  DataSourceType = "azureblob"
+	DataSourceTypeAzuresql   DataSourceType = "azuresql"
+	DataSourceTypeAzuretable DataSourceType = "azuretable"
+	DataSourceTypeCosmosdb   DataSourceType = "cosmosdb"
+	DataSourceTypeMysql      DataSourceType = "mysql"
+)
+
+func PossibleValuesForDataSourceType() []string {
+	return []string{
+		string(DataSourceTypeAdlsgenTwo),
+		string(DataSourceTypeAzureblob),
+		string(DataSourceTypeAzuresql),
+		string(DataSourceTypeAzuretable),
+		string(DataSourceTypeCosmosdb),
+		string(DataSourceTypeMysql),
+	}
+}
+
+const (
+	OdataTypeHighWaterMarkChangeDetectionPolicy          = "#Microsoft.Azure.Search.HighWaterMarkChangeDetectionPolicy"
+	OdataTypeSoftDeleteColumnDeletionDetectionPolicy     = "#Microsoft.Azure.Search.SoftDeleteColumnDeletionDetectionPolicy"
+	OdataTypeSqlIntegratedChangeTrackingPolicy           = "#Microsoft.Azure.Search.SqlIntegratedChangeTrackingPolicy"
+	OdataTypeNativeBlobSoftDeleteDeletionDetectionPolicy = "#Microsoft.Azure.Search.NativeBlobSoftDeleteDeletionDetectionPolicy"
+)
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+type DataSource struct {
+	Container                   DataContainer                `json:"container"`
+	Credentials                 DataSourceCredentials        `json:"credentials"`
+	DataChangeDetectionPolicy   *DataChangeDetectionPolicy   `json:"dataChangeDetectionPolicy,omitempty"`
+	DataDeletionDetectionPolicy *DataDeletionDetectionPolicy `json:"dataDeletionDetectionPolicy,omitempty"`
+	Description                 *string                      `json:"description,omitempty"`
+	ETag                        *string                      `json:"@odata.etag,omitempty"`
+	Name                        string                       `json:"name"`
+	Type                        DataSourceType               `json:"type"`
+}
+
+type DataContainer struct {
+	Name  string  `json:"name"`
+	Query *string `json:"query,omitempty"`
+}
+
+type DataSourceCredentials struct {
+	// ConnectionString is returned as `<unchanged>` by the API
+	ConnectionString *string `json:"connectionString,omitempty"`
+}
+
+type DataChangeDetectionPolicy struct {
+	HighWaterMarkColumnName *string `json:"highWaterMarkColumnName,omitempty"`
+	OdataType               string  `json:"@odata.type"`
+}
+
+type DataDeletionDetectionPolicy struct {
+	OdataType             string  `json:"@odata.type"`
+	SoftDeleteColumnName  *string `json:"softDeleteColumnName,omitempty"`
+	SoftDeleteMarkerValue *string `json:"softDeleteMarkerValue,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+)
+
+const defaultApiVersion = "2024-07-01"
+
+type IndexersClient struct {
+	Client *searchclient.Client
+}
+
+func NewIndexersClientWithBaseURI(endpoint string) (*IndexersClient, error) {
+	client, err := searchclient.NewClient(endpoint, "indexers", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IndexersClient: %+v", err)
+	}
+
+	return &IndexersClient{
+		Client: client,
+	}, nil
+}
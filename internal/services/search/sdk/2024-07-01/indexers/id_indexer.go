@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&IndexerId{})
+}
+
+var _ resourceids.ResourceId = &IndexerId{}
+
+// IndexerId is a struct representing the Resource ID for a Search Indexer
+//
+// NOTE: Indexers are Data Plane resources, this ID is nested beneath the Search Service so that the Search Service
+// (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type IndexerId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SearchServiceName string
+	IndexerName       string
+}
+
+// NewIndexerID returns a new IndexerId struct
+func NewIndexerID(subscriptionId string, resourceGroupName string, searchServiceName string, indexerName string) IndexerId {
+	return IndexerId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SearchServiceName: searchServiceName,
+		IndexerName:       indexerName,
+	}
+}
+
+// ParseIndexerID parses 'input' into a IndexerId
+func ParseIndexerID(input string) (*IndexerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndexerId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndexerId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseIndexerIDInsensitively parses 'input' case-insensitively into a IndexerId
+// note: this method should only be used for API response data and not user input
+func ParseIndexerIDInsensitively(input string) (*IndexerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndexerId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndexerId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *IndexerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SearchServiceName, ok = input.Parsed["searchServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "searchServiceName", input)
+	}
+
+	if id.IndexerName, ok = input.Parsed["indexerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "indexerName", input)
+	}
+
+	return nil
+}
+
+// ValidateIndexerID checks that 'input' can be parsed as a Indexer ID
+func ValidateIndexerID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseIndexerID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Indexer ID
+func (id IndexerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/indexers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName, id.IndexerName)
+}
+
+// DataPlanePath returns the path of this Indexer within the Data Plane API of the Search Service
+func (id IndexerId) DataPlanePath() string {
+	return fmt.Sprintf("/indexers/%s", id.IndexerName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Indexer ID
+func (id IndexerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSearch", "Microsoft.Search", "Microsoft.Search"),
+		resourceids.StaticSegment("staticSearchServices", "searchServices", "searchServices"),
+		resourceids.UserSpecifiedSegment("searchServiceName", "searchServiceName"),
+		resourceids.StaticSegment("staticIndexers", "indexers", "indexers"),
+		resourceids.UserSpecifiedSegment("indexerName", "indexerName"),
+	}
+}
+
+// String returns a human-readable description of this Indexer ID
+func (id IndexerId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Search Service Name: %q", id.SearchServiceName),
+		fmt.Sprintf("Indexer Name: %q", id.IndexerName),
+	}
+	return fmt.Sprintf("Indexer (%s)", strings.Join(components, "\n"))
+}
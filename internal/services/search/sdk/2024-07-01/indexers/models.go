@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexers
+
+type Indexer struct {
+	DataSourceName      string              `json:"dataSourceName"`
+	Description         *string             `json:"description,omitempty"`
+	Disabled            *bool               `json:"disabled,omitempty"`
+	ETag                *string             `json:"@odata.etag,omitempty"`
+	FieldMappings       *[]FieldMapping     `json:"fieldMappings,omitempty"`
+	Name                string              `json:"name"`
+	OutputFieldMappings *[]FieldMapping     `json:"outputFieldMappings,omitempty"`
+	Parameters          *IndexingParameters `json:"parameters,omitempty"`
+	Schedule            *IndexingSchedule   `json:"schedule,omitempty"`
+	SkillsetName        *string             `json:"skillsetName,omitempty"`
+	TargetIndexName     string              `json:"targetIndexName"`
+}
+
+type IndexingSchedule struct {
+	Interval  string  `json:"interval"`
+	StartTime *string `json:"startTime,omitempty"`
+}
+
+type IndexingParameters struct {
+	BatchSize              *int64                  `json:"batchSize,omitempty"`
+	Configuration          *map[string]interface{} `json:"configuration,omitempty"`
+	MaxFailedItems         *int64                  `json:"maxFailedItems,omitempty"`
+	MaxFailedItemsPerBatch *int64                  `json:"maxFailedItemsPerBatch,omitempty"`
+}
+
+type FieldMapping struct {
+	MappingFunction *FieldMappingFunction `json:"mappingFunction,omitempty"`
+	SourceFieldName string                `json:"sourceFieldName"`
+	TargetFieldName *string               `json:"targetFieldName,omitempty"`
+}
+
+type FieldMappingFunction struct {
+	Name       string                  `json:"name"`
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
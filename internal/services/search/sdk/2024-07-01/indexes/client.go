@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+)
+
+const defaultApiVersion = "2024-07-01"
+
+type IndexesClient struct {
+	Client *searchclient.Client
+}
+
+func NewIndexesClientWithBaseURI(endpoint string) (*IndexesClient, error) {
+	client, err := searchclient.NewClient(endpoint, "indexes", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IndexesClient: %+v", err)
+	}
+
+	return &IndexesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexes
+
+type SearchFieldDataType string
+
+const (
+	SearchFieldDataTypeCollectionEdmBoolean        SearchFieldDataType = "Collection(Edm.Boolean)"
+	SearchFieldDataTypeCollectionEdmDateTimeOffset SearchFieldDataType = "Collection(Edm.DateTimeOffset)"
+	SearchFieldDataTypeCollectionEdmDouble         SearchFieldDataType = "Collection(Edm.Double)"
+	SearchFieldDataTypeCollectionEdmGeographyPoint SearchFieldDataType = "Collection(Edm.GeographyPoint)"
+	SearchFieldDataTypeCollectionEdmHalf           SearchFieldDataType = "Collection(Edm.Half)"
+	SearchFieldDataTypeCollectionEdmInt32          SearchFieldDataType = "Collection(Edm.Int32)"
+	SearchFieldDataTypeCollectionEdmInt64          SearchFieldDataType = "Collection(Edm.Int64)"
+	SearchFieldDataTypeCollectionEdmSingle         SearchFieldDataType = "Collection(Edm.Single)"
+	SearchFieldDataTypeCollectionEdmString         SearchFieldDataType = "Collection(Edm.String)"
+	SearchFieldDataTypeEdmBoolean                  SearchFieldDataType = "Edm.Boolean"
+	SearchFieldDataTypeEdmDateTimeOffset           SearchFieldDataType = "Edm.DateTimeOffset"
+	SearchFieldDataTypeEdmDouble                   SearchFieldDataType = "Edm.Double"
+	SearchFieldDataTypeEdmGeographyPoint           SearchFieldDataType = "Edm.GeographyPoint"
+	SearchFieldDataTypeEdmInt32                    SearchFieldDataType = "Edm.Int32"
+	SearchFieldDataTypeEdmInt64                    SearchFieldDataType = "Edm.Int64"
+	SearchFieldDataTypeEdmString                   SearchFieldDataType = "Edm.String"
+)
+
+func PossibleValuesForSearchFieldDataType() []string {
+	return []string{
+		string(SearchFieldDataTypeCollectionEdmBoolean),
+		string(SearchFieldDataTypeCollectionEdmDateTimeOffset),
+		string(SearchFieldDataTypeCollectionEdmDouble),
+		string(SearchFieldDataTypeCollectionEdmGeographyPoint),
+		string(SearchFieldDataTypeCollectionEdmHalf),
+		string(SearchFieldDataTypeCollectionEdmInt32),
+		string(SearchFieldDataTypeCollectionEdmInt64),
+		string(SearchFieldDataTypeCollectionEdmSingle),
+		string(SearchFieldDataTypeCollectionEdmString),
+		string(SearchFieldDataTypeEdmBoolean),
+		string(SearchFieldDataTypeEdmDateTimeOffset),
+		string(SearchFieldDataTypeEdmDouble),
+		string(SearchFieldDataTypeEdmGeographyPoint),
+		string(SearchFieldDataTypeEdmInt32),
+		string(SearchFieldDataTypeEdmInt64),
+		string(SearchFieldDataTypeEdmString),
+	}
+}
+
+type SuggesterSearchMode string
+
+const (
+	SuggesterSearchModeAnalyzingInfixMatching SuggesterSearchMode = "analyzingInfixMatching"
+)
+
+type VectorSearchAlgorithmKind string
+
+const (
+	VectorSearchAlgorithmKindExhaustiveKnn VectorSearchAlgorithmKind = "exhaustiveKnn"
+	VectorSearchAlgorithmKindHnsw          VectorSearchAlgorithmKind = "hnsw"
+)
+
+func PossibleValuesForVectorSearchAlgorithmKind() []string {
+	return []string{
+		string(VectorSearchAlgorithmKindExhaustiveKnn),
+		string(VectorSearchAlgorithmKindHnsw),
+	}
+}
+
+type VectorSearchAlgorithmMetric string
+
+const (
+	VectorSearchAlgorithmMetricCosine     VectorSearchAlgorithmMetric = "cosine"
+	VectorSearchAlgorithmMetricDotProduct VectorSearchAlgorithmMetric = "dotProduct"
+	VectorSearchAlgorithmMetricEuclidean  VectorSearchAlgorithmMetric = "euclidean"
+	VectorSearchAlgorithmMetricHamming    VectorSearchAlgorithmMetric = "hamming"
+)
+
+func PossibleValuesForVectorSearchAlgorithmMetric() []string {
+	return []string{
+		string(VectorSearchAlgorithmMetricCosine),
+		string(VectorSearchAlgorithmMetricDotProduct),
+		string(VectorSearchAlgorithmMetricEuclidean),
+		string(VectorSearchAlgorithmMetricHamming),
+	}
+}
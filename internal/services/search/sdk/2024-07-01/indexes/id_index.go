@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&IndexId{})
+}
+
+var _ resourceids.ResourceId = &IndexId{}
+
+// IndexId is a struct representing the Resource ID for a Search Index
+//
+// NOTE: Indexs are Data Plane resources, this ID is nested beneath the Search Service so that the Search Service
+// (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type IndexId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SearchServiceName string
+	IndexName         string
+}
+
+// NewIndexID returns a new IndexId struct
+func NewIndexID(subscriptionId string, resourceGroupName string, searchServiceName string, indexName string) IndexId {
+	return IndexId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SearchServiceName: searchServiceName,
+		IndexName:         indexName,
+	}
+}
+
+// ParseIndexID parses 'input' into a IndexId
+func ParseIndexID(input string) (*IndexId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndexId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndexId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseIndexIDInsensitively parses 'input' case-insensitively into a IndexId
+// note: this method should only be used for API response data and not user input
+func ParseIndexIDInsensitively(input string) (*IndexId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IndexId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IndexId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *IndexId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SearchServiceName, ok = input.Parsed["searchServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "searchServiceName", input)
+	}
+
+	if id.IndexName, ok = input.Parsed["indexName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "indexName", input)
+	}
+
+	return nil
+}
+
+// ValidateIndexID checks that 'input' can be parsed as a Index ID
+func ValidateIndexID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseIndexID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Index ID
+func (id IndexId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/indexes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName, id.IndexName)
+}
+
+// DataPlanePath returns the path of this Index within the Data Plane API of the Search Service
+func (id IndexId) DataPlanePath() string {
+	return fmt.Sprintf("/indexes/%s", id.IndexName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Index ID
+func (id IndexId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSearch", "Microsoft.Search", "Microsoft.Search"),
+		resourceids.StaticSegment("staticSearchServices", "searchServices", "searchServices"),
+		resourceids.UserSpecifiedSegment("searchServiceName", "searchServiceName"),
+		resourceids.StaticSegment("staticIndexes", "indexes", "indexes"),
+		resourceids.UserSpecifiedSegment("indexName", "indexName"),
+	}
+}
+
+// String returns a human-readable description of this Index ID
+func (id IndexId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Search Service Name: %q", id.SearchServiceName),
+		fmt.Sprintf("Index Name: %q", id.IndexName),
+	}
+	return fmt.Sprintf("Index (%s)", strings.Join(components, "\n"))
+}
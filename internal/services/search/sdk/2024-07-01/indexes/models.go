@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package indexes
+
+type Index struct {
+	CorsOptions  *CorsOptions      `json:"corsOptions,omitempty"`
+	ETag         *string           `json:"@odata.etag,omitempty"`
+	Fields       []Field           `json:"fields"`
+	Name         string            `json:"name"`
+	Semantic     *SemanticSettings `json:"semantic,omitempty"`
+	Suggesters   *[]Suggester      `json:"suggesters,omitempty"`
+	VectorSearch *VectorSearch     `json:"vectorSearch,omitempty"`
+}
+
+type Field struct {
+	Analyzer            *string   `json:"analyzer,omitempty"`
+	Dimensions          *int64    `json:"dimensions,omitempty"`
+	Facetable           *bool     `json:"facetable,omitempty"`
+	Fields              *[]Field  `json:"fields,omitempty"`
+	Filterable          *bool     `json:"filterable,omitempty"`
+	IndexAnalyzer       *string   `json:"indexAnalyzer,omitempty"`
+	Key                 *bool     `json:"key,omitempty"`
+	Name                string    `json:"name"`
+	Retrievable         *bool     `json:"retrievable,omitempty"`
+	SearchAnalyzer      *string   `json:"searchAnalyzer,omitempty"`
+	Searchable          *bool     `json:"searchable,omitempty"`
+	Sortable            *bool     `json:"sortable,omitempty"`
+	SynonymMaps         *[]string `json:"synonymMaps,omitempty"`
+	Type                string    `json:"type"`
+	VectorSearchProfile *string   `json:"vectorSearchProfile,omitempty"`
+}
+
+type CorsOptions struct {
+	AllowedOrigins  []string `json:"allowedOrigins"`
+	MaxAgeInSeconds *int64   `json:"maxAgeInSeconds,omitempty"`
+}
+
+type Suggester struct {
+	Name         string              `json:"name"`
+	SearchMode   SuggesterSearchMode `json:"searchMode"`
+	SourceFields []string            `json:"sourceFields"`
+}
+
+type SemanticSettings struct {
+	Configurations       *[]SemanticConfiguration `json:"configurations,omitempty"`
+	DefaultConfiguration *string                  `json:"defaultConfiguration,omitempty"`
+}
+
+type SemanticConfiguration struct {
+	Name              string                    `json:"name"`
+	PrioritizedFields SemanticPrioritizedFields `json:"prioritizedFields"`
+}
+
+type SemanticPrioritizedFields struct {
+	PrioritizedContentFields  *[]SemanticField `json:"prioritizedContentFields,omitempty"`
+	PrioritizedKeywordsFields *[]SemanticField `json:"prioritizedKeywordsFields,omitempty"`
+	TitleField                *SemanticField   `json:"titleField,omitempty"`
+}
+
+type SemanticField struct {
+	FieldName string `json:"fieldName"`
+}
+
+type VectorSearch struct {
+	Algorithms *[]VectorSearchAlgorithmConfiguration `json:"algorithms,omitempty"`
+	Profiles   *[]VectorSearchProfile                `json:"profiles,omitempty"`
+}
+
+type VectorSearchAlgorithmConfiguration struct {
+	ExhaustiveKnnParameters *ExhaustiveKnnParameters  `json:"exhaustiveKnnParameters,omitempty"`
+	HnswParameters          *HnswParameters           `json:"hnswParameters,omitempty"`
+	Kind                    VectorSearchAlgorithmKind `json:"kind"`
+	Name                    string                    `json:"name"`
+}
+
+type HnswParameters struct {
+	EfConstruction *int64                       `json:"efConstruction,omitempty"`
+	EfSearch       *int64                       `json:"efSearch,omitempty"`
+	M              *int64                       `json:"m,omitempty"`
+	Metric         *VectorSearchAlgorithmMetric `json:"metric,omitempty"`
+}
+
+type ExhaustiveKnnParameters struct {
+	Metric *VectorSearchAlgorithmMetric `json:"metric,omitempty"`
+}
+
+type VectorSearchProfile struct {
+	Algorithm string `json:"algorithm"`
+	Name      string `json:"name"`
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package searchclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// NOTE: the Azure AI Search Data Plane API isn't yet available in `hashicorp/go-azure-sdk`, this package (and the
+// packages alongside it) mirror the layout of the generated SDK so that they can be swapped out for the upstream
+// packages once they become available.
+
+var _ client.BaseClient = &Client{}
+
+type Client struct {
+	*dataplane.Client
+}
+
+func NewClient(endpoint string, serviceName, apiVersion string) (*Client, error) {
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("parsing the endpoint %q: %+v", endpoint, err)
+	}
+
+	return &Client{
+		Client: dataplane.NewDataPlaneClient(endpoint, fmt.Sprintf("search/%s", serviceName), apiVersion),
+	}, nil
+}
+
+// WithApiKey configures the Client to authenticate using an API Key (e.g. the Admin Key of the Search Service)
+// rather than a bearer token.
+func (c *Client) WithApiKey(apiKey string) {
+	c.Client.Client.AuthorizeRequest = func(_ context.Context, req *http.Request, _ auth.Authorizer) error {
+		req.Header.Set("api-key", apiKey)
+		return nil
+	}
+}
+
+func (c *Client) NewRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, fmt.Errorf("internal-error: the context used must have a deadline attached for polling purposes, but got no deadline")
+	}
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("internal-error: pre-validating request payload: %+v", err)
+	}
+
+	req, err := c.Client.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("building %s request: %+v", input.HttpMethod, err)
+	}
+
+	req.Client = c
+
+	query := url.Values{}
+	query.Set("api-version", c.Client.ApiVersion)
+	if input.OptionsObject != nil {
+		if h := input.OptionsObject.ToHeaders(); h != nil {
+			for k, v := range h.Headers() {
+				req.Header[k] = v
+			}
+		}
+
+		if q := input.OptionsObject.ToQuery(); q != nil {
+			for k, v := range q.Values() {
+				query.Del(k)
+				query.Add(k, v[0])
+			}
+		}
+	}
+
+	req.URL.RawQuery = query.Encode()
+	req.RetryFunc = input.RetryFunc
+	req.ValidStatusCodes = input.ExpectedStatusCodes
+
+	return req, nil
+}
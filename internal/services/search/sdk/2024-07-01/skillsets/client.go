@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skillsets
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+)
+
+const defaultApiVersion = "2024-07-01"
+
+type SkillsetsClient struct {
+	Client *searchclient.Client
+}
+
+func NewSkillsetsClientWithBaseURI(endpoint string) (*SkillsetsClient, error) {
+	client, err := searchclient.NewClient(endpoint, "skillsets", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SkillsetsClient: %+v", err)
+	}
+
+	return &SkillsetsClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skillsets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&SkillsetId{})
+}
+
+var _ resourceids.ResourceId = &SkillsetId{}
+
+// SkillsetId is a struct representing the Resource ID for a Search Skillset
+//
+// NOTE: Skillsets are Data Plane resources, this ID is nested beneath the Search Service so that the Search Service
+// (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type SkillsetId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SearchServiceName string
+	SkillsetName      string
+}
+
+// NewSkillsetID returns a new SkillsetId struct
+func NewSkillsetID(subscriptionId string, resourceGroupName string, searchServiceName string, skillsetName string) SkillsetId {
+	return SkillsetId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SearchServiceName: searchServiceName,
+		SkillsetName:      skillsetName,
+	}
+}
+
+// ParseSkillsetID parses 'input' into a SkillsetId
+func ParseSkillsetID(input string) (*SkillsetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SkillsetId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SkillsetId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseSkillsetIDInsensitively parses 'input' case-insensitively into a SkillsetId
+// note: this method should only be used for API response data and not user input
+func ParseSkillsetIDInsensitively(input string) (*SkillsetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SkillsetId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SkillsetId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *SkillsetId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SearchServiceName, ok = input.Parsed["searchServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "searchServiceName", input)
+	}
+
+	if id.SkillsetName, ok = input.Parsed["skillsetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "skillsetName", input)
+	}
+
+	return nil
+}
+
+// ValidateSkillsetID checks that 'input' can be parsed as a Skillset ID
+func ValidateSkillsetID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSkillsetID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Skillset ID
+func (id SkillsetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/skillsets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName, id.SkillsetName)
+}
+
+// DataPlanePath returns the path of this Skillset within the Data Plane API of the Search Service
+func (id SkillsetId) DataPlanePath() string {
+	return fmt.Sprintf("/skillsets/%s", id.SkillsetName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Skillset ID
+func (id SkillsetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSearch", "Microsoft.Search", "Microsoft.Search"),
+		resourceids.StaticSegment("staticSearchServices", "searchServices", "searchServices"),
+		resourceids.UserSpecifiedSegment("searchServiceName", "searchServiceName"),
+		resourceids.StaticSegment("staticSkillsets", "skillsets", "skillsets"),
+		resourceids.UserSpecifiedSegment("skillsetName", "skillsetName"),
+	}
+}
+
+// String returns a human-readable description of this Skillset ID
+func (id SkillsetId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Search Service Name: %q", id.SearchServiceName),
+		fmt.Sprintf("Skillset Name: %q", id.SkillsetName),
+	}
+	return fmt.Sprintf("Skillset (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skillsets
+
+import "encoding/json"
+
+type Skillset struct {
+	CognitiveServices *CognitiveServicesAccount `json:"cognitiveServices,omitempty"`
+	Description       *string                   `json:"description,omitempty"`
+	ETag              *string                   `json:"@odata.etag,omitempty"`
+	Name              string                    `json:"name"`
+
+	// Skills is a discriminated type with a large number of implementations, as such these are intentionally
+	// exposed as raw JSON rather than being modelled individually.
+	Skills []json.RawMessage `json:"skills"`
+}
+
+type CognitiveServicesAccount struct {
+	Description *string `json:"description,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	OdataType   string  `json:"@odata.type"`
+}
+
+const (
+	OdataTypeCognitiveServicesByKey   = "#Microsoft.Azure.Search.CognitiveServicesByKey"
+	OdataTypeDefaultCognitiveServices = "#Microsoft.Azure.Search.DefaultCognitiveServices"
+)
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synonymmaps
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/searchclient"
+)
+
+const defaultApiVersion = "2024-07-01"
+
+type SynonymMapsClient struct {
+	Client *searchclient.Client
+}
+
+func NewSynonymMapsClientWithBaseURI(endpoint string) (*SynonymMapsClient, error) {
+	client, err := searchclient.NewClient(endpoint, "synonymmaps", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SynonymMapsClient: %+v", err)
+	}
+
+	return &SynonymMapsClient{
+		Client: client,
+	}, nil
+}
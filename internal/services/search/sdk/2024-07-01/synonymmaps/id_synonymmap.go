@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synonymmaps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&SynonymMapId{})
+}
+
+var _ resourceids.ResourceId = &SynonymMapId{}
+
+// SynonymMapId is a struct representing the Resource ID for a Search Synonym Map
+//
+// NOTE: Synonym Maps are Data Plane resources, this ID is nested beneath the Search Service so that the Search Service
+// (and therefore the Data Plane Endpoint and credentials) can be determined from the ID.
+type SynonymMapId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SearchServiceName string
+	SynonymMapName    string
+}
+
+// NewSynonymMapID returns a new SynonymMapId struct
+func NewSynonymMapID(subscriptionId string, resourceGroupName string, searchServiceName string, synonymMapName string) SynonymMapId {
+	return SynonymMapId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SearchServiceName: searchServiceName,
+		SynonymMapName:    synonymMapName,
+	}
+}
+
+// ParseSynonymMapID parses 'input' into a SynonymMapId
+func ParseSynonymMapID(input string) (*SynonymMapId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SynonymMapId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SynonymMapId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseSynonymMapIDInsensitively parses 'input' case-insensitively into a SynonymMapId
+// note: this method should only be used for API response data and not user input
+func ParseSynonymMapIDInsensitively(input string) (*SynonymMapId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SynonymMapId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SynonymMapId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *SynonymMapId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SearchServiceName, ok = input.Parsed["searchServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "searchServiceName", input)
+	}
+
+	if id.SynonymMapName, ok = input.Parsed["synonymMapName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "synonymMapName", input)
+	}
+
+	return nil
+}
+
+// ValidateSynonymMapID checks that 'input' can be parsed as a Synonym Map ID
+func ValidateSynonymMapID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSynonymMapID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Synonym Map ID
+func (id SynonymMapId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/synonymMaps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName, id.SynonymMapName)
+}
+
+// DataPlanePath returns the path of this Synonym Map within the Data Plane API of the Search Service
+func (id SynonymMapId) DataPlanePath() string {
+	return fmt.Sprintf("/synonymmaps/%s", id.SynonymMapName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Synonym Map ID
+func (id SynonymMapId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSearch", "Microsoft.Search", "Microsoft.Search"),
+		resourceids.StaticSegment("staticSearchServices", "searchServices", "searchServices"),
+		resourceids.UserSpecifiedSegment("searchServiceName", "searchServiceName"),
+		resourceids.StaticSegment("staticSynonymMaps", "synonymMaps", "synonymMaps"),
+		resourceids.UserSpecifiedSegment("synonymMapName", "synonymMapName"),
+	}
+}
+
+// String returns a human-readable description of this Synonym Map ID
+func (id SynonymMapId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Search Service Name: %q", id.SearchServiceName),
+		fmt.Sprintf("Synonym Map Name: %q", id.SynonymMapName),
+	}
+	return fmt.Sprintf("Synonym Map (%s)", strings.Join(components, "\n"))
+}
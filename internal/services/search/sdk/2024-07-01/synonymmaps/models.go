@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synonymmaps
+
+type SynonymMap struct {
+	ETag   *string          `json:"@odata.etag,omitempty"`
+	Format SynonymMapFormat `json:"format"`
+	Name   string           `json:"name"`
+
+	// Synonyms contains the rules in the Solr format, separated by new lines
+	Synonyms string `json:"synonyms"`
+}
+
+type SynonymMapFormat string
+
+const (
+	SynonymMapFormatSolr SynonymMapFormat = "solr"
+)
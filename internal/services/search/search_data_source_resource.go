@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/datasources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchDataSourceResource struct{}
+
+var (
+	_ sdk.Resource           = SearchDataSourceResource{}
+	_ sdk.ResourceWithUpdate = SearchDataSourceResource{}
+)
+
+type SearchDataSourceModel struct {
+	Name                      string                             `tfschema:"name"`
+	SearchServiceId           string                             `tfschema:"search_service_id"`
+	Type                      string                             `tfschema:"type"`
+	ConnectionString          string                             `tfschema:"connection_string"`
+	Container                 []SearchDataSourceContainerModel   `tfschema:"container"`
+	Description               string                             `tfschema:"description"`
+	HighWaterMarkChangePolicy []SearchDataSourceHighWaterMark    `tfschema:"high_water_mark_change_detection_policy"`
+	SoftDeleteDeletionPolicy  []SearchDataSourceSoftDeleteColumn `tfschema:"soft_delete_column_deletion_detection_policy"`
+}
+
+type SearchDataSourceContainerModel struct {
+	Name  string `tfschema:"name"`
+	Query string `tfschema:"query"`
+}
+
+type SearchDataSourceHighWaterMark struct {
+	ColumnName string `tfschema:"column_name"`
+}
+
+type SearchDataSourceSoftDeleteColumn struct {
+	ColumnName  string `tfschema:"column_name"`
+	MarkerValue string `tfschema:"marker_value"`
+}
+
+func (r SearchDataSourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(datasources.PossibleValuesForDataSourceType(), false),
+		},
+
+		"connection_string": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"high_water_mark_change_detection_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"column_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"soft_delete_column_deletion_detection_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"column_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"marker_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchDataSourceResource) ResourceType() string {
+	return "azurerm_search_data_source"
+}
+
+func (r SearchDataSourceResource) ModelObject() interface{} {
+	return &SearchDataSourceModel{}
+}
+
+func (r SearchDataSourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return datasources.ValidateDataSourceID
+}
+
+func (r SearchDataSourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", *searchServiceId)
+			}
+			client := dataPlaneClient.DataSourcesClient
+
+			id := datasources.NewDataSourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandSearchDataSource(id.DataSourceName, model), datasources.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchDataSourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := datasources.ParseDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := dataPlaneClient.DataSourcesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchDataSourceModel{
+				Name:            id.DataSourceName,
+				SearchServiceId: searchServiceId.ID(),
+				// the API returns `<unchanged>` rather than the Connection String, so this is sourced from the config
+				ConnectionString: metadata.ResourceData.Get("connection_string").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Type = string(model.Type)
+				state.Description = pointer.From(model.Description)
+				state.Container = []SearchDataSourceContainerModel{
+					{
+						Name:  model.Container.Name,
+						Query: pointer.From(model.Container.Query),
+					},
+				}
+
+				if policy := model.DataChangeDetectionPolicy; policy != nil && policy.OdataType == datasources.OdataTypeHighWaterMarkChangeDetectionPolicy {
+					state.HighWaterMarkChangePolicy = []SearchDataSourceHighWaterMark{
+						{
+							ColumnName: pointer.From(policy.HighWaterMarkColumnName),
+						},
+					}
+				}
+
+				if policy := model.DataDeletionDetectionPolicy; policy != nil && policy.OdataType == datasources.OdataTypeSoftDeleteColumnDeletionDetectionPolicy {
+					state.SoftDeleteDeletionPolicy = []SearchDataSourceSoftDeleteColumn{
+						{
+							ColumnName:  pointer.From(policy.SoftDeleteColumnName),
+							MarkerValue: pointer.From(policy.SoftDeleteMarkerValue),
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SearchDataSourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := datasources.ParseDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", searchServiceId)
+			}
+
+			if _, err := dataPlaneClient.DataSourcesClient.CreateOrUpdate(ctx, *id, expandSearchDataSource(id.DataSourceName, model), datasources.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchDataSourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := datasources.ParseDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				// the Search Service has been deleted, and this along with it
+				return nil
+			}
+
+			if resp, err := dataPlaneClient.DataSourcesClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandSearchDataSource(name string, model SearchDataSourceModel) datasources.DataSource {
+	output := datasources.DataSource{
+		Name: name,
+		Type: datasources.DataSourceType(model.Type),
+		Credentials: datasources.DataSourceCredentials{
+			ConnectionString: pointer.To(model.ConnectionString),
+		},
+	}
+
+	if model.Description != "" {
+		output.Description = pointer.To(model.Description)
+	}
+
+	if len(model.Container) > 0 {
+		output.Container = datasources.DataContainer{
+			Name: model.Container[0].Name,
+		}
+		if model.Container[0].Query != "" {
+			output.Container.Query = pointer.To(model.Container[0].Query)
+		}
+	}
+
+	if len(model.HighWaterMarkChangePolicy) > 0 {
+		output.DataChangeDetectionPolicy = &datasources.DataChangeDetectionPolicy{
+			OdataType:               datasources.OdataTypeHighWaterMarkChangeDetectionPolicy,
+			HighWaterMarkColumnName: pointer.To(model.HighWaterMarkChangePolicy[0].ColumnName),
+		}
+	}
+
+	if len(model.SoftDeleteDeletionPolicy) > 0 {
+		output.DataDeletionDetectionPolicy = &datasources.DataDeletionDetectionPolicy{
+			OdataType:             datasources.OdataTypeSoftDeleteColumnDeletionDetectionPolicy,
+			SoftDeleteColumnName:  pointer.To(model.SoftDeleteDeletionPolicy[0].ColumnName),
+			SoftDeleteMarkerValue: pointer.To(model.SoftDeleteDeletionPolicy[0].MarkerValue),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/datasources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchDataSourceResource struct{}
+
+func TestAccSearchDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccSearchDataSource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchDataSource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func (r SearchDataSourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datasources.ParseDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+	if dataPlaneClient == nil {
+		return pointer.To(false), nil
+	}
+
+	resp, err := dataPlaneClient.DataSourcesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+
+  container {
+    name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "import" {
+  name              = azurerm_search_data_source.test.name
+  search_service_id = azurerm_search_data_source.test.search_service_id
+  type              = azurerm_search_data_source.test.type
+  connection_string = azurerm_search_data_source.test.connection_string
+
+  container {
+    name = azurerm_storage_container.test.name
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchDataSourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  description       = "Hotel documents"
+
+  container {
+    name  = azurerm_storage_container.test.name
+    query = "hotels"
+  }
+
+  high_water_mark_change_detection_policy {
+    column_name = "metadata_storage_last_modified"
+  }
+
+  soft_delete_column_deletion_detection_policy {
+    column_name  = "IsDeleted"
+    marker_value = "true"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SearchDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "documents"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomString)
+}
@@ -0,0 +1,887 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexResource struct{}
+
+var (
+	_ sdk.Resource                  = SearchIndexResource{}
+	_ sdk.ResourceWithUpdate        = SearchIndexResource{}
+	_ sdk.ResourceWithCustomizeDiff = SearchIndexResource{}
+)
+
+type SearchIndexModel struct {
+	Name                             string                             `tfschema:"name"`
+	SearchServiceId                  string                             `tfschema:"search_service_id"`
+	Field                            []SearchIndexFieldModel            `tfschema:"field"`
+	Cors                             []SearchIndexCorsModel             `tfschema:"cors"`
+	DefaultSemanticConfigurationName string                             `tfschema:"default_semantic_configuration_name"`
+	SemanticConfiguration            []SearchIndexSemanticConfiguration `tfschema:"semantic_configuration"`
+	Suggester                        []SearchIndexSuggesterModel        `tfschema:"suggester"`
+	VectorSearchAlgorithm            []SearchIndexVectorSearchAlgorithm `tfschema:"vector_search_algorithm"`
+	VectorSearchProfile              []SearchIndexVectorSearchProfile   `tfschema:"vector_search_profile"`
+}
+
+type SearchIndexFieldModel struct {
+	Name                    string   `tfschema:"name"`
+	Type                    string   `tfschema:"type"`
+	Key                     bool     `tfschema:"key"`
+	Searchable              bool     `tfschema:"searchable"`
+	Filterable              bool     `tfschema:"filterable"`
+	Sortable                bool     `tfschema:"sortable"`
+	Facetable               bool     `tfschema:"facetable"`
+	Retrievable             bool     `tfschema:"retrievable"`
+	Analyzer                string   `tfschema:"analyzer"`
+	SearchAnalyzer          string   `tfschema:"search_analyzer"`
+	IndexAnalyzer           string   `tfschema:"index_analyzer"`
+	SynonymMapNames         []string `tfschema:"synonym_map_names"`
+	Dimensions              int64    `tfschema:"dimensions"`
+	VectorSearchProfileName string   `tfschema:"vector_search_profile_name"`
+}
+
+type SearchIndexCorsModel struct {
+	AllowedOrigins  []string `tfschema:"allowed_origins"`
+	MaxAgeInSeconds int64    `tfschema:"max_age_in_seconds"`
+}
+
+type SearchIndexSemanticConfiguration struct {
+	Name              string   `tfschema:"name"`
+	TitleFieldName    string   `tfschema:"title_field_name"`
+	ContentFieldNames []string `tfschema:"content_field_names"`
+	KeywordFieldNames []string `tfschema:"keyword_field_names"`
+}
+
+type SearchIndexSuggesterModel struct {
+	Name             string   `tfschema:"name"`
+	SourceFieldNames []string `tfschema:"source_field_names"`
+}
+
+type SearchIndexVectorSearchAlgorithm struct {
+	Name           string `tfschema:"name"`
+	Kind           string `tfschema:"kind"`
+	Metric         string `tfschema:"metric"`
+	M              int64  `tfschema:"m"`
+	EfConstruction int64  `tfschema:"ef_construction"`
+	EfSearch       int64  `tfschema:"ef_search"`
+}
+
+type SearchIndexVectorSearchProfile struct {
+	Name          string `tfschema:"name"`
+	AlgorithmName string `tfschema:"algorithm_name"`
+}
+
+// searchIndexFieldPropertiesRequiringRebuild are the properties of an existing field which can't be changed
+// without dropping and rebuilding the index.
+var searchIndexFieldPropertiesRequiringRebuild = []string{
+	"type",
+	"key",
+	"searchable",
+	"filterable",
+	"sortable",
+	"facetable",
+	"analyzer",
+	"index_analyzer",
+	"dimensions",
+	"vector_search_profile_name",
+}
+
+func (r SearchIndexResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"field": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(indexes.PossibleValuesForSearchFieldDataType(), false),
+					},
+
+					"key": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"searchable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"filterable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"sortable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"facetable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retrievable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"search_analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"index_analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"synonym_map_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validate.DataPlaneObjectName,
+						},
+					},
+
+					"dimensions": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(2, 3072),
+					},
+
+					"vector_search_profile_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"cors": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allowed_origins": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"max_age_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      300,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"default_semantic_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"semantic_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"title_field_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"content_field_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"keyword_field_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"suggester": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source_field_names": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"vector_search_algorithm": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"kind": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(indexes.PossibleValuesForVectorSearchAlgorithmKind(), false),
+					},
+
+					"metric": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(indexes.VectorSearchAlgorithmMetricCosine),
+						ValidateFunc: validation.StringInSlice(indexes.PossibleValuesForVectorSearchAlgorithmMetric(), false),
+					},
+
+					"m": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(4, 10),
+					},
+
+					"ef_construction": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(100, 1000),
+					},
+
+					"ef_search": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(100, 1000),
+					},
+				},
+			},
+		},
+
+		"vector_search_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"algorithm_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SearchIndexResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchIndexResource) ResourceType() string {
+	return "azurerm_search_index"
+}
+
+func (r SearchIndexResource) ModelObject() interface{} {
+	return &SearchIndexModel{}
+}
+
+func (r SearchIndexResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return indexes.ValidateIndexID
+}
+
+func (r SearchIndexResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", *searchServiceId)
+			}
+			client := dataPlaneClient.IndexesClient
+
+			id := indexes.NewIndexID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandSearchIndex(id.IndexName, model), indexes.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchIndexResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexes.ParseIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := dataPlaneClient.IndexesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchIndexModel{
+				Name:            id.IndexName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Field = flattenSearchIndexFields(model.Fields)
+				state.Cors = flattenSearchIndexCors(model.CorsOptions)
+				state.Suggester = flattenSearchIndexSuggesters(model.Suggesters)
+
+				if semantic := model.Semantic; semantic != nil {
+					state.DefaultSemanticConfigurationName = pointer.From(semantic.DefaultConfiguration)
+					state.SemanticConfiguration = flattenSearchIndexSemanticConfigurations(semantic.Configurations)
+				}
+
+				if vectorSearch := model.VectorSearch; vectorSearch != nil {
+					state.VectorSearchAlgorithm = flattenSearchIndexVectorSearchAlgorithms(vectorSearch.Algorithms)
+					state.VectorSearchProfile = flattenSearchIndexVectorSearchProfiles(vectorSearch.Profiles)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SearchIndexResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexes.ParseIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", searchServiceId)
+			}
+
+			if _, err := dataPlaneClient.IndexesClient.CreateOrUpdate(ctx, *id, expandSearchIndex(id.IndexName, model), indexes.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchIndexResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexes.ParseIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				// the Search Service has been deleted, and this along with it
+				return nil
+			}
+
+			if resp, err := dataPlaneClient.IndexesClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchIndexResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" {
+				return nil
+			}
+
+			// new fields can be added to an existing index and a subset of properties can be updated in-place,
+			// however removing a field or changing how an existing field is indexed requires the index to be rebuilt
+			if rd.HasChange("field") {
+				oldRaw, newRaw := rd.GetChange("field")
+				if searchIndexFieldsRequireRebuild(oldRaw.([]interface{}), newRaw.([]interface{})) {
+					if err := rd.ForceNew("field"); err != nil {
+						return err
+					}
+				}
+			}
+
+			// suggesters can only be defined on fields when they're added to the index
+			if rd.HasChange("suggester") {
+				if err := rd.ForceNew("suggester"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func searchIndexFieldsRequireRebuild(oldFields []interface{}, newFields []interface{}) bool {
+	newFieldsByName := make(map[string]map[string]interface{})
+	for _, raw := range newFields {
+		if v, ok := raw.(map[string]interface{}); ok {
+			newFieldsByName[v["name"].(string)] = v
+		}
+	}
+
+	for _, raw := range oldFields {
+		oldField, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		newField, ok := newFieldsByName[oldField["name"].(string)]
+		if !ok {
+			return true
+		}
+
+		for _, property := range searchIndexFieldPropertiesRequiringRebuild {
+			if !reflect.DeepEqual(oldField[property], newField[property]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func expandSearchIndex(name string, model SearchIndexModel) indexes.Index {
+	output := indexes.Index{
+		Name:       name,
+		Fields:     expandSearchIndexFields(model.Field),
+		Suggesters: expandSearchIndexSuggesters(model.Suggester),
+	}
+
+	if len(model.Cors) > 0 {
+		output.CorsOptions = &indexes.CorsOptions{
+			AllowedOrigins:  model.Cors[0].AllowedOrigins,
+			MaxAgeInSeconds: pointer.To(model.Cors[0].MaxAgeInSeconds),
+		}
+	}
+
+	if len(model.SemanticConfiguration) > 0 || model.DefaultSemanticConfigurationName != "" {
+		output.Semantic = &indexes.SemanticSettings{
+			Configurations: expandSearchIndexSemanticConfigurations(model.SemanticConfiguration),
+		}
+		if model.DefaultSemanticConfigurationName != "" {
+			output.Semantic.DefaultConfiguration = pointer.To(model.DefaultSemanticConfigurationName)
+		}
+	}
+
+	if len(model.VectorSearchAlgorithm) > 0 || len(model.VectorSearchProfile) > 0 {
+		output.VectorSearch = &indexes.VectorSearch{
+			Algorithms: expandSearchIndexVectorSearchAlgorithms(model.VectorSearchAlgorithm),
+			Profiles:   expandSearchIndexVectorSearchProfiles(model.VectorSearchProfile),
+		}
+	}
+
+	return output
+}
+
+func expandSearchIndexFields(input []SearchIndexFieldModel) []indexes.Field {
+	output := make([]indexes.Field, 0)
+	for _, v := range input {
+		field := indexes.Field{
+			Name:        v.Name,
+			Type:        v.Type,
+			Key:         pointer.To(v.Key),
+			Searchable:  pointer.To(v.Searchable),
+			Filterable:  pointer.To(v.Filterable),
+			Sortable:    pointer.To(v.Sortable),
+			Facetable:   pointer.To(v.Facetable),
+			Retrievable: pointer.To(v.Retrievable),
+			SynonymMaps: pointer.To(v.SynonymMapNames),
+		}
+		if v.Analyzer != "" {
+			field.Analyzer = pointer.To(v.Analyzer)
+		}
+		if v.SearchAnalyzer != "" {
+			field.SearchAnalyzer = pointer.To(v.SearchAnalyzer)
+		}
+		if v.IndexAnalyzer != "" {
+			field.IndexAnalyzer = pointer.To(v.IndexAnalyzer)
+		}
+		if v.Dimensions != 0 {
+			field.Dimensions = pointer.To(v.Dimensions)
+		}
+		if v.VectorSearchProfileName != "" {
+			field.VectorSearchProfile = pointer.To(v.VectorSearchProfileName)
+		}
+		output = append(output, field)
+	}
+	return output
+}
+
+func flattenSearchIndexFields(input []indexes.Field) []SearchIndexFieldModel {
+	output := make([]SearchIndexFieldModel, 0)
+	for _, v := range input {
+		output = append(output, SearchIndexFieldModel{
+			Name:                    v.Name,
+			Type:                    v.Type,
+			Key:                     pointer.From(v.Key),
+			Searchable:              pointer.From(v.Searchable),
+			Filterable:              pointer.From(v.Filterable),
+			Sortable:                pointer.From(v.Sortable),
+			Facetable:               pointer.From(v.Facetable),
+			Retrievable:             pointer.From(v.Retrievable),
+			Analyzer:                pointer.From(v.Analyzer),
+			SearchAnalyzer:          pointer.From(v.SearchAnalyzer),
+			IndexAnalyzer:           pointer.From(v.IndexAnalyzer),
+			SynonymMapNames:         pointer.From(v.SynonymMaps),
+			Dimensions:              pointer.From(v.Dimensions),
+			VectorSearchProfileName: pointer.From(v.VectorSearchProfile),
+		})
+	}
+	return output
+}
+
+func flattenSearchIndexCors(input *indexes.CorsOptions) []SearchIndexCorsModel {
+	if input == nil {
+		return []SearchIndexCorsModel{}
+	}
+
+	return []SearchIndexCorsModel{
+		{
+			AllowedOrigins:  input.AllowedOrigins,
+			MaxAgeInSeconds: pointer.From(input.MaxAgeInSeconds),
+		},
+	}
+}
+
+func expandSearchIndexSuggesters(input []SearchIndexSuggesterModel) *[]indexes.Suggester {
+	output := make([]indexes.Suggester, 0)
+	for _, v := range input {
+		output = append(output, indexes.Suggester{
+			Name:         v.Name,
+			SearchMode:   indexes.SuggesterSearchModeAnalyzingInfixMatching,
+			SourceFields: v.SourceFieldNames,
+		})
+	}
+	return &output
+}
+
+func flattenSearchIndexSuggesters(input *[]indexes.Suggester) []SearchIndexSuggesterModel {
+	output := make([]SearchIndexSuggesterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, SearchIndexSuggesterModel{
+			Name:             v.Name,
+			SourceFieldNames: v.SourceFields,
+		})
+	}
+	return output
+}
+
+func expandSearchIndexSemanticConfigurations(input []SearchIndexSemanticConfiguration) *[]indexes.SemanticConfiguration {
+	output := make([]indexes.SemanticConfiguration, 0)
+	for _, v := range input {
+		configuration := indexes.SemanticConfiguration{
+			Name: v.Name,
+			PrioritizedFields: indexes.SemanticPrioritizedFields{
+				PrioritizedContentFields:  expandSearchIndexSemanticFields(v.ContentFieldNames),
+				PrioritizedKeywordsFields: expandSearchIndexSemanticFields(v.KeywordFieldNames),
+			},
+		}
+		if v.TitleFieldName != "" {
+			configuration.PrioritizedFields.TitleField = &indexes.SemanticField{
+				FieldName: v.TitleFieldName,
+			}
+		}
+		output = append(output, configuration)
+	}
+	return &output
+}
+
+func flattenSearchIndexSemanticConfigurations(input *[]indexes.SemanticConfiguration) []SearchIndexSemanticConfiguration {
+	output := make([]SearchIndexSemanticConfiguration, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		configuration := SearchIndexSemanticConfiguration{
+			Name:              v.Name,
+			ContentFieldNames: flattenSearchIndexSemanticFields(v.PrioritizedFields.PrioritizedContentFields),
+			KeywordFieldNames: flattenSearchIndexSemanticFields(v.PrioritizedFields.PrioritizedKeywordsFields),
+		}
+		if v.PrioritizedFields.TitleField != nil {
+			configuration.TitleFieldName = v.PrioritizedFields.TitleField.FieldName
+		}
+		output = append(output, configuration)
+	}
+	return output
+}
+
+func expandSearchIndexSemanticFields(input []string) *[]indexes.SemanticField {
+	output := make([]indexes.SemanticField, 0)
+	for _, v := range input {
+		output = append(output, indexes.SemanticField{
+			FieldName: v,
+		})
+	}
+	return &output
+}
+
+func flattenSearchIndexSemanticFields(input *[]indexes.SemanticField) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, v.FieldName)
+	}
+	return output
+}
+
+func expandSearchIndexVectorSearchAlgorithms(input []SearchIndexVectorSearchAlgorithm) *[]indexes.VectorSearchAlgorithmConfiguration {
+	output := make([]indexes.VectorSearchAlgorithmConfiguration, 0)
+	for _, v := range input {
+		algorithm := indexes.VectorSearchAlgorithmConfiguration{
+			Name: v.Name,
+			Kind: indexes.VectorSearchAlgorithmKind(v.Kind),
+		}
+
+		metric := pointer.To(indexes.VectorSearchAlgorithmMetric(v.Metric))
+		if algorithm.Kind == indexes.VectorSearchAlgorithmKindHnsw {
+			algorithm.HnswParameters = &indexes.HnswParameters{
+				Metric: metric,
+			}
+			if v.M != 0 {
+				algorithm.HnswParameters.M = pointer.To(v.M)
+			}
+			if v.EfConstruction != 0 {
+				algorithm.HnswParameters.EfConstruction = pointer.To(v.EfConstruction)
+			}
+			if v.EfSearch != 0 {
+				algorithm.HnswParameters.EfSearch = pointer.To(v.EfSearch)
+			}
+		} else {
+			algorithm.ExhaustiveKnnParameters = &indexes.ExhaustiveKnnParameters{
+				Metric: metric,
+			}
+		}
+
+		output = append(output, algorithm)
+	}
+	return &output
+}
+
+func flattenSearchIndexVectorSearchAlgorithms(input *[]indexes.VectorSearchAlgorithmConfiguration) []SearchIndexVectorSearchAlgorithm {
+	output := make([]SearchIndexVectorSearchAlgorithm, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		algorithm := SearchIndexVectorSearchAlgorithm{
+			Name: v.Name,
+			Kind: string(v.Kind),
+		}
+		if params := v.HnswParameters; params != nil {
+			algorithm.Metric = string(pointer.From(params.Metric))
+			algorithm.M = pointer.From(params.M)
+			algorithm.EfConstruction = pointer.From(params.EfConstruction)
+			algorithm.EfSearch = pointer.From(params.EfSearch)
+		}
+		if params := v.ExhaustiveKnnParameters; params != nil {
+			algorithm.Metric = string(pointer.From(params.Metric))
+		}
+		output = append(output, algorithm)
+	}
+	return output
+}
+
+func expandSearchIndexVectorSearchProfiles(input []SearchIndexVectorSearchProfile) *[]indexes.VectorSearchProfile {
+	output := make([]indexes.VectorSearchProfile, 0)
+	for _, v := range input {
+		output = append(output, indexes.VectorSearchProfile{
+			Name:      v.Name,
+			Algorithm: v.AlgorithmName,
+		})
+	}
+	return &output
+}
+
+func flattenSearchIndexVectorSearchProfiles(input *[]indexes.VectorSearchProfile) []SearchIndexVectorSearchProfile {
+	output := make([]SearchIndexVectorSearchProfile, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, SearchIndexVectorSearchProfile{
+			Name:          v.Name,
+			AlgorithmName: v.Algorithm,
+		})
+	}
+	return output
+}
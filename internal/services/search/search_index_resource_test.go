@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexResource struct{}
+
+func TestAccSearchIndex_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndex_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// adding a field is an in-place update
+			Config: r.addField(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// changing how an existing field is indexed requires the index to be rebuilt
+			Config: r.rebuildField(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.1.filterable").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchIndexResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := indexes.ParseIndexID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+	if dataPlaneClient == nil {
+		return pointer.To(false), nil
+	}
+
+	resp, err := dataPlaneClient.IndexesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchIndexResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name       = "id"
+    type       = "Edm.String"
+    key        = true
+    filterable = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "import" {
+  name              = azurerm_search_index.test.name
+  search_service_id = azurerm_search_index.test.search_service_id
+
+  field {
+    name       = "id"
+    type       = "Edm.String"
+    key        = true
+    filterable = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexResource) addField(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name       = "id"
+    type       = "Edm.String"
+    key        = true
+    filterable = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+
+  field {
+    name     = "rating"
+    type     = "Edm.Double"
+    sortable = true
+  }
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) rebuildField(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name       = "id"
+    type       = "Edm.String"
+    key        = true
+    filterable = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+    filterable = true
+  }
+
+  field {
+    name     = "rating"
+    type     = "Edm.Double"
+    sortable = true
+  }
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "test" {
+  name              = "acctestsynonyms-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+  synonyms          = ["hotel, motel", "inn => hotel"]
+}
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name       = "id"
+    type       = "Edm.String"
+    key        = true
+    filterable = true
+  }
+
+  field {
+    name              = "title"
+    type              = "Edm.String"
+    searchable        = true
+    analyzer          = "en.microsoft"
+    synonym_map_names = [azurerm_search_synonym_map.test.name]
+  }
+
+  field {
+    name       = "tags"
+    type       = "Collection(Edm.String)"
+    searchable = true
+    filterable = true
+    facetable  = true
+  }
+
+  field {
+    name                       = "titleVector"
+    type                       = "Collection(Edm.Single)"
+    searchable                 = true
+    retrievable                = false
+    dimensions                 = 1536
+    vector_search_profile_name = "default"
+  }
+
+  cors {
+    allowed_origins    = ["https://www.contoso.com"]
+    max_age_in_seconds = 60
+  }
+
+  suggester {
+    name               = "sg"
+    source_field_names = ["title"]
+  }
+
+  default_semantic_configuration_name = "default"
+
+  semantic_configuration {
+    name                = "default"
+    title_field_name    = "title"
+    keyword_field_names = ["tags"]
+  }
+
+  vector_search_algorithm {
+    name            = "hnsw"
+    kind            = "hnsw"
+    metric          = "cosine"
+    m               = 4
+    ef_construction = 400
+    ef_search       = 500
+  }
+
+  vector_search_profile {
+    name           = "default"
+    algorithm_name = "hnsw"
+  }
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+// SearchDataPlaneTemplate provides the Search Service used by the tests for the Data Plane resources
+type SearchDataPlaneTemplate struct{}
+
+func (SearchDataPlaneTemplate) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "basic"
+  semantic_search_sku = "free"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexerResource struct{}
+
+var (
+	_ sdk.Resource           = SearchIndexerResource{}
+	_ sdk.ResourceWithUpdate = SearchIndexerResource{}
+)
+
+type SearchIndexerModel struct {
+	Name               string                           `tfschema:"name"`
+	SearchServiceId    string                           `tfschema:"search_service_id"`
+	DataSourceName     string                           `tfschema:"data_source_name"`
+	TargetIndexName    string                           `tfschema:"target_index_name"`
+	SkillsetName       string                           `tfschema:"skillset_name"`
+	Description        string                           `tfschema:"description"`
+	Enabled            bool                             `tfschema:"enabled"`
+	Schedule           []SearchIndexerScheduleModel     `tfschema:"schedule"`
+	Parameters         []SearchIndexerParametersModel   `tfschema:"parameters"`
+	FieldMapping       []SearchIndexerFieldMappingModel `tfschema:"field_mapping"`
+	OutputFieldMapping []SearchIndexerFieldMappingModel `tfschema:"output_field_mapping"`
+}
+
+type SearchIndexerScheduleModel struct {
+	Interval  string `tfschema:"interval"`
+	StartTime string `tfschema:"start_time"`
+}
+
+type SearchIndexerParametersModel struct {
+	BatchSize              int64             `tfschema:"batch_size"`
+	MaxFailedItems         int64             `tfschema:"max_failed_items"`
+	MaxFailedItemsPerBatch int64             `tfschema:"max_failed_items_per_batch"`
+	Configuration          map[string]string `tfschema:"configuration"`
+}
+
+type SearchIndexerFieldMappingModel struct {
+	SourceFieldName           string            `tfschema:"source_field_name"`
+	TargetFieldName           string            `tfschema:"target_field_name"`
+	MappingFunctionName       string            `tfschema:"mapping_function_name"`
+	MappingFunctionParameters map[string]string `tfschema:"mapping_function_parameters"`
+}
+
+func (r SearchIndexerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"data_source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"target_index_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"skillset_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"interval": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azValidate.ISO8601DurationBetween("PT5M", "P1D"),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"parameters": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"batch_size": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"max_failed_items": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(-1),
+					},
+
+					"max_failed_items_per_batch": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(-1),
+					},
+
+					"configuration": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"field_mapping": searchIndexerFieldMappingSchema(),
+
+		"output_field_mapping": searchIndexerFieldMappingSchema(),
+	}
+}
+
+func (r SearchIndexerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchIndexerResource) ResourceType() string {
+	return "azurerm_search_indexer"
+}
+
+func (r SearchIndexerResource) ModelObject() interface{} {
+	return &SearchIndexerModel{}
+}
+
+func (r SearchIndexerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return indexers.ValidateIndexerID
+}
+
+func (r SearchIndexerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", *searchServiceId)
+			}
+			client := dataPlaneClient.IndexersClient
+
+			id := indexers.NewIndexerID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandSearchIndexer(id.IndexerName, model), indexers.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchIndexerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexers.ParseIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := dataPlaneClient.IndexersClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchIndexerModel{
+				Name:            id.IndexerName,
+				SearchServiceId: searchServiceId.ID(),
+				Enabled:         true,
+			}
+
+			if model := resp.Model; model != nil {
+				state.DataSourceName = model.DataSourceName
+				state.TargetIndexName = model.TargetIndexName
+				state.SkillsetName = pointer.From(model.SkillsetName)
+				state.Description = pointer.From(model.Description)
+				state.Enabled = !pointer.From(model.Disabled)
+				state.FieldMapping = flattenSearchIndexerFieldMappings(model.FieldMappings)
+				state.OutputFieldMapping = flattenSearchIndexerFieldMappings(model.OutputFieldMappings)
+
+				if schedule := model.Schedule; schedule != nil {
+					state.Schedule = []SearchIndexerScheduleModel{
+						{
+							Interval:  schedule.Interval,
+							StartTime: pointer.From(schedule.StartTime),
+						},
+					}
+				}
+
+				if parameters := model.Parameters; parameters != nil {
+					state.Parameters = []SearchIndexerParametersModel{
+						{
+							BatchSize:              pointer.From(parameters.BatchSize),
+							MaxFailedItems:         pointer.From(parameters.MaxFailedItems),
+							MaxFailedItemsPerBatch: pointer.From(parameters.MaxFailedItemsPerBatch),
+							Configuration:          flattenSearchIndexerParameterValues(parameters.Configuration),
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SearchIndexerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexers.ParseIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", searchServiceId)
+			}
+
+			if _, err := dataPlaneClient.IndexersClient.CreateOrUpdate(ctx, *id, expandSearchIndexer(id.IndexerName, model), indexers.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchIndexerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := indexers.ParseIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				// the Search Service has been deleted, and this along with it
+				return nil
+			}
+
+			if resp, err := dataPlaneClient.IndexersClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func searchIndexerFieldMappingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"source_field_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"target_field_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"mapping_function_name": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"base64Encode",
+						"base64Decode",
+						"extractTokenAtPosition",
+						"jsonArrayToStringCollection",
+						"urlEncode",
+						"urlDecode",
+					}, false),
+				},
+
+				"mapping_function_parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandSearchIndexer(name string, model SearchIndexerModel) indexers.Indexer {
+	output := indexers.Indexer{
+		Name:                name,
+		DataSourceName:      model.DataSourceName,
+		TargetIndexName:     model.TargetIndexName,
+		Disabled:            pointer.To(!model.Enabled),
+		FieldMappings:       expandSearchIndexerFieldMappings(model.FieldMapping),
+		OutputFieldMappings: expandSearchIndexerFieldMappings(model.OutputFieldMapping),
+	}
+
+	if model.SkillsetName != "" {
+		output.SkillsetName = pointer.To(model.SkillsetName)
+	}
+
+	if model.Description != "" {
+		output.Description = pointer.To(model.Description)
+	}
+
+	if len(model.Schedule) > 0 {
+		output.Schedule = &indexers.IndexingSchedule{
+			Interval: model.Schedule[0].Interval,
+		}
+		if v := model.Schedule[0].StartTime; v != "" {
+			output.Schedule.StartTime = pointer.To(v)
+		}
+	}
+
+	if len(model.Parameters) > 0 {
+		parameters := model.Parameters[0]
+		output.Parameters = &indexers.IndexingParameters{
+			Configuration: expandSearchIndexerParameterValues(parameters.Configuration),
+		}
+		if parameters.BatchSize != 0 {
+			output.Parameters.BatchSize = pointer.To(parameters.BatchSize)
+		}
+		if parameters.MaxFailedItems != 0 {
+			output.Parameters.MaxFailedItems = pointer.To(parameters.MaxFailedItems)
+		}
+		if parameters.MaxFailedItemsPerBatch != 0 {
+			output.Parameters.MaxFailedItemsPerBatch = pointer.To(parameters.MaxFailedItemsPerBatch)
+		}
+	}
+
+	return output
+}
+
+func expandSearchIndexerFieldMappings(input []SearchIndexerFieldMappingModel) *[]indexers.FieldMapping {
+	output := make([]indexers.FieldMapping, 0)
+	for _, v := range input {
+		mapping := indexers.FieldMapping{
+			SourceFieldName: v.SourceFieldName,
+		}
+		if v.TargetFieldName != "" {
+			mapping.TargetFieldName = pointer.To(v.TargetFieldName)
+		}
+		if v.MappingFunctionName != "" {
+			mapping.MappingFunction = &indexers.FieldMappingFunction{
+				Name:       v.MappingFunctionName,
+				Parameters: expandSearchIndexerParameterValues(v.MappingFunctionParameters),
+			}
+		}
+		output = append(output, mapping)
+	}
+	return &output
+}
+
+func flattenSearchIndexerFieldMappings(input *[]indexers.FieldMapping) []SearchIndexerFieldMappingModel {
+	output := make([]SearchIndexerFieldMappingModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		mapping := SearchIndexerFieldMappingModel{
+			SourceFieldName: v.SourceFieldName,
+			TargetFieldName: pointer.From(v.TargetFieldName),
+		}
+		if v.MappingFunction != nil {
+			mapping.MappingFunctionName = v.MappingFunction.Name
+			mapping.MappingFunctionParameters = flattenSearchIndexerParameterValues(v.MappingFunction.Parameters)
+		}
+		output = append(output, mapping)
+	}
+	return output
+}
+
+// expandSearchIndexerParameterValues converts the string values used in the schema into the typed values
+// expected by the API, since indexer configuration and mapping function parameters can be strings, booleans or integers.
+func expandSearchIndexerParameterValues(input map[string]string) *map[string]interface{} {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range input {
+		if b, err := strconv.ParseBool(v); err == nil && (v == "true" || v == "false") {
+			output[k] = b
+			continue
+		}
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			output[k] = i
+			continue
+		}
+		output[k] = v
+	}
+	return &output
+}
+
+func flattenSearchIndexerParameterValues(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		if v == nil {
+			continue
+		}
+		if f, ok := v.(float64); ok {
+			output[k] = strconv.FormatFloat(f, 'f', -1, 64)
+			continue
+		}
+		output[k] = fmt.Sprint(v)
+	}
+	return output
+}
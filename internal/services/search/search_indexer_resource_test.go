@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/indexers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexerResource struct{}
+
+func TestAccSearchIndexer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndexer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndexer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchIndexerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := indexers.ParseIndexerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+	if dataPlaneClient == nil {
+		return pointer.To(false), nil
+	}
+
+	resp, err := dataPlaneClient.IndexersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchIndexerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer-%d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "import" {
+  name              = azurerm_search_indexer.test.name
+  search_service_id = azurerm_search_indexer.test.search_service_id
+  data_source_name  = azurerm_search_indexer.test.data_source_name
+  target_index_name = azurerm_search_indexer.test.target_index_name
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctestskillset-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      name          = "split"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        },
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        },
+      ]
+    },
+  ])
+}
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+  skillset_name     = azurerm_search_skillset.test.name
+  description       = "Indexes hotel documents"
+  enabled           = false
+
+  schedule {
+    interval   = "PT2H"
+    start_time = "2030-01-01T00:00:00Z"
+  }
+
+  parameters {
+    batch_size                 = 10
+    max_failed_items           = -1
+    max_failed_items_per_batch = -1
+
+    configuration = {
+      dataToExtract = "contentAndMetadata"
+      parsingMode   = "default"
+    }
+  }
+
+  field_mapping {
+    source_field_name     = "metadata_storage_path"
+    target_field_name     = "id"
+    mapping_function_name = "base64Encode"
+  }
+
+  output_field_mapping {
+    source_field_name = "/document/pages"
+    target_field_name = "pages"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SearchIndexerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+
+  container {
+    name = azurerm_storage_container.test.name
+  }
+}
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "content"
+    type       = "Edm.String"
+    searchable = true
+  }
+
+  field {
+    name       = "pages"
+    type       = "Collection(Edm.String)"
+    searchable = true
+  }
+}
+`, SearchDataSourceResource{}.template(data), data.RandomInteger)
+}
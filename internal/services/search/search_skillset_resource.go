@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/skillsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchSkillsetResource struct{}
+
+var (
+	_ sdk.Resource           = SearchSkillsetResource{}
+	_ sdk.ResourceWithUpdate = SearchSkillsetResource{}
+)
+
+type SearchSkillsetModel struct {
+	Name              string                           `tfschema:"name"`
+	SearchServiceId   string                           `tfschema:"search_service_id"`
+	SkillsJson        string                           `tfschema:"skills_json"`
+	CognitiveServices []SearchSkillsetCognitiveService `tfschema:"cognitive_services"`
+	Description       string                           `tfschema:"description"`
+}
+
+type SearchSkillsetCognitiveService struct {
+	Key         string `tfschema:"key"`
+	Description string `tfschema:"description"`
+}
+
+func (r SearchSkillsetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"skills_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"cognitive_services": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SearchSkillsetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchSkillsetResource) ResourceType() string {
+	return "azurerm_search_skillset"
+}
+
+func (r SearchSkillsetResource) ModelObject() interface{} {
+	return &SearchSkillsetModel{}
+}
+
+func (r SearchSkillsetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return skillsets.ValidateSkillsetID
+}
+
+func (r SearchSkillsetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchSkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", *searchServiceId)
+			}
+			client := dataPlaneClient.SkillsetsClient
+
+			id := skillsets.NewSkillsetID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandSearchSkillset(id.SkillsetName, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters, skillsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchSkillsetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := skillsets.ParseSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := dataPlaneClient.SkillsetsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchSkillsetModel{
+				Name:            id.SkillsetName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Description = pointer.From(model.Description)
+
+				skills, err := json.Marshal(model.Skills)
+				if err != nil {
+					return fmt.Errorf("serializing `skills_json`: %+v", err)
+				}
+				state.SkillsJson = string(skills)
+
+				if cognitiveServices := model.CognitiveServices; cognitiveServices != nil && cognitiveServices.OdataType == skillsets.OdataTypeCognitiveServicesByKey {
+					state.CognitiveServices = []SearchSkillsetCognitiveService{
+						{
+							// the API doesn't return the key, so this is sourced from the config
+							Key:         metadata.ResourceData.Get("cognitive_services.0.key").(string),
+							Description: pointer.From(cognitiveServices.Description),
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SearchSkillsetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := skillsets.ParseSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchSkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", searchServiceId)
+			}
+
+			parameters, err := expandSearchSkillset(id.SkillsetName, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := dataPlaneClient.SkillsetsClient.CreateOrUpdate(ctx, *id, *parameters, skillsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchSkillsetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := skillsets.ParseSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				// the Search Service has been deleted, and this along with it
+				return nil
+			}
+
+			if resp, err := dataPlaneClient.SkillsetsClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandSearchSkillset(name string, model SearchSkillsetModel) (*skillsets.Skillset, error) {
+	skills := make([]json.RawMessage, 0)
+	if err := json.Unmarshal([]byte(model.SkillsJson), &skills); err != nil {
+		return nil, fmt.Errorf("parsing `skills_json`: expected a JSON array of skills: %+v", err)
+	}
+
+	output := skillsets.Skillset{
+		Name:   name,
+		Skills: skills,
+		CognitiveServices: &skillsets.CognitiveServicesAccount{
+			OdataType: skillsets.OdataTypeDefaultCognitiveServices,
+		},
+	}
+
+	if model.Description != "" {
+		output.Description = pointer.To(model.Description)
+	}
+
+	if len(model.CognitiveServices) > 0 {
+		output.CognitiveServices = &skillsets.CognitiveServicesAccount{
+			OdataType: skillsets.OdataTypeCognitiveServicesByKey,
+			Key:       pointer.To(model.CognitiveServices[0].Key),
+		}
+		if v := model.CognitiveServices[0].Description; v != "" {
+			output.CognitiveServices.Description = pointer.To(v)
+		}
+	}
+
+	return &output, nil
+}
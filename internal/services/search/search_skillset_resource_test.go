@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/skillsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchSkillsetResource struct{}
+
+func TestAccSearchSkillset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSkillset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSkillset_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("cognitive_services.0.key"),
+	})
+}
+
+func (r SearchSkillsetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := skillsets.ParseSkillsetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+	if dataPlaneClient == nil {
+		return pointer.To(false), nil
+	}
+
+	resp, err := dataPlaneClient.SkillsetsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchSkillsetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctestskillset-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      name          = "split"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        },
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        },
+      ]
+    },
+  ])
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+func (r SearchSkillsetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "import" {
+  name              = azurerm_search_skillset.test.name
+  search_service_id = azurerm_search_skillset.test.search_service_id
+  skills_json       = azurerm_search_skillset.test.skills_json
+}
+`, r.basic(data))
+}
+
+func (r SearchSkillsetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "CognitiveServices"
+  sku_name            = "S0"
+}
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctestskillset-%[2]d"
+  search_service_id = azurerm_search_service.test.id
+  description       = "Extracts key phrases"
+
+  cognitive_services {
+    key         = azurerm_cognitive_account.test.primary_access_key
+    description = "billing account"
+  }
+
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.KeyPhraseExtractionSkill"
+      name          = "keyphrases"
+      context       = "/document"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        },
+      ]
+      outputs = [
+        {
+          name       = "keyPhrases"
+          targetName = "keyPhrases"
+        },
+      ]
+    },
+  ])
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/synonymmaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchSynonymMapResource struct{}
+
+var (
+	_ sdk.Resource           = SearchSynonymMapResource{}
+	_ sdk.ResourceWithUpdate = SearchSynonymMapResource{}
+)
+
+type SearchSynonymMapModel struct {
+	Name            string   `tfschema:"name"`
+	SearchServiceId string   `tfschema:"search_service_id"`
+	Synonyms        []string `tfschema:"synonyms"`
+}
+
+func (r SearchSynonymMapResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"synonyms": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r SearchSynonymMapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchSynonymMapResource) ResourceType() string {
+	return "azurerm_search_synonym_map"
+}
+
+func (r SearchSynonymMapResource) ModelObject() interface{} {
+	return &SearchSynonymMapModel{}
+}
+
+func (r SearchSynonymMapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return synonymmaps.ValidateSynonymMapID
+}
+
+func (r SearchSynonymMapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchSynonymMapModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", *searchServiceId)
+			}
+			client := dataPlaneClient.SynonymMapsClient
+
+			id := synonymmaps.NewSynonymMapID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := synonymmaps.SynonymMap{
+				Name:     model.Name,
+				Format:   synonymmaps.SynonymMapFormatSolr,
+				Synonyms: strings.Join(model.Synonyms, "\n"),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters, synonymmaps.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchSynonymMapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := synonymmaps.ParseSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := dataPlaneClient.SynonymMapsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchSynonymMapModel{
+				Name:            id.SynonymMapName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Synonyms = flattenSearchSynonyms(model.Synonyms)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SearchSynonymMapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := synonymmaps.ParseSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchSynonymMapModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				return fmt.Errorf("%s was not found", searchServiceId)
+			}
+
+			parameters := synonymmaps.SynonymMap{
+				Name:     id.SynonymMapName,
+				Format:   synonymmaps.SynonymMapFormatSolr,
+				Synonyms: strings.Join(model.Synonyms, "\n"),
+			}
+
+			if _, err := dataPlaneClient.SynonymMapsClient.CreateOrUpdate(ctx, *id, parameters, synonymmaps.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SearchSynonymMapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := synonymmaps.ParseSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+			dataPlaneClient, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if dataPlaneClient == nil {
+				// the Search Service has been deleted, and this along with it
+				return nil
+			}
+
+			if resp, err := dataPlaneClient.SynonymMapsClient.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func flattenSearchSynonyms(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-07-01/synonymmaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchSynonymMapResource struct{}
+
+func TestAccSearchSynonymMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSynonymMap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSynonymMap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synonyms.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchSynonymMapResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := synonymmaps.ParseSynonymMapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+	if dataPlaneClient == nil {
+		return pointer.To(false), nil
+	}
+
+	resp, err := dataPlaneClient.SynonymMapsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchSynonymMapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "test" {
+  name              = "acctestsynonyms-%d"
+  search_service_id = azurerm_search_service.test.id
+  synonyms          = ["USA, United States, United States of America"]
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
+
+func (r SearchSynonymMapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "import" {
+  name              = azurerm_search_synonym_map.test.name
+  search_service_id = azurerm_search_synonym_map.test.search_service_id
+  synonyms          = azurerm_search_synonym_map.test.synonyms
+}
+`, r.basic(data))
+}
+
+func (r SearchSynonymMapResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "test" {
+  name              = "acctestsynonyms-%d"
+  search_service_id = azurerm_search_service.test.id
+  synonyms = [
+    "USA, United States, United States of America",
+    "Washington, Wash., WA => WA",
+    "hotel, motel",
+  ]
+}
+`, SearchDataPlaneTemplate{}.template(data), data.RandomInteger)
+}
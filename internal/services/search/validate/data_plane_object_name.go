@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DataPlaneObjectName validates the name of an Index, Data Source, Indexer, Skillset or Synonym Map
+// within a Search Service.
+func DataPlaneObjectName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,126}[a-z0-9])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long, can only contain lowercase letters, numbers and dashes, and must start and end with a lowercase letter or number", k))
+	}
+
+	return
+}
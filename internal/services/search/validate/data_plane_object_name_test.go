@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataPlaneObjectName(t *testing.T) {
+	testData := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "a",
+			valid: true,
+		},
+		{
+			input: "hotels-sample-index",
+			valid: true,
+		},
+		{
+			input: "Hotels",
+			valid: false,
+		},
+		{
+			input: "-hotels",
+			valid: false,
+		},
+		{
+			input: "hotels-",
+			valid: false,
+		},
+		{
+			input: "hotels_index",
+			valid: false,
+		},
+		{
+			input: strings.Repeat("a", 128),
+			valid: true,
+		},
+		{
+			input: strings.Repeat("a", 129),
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataPlaneObjectName(v.input, "name")
+		actual := len(errors) == 0
+		if v.valid != actual {
+			t.Fatalf("expected %t but got %t for %q", v.valid, actual, v.input)
+		}
+	}
+}
@@ -60,6 +60,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AlertRuleAnomalyDataSource{},
+		ContentTemplateUpdatesDataSource{},
 	}
 }
 
@@ -82,5 +83,8 @@ func (r Registration) Resources() []sdk.Resource {
 		MetadataResource{},
 		AlertRuleAnomalyDuplicateResource{},
 		ThreatIntelligenceIndicator{},
+		ContentPackageResource{},
+		HuntingQueryResource{},
+		WorkbookResource{},
 	}
 }
@@ -4,14 +4,18 @@
 package sentinel
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertruletemplates"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -35,6 +39,8 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 			return err
 		}, importSentinelAlertRule(alertrules.AlertRuleKindScheduled)),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceSentinelAlertRuleScheduledCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -59,7 +65,8 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 
 			"display_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -73,11 +80,20 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 			"alert_rule_template_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
+				Computed: true,
+			},
+
+			"template_instantiation_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"alert_rule_template_guid"},
 			},
 
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -99,6 +115,7 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 			"tactics": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAttackTactic(), false),
@@ -108,6 +125,7 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 			"techniques": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -189,7 +207,8 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 
 			"severity": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAlertSeverity(), false),
 			},
 
@@ -201,35 +220,38 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 
 			"query": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			// NOTE: O+C the defaults for these are set in the CustomizeDiff, since these are sourced from the alert
+			// rule template when `template_instantiation_enabled` is set
 			"query_frequency": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				Default:      "PT5H",
+				Computed:     true,
 				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "P14D"),
 			},
 
 			"query_period": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				Default:      "PT5H",
+				Computed:     true,
 				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "P14D"),
 			},
 
 			"trigger_operator": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				Default:      string(alertrules.TriggerOperatorGreaterThan),
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForTriggerOperator(), false),
 			},
 
 			"trigger_threshold": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Default:      0,
+				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 
@@ -600,3 +622,213 @@ func flattenAlertRuleScheduledEventGroupingSetting(input *alertrules.EventGroupi
 		},
 	}
 }
+
+// scheduledAlertRuleTemplateField is an argument of the rule which is sourced from the alert rule template when
+// `template_instantiation_enabled` is set and the argument isn't specified in the configuration
+type scheduledAlertRuleTemplateField struct {
+	// defaultValue is used when the rule isn't instantiated from a template, where nil means the argument is required
+	defaultValue interface{}
+	fromTemplate func(alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{}
+}
+
+var scheduledAlertRuleTemplateFields = map[string]scheduledAlertRuleTemplateField{
+	"display_name": {
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return pointer.From(p.DisplayName)
+		},
+	},
+	"description": {
+		defaultValue: "",
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return pointer.From(p.Description)
+		},
+	},
+	"severity": {
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return string(pointer.From(p.Severity))
+		},
+	},
+	"query": {
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return pointer.From(p.Query)
+		},
+	},
+	"query_frequency": {
+		defaultValue: "PT5H",
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return pointer.From(p.QueryFrequency)
+		},
+	},
+	"query_period": {
+		defaultValue: "PT5H",
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return pointer.From(p.QueryPeriod)
+		},
+	},
+	"trigger_operator": {
+		defaultValue: string(alertrules.TriggerOperatorGreaterThan),
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return string(pointer.From(p.TriggerOperator))
+		},
+	},
+	"trigger_threshold": {
+		defaultValue: 0,
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			return int(pointer.From(p.TriggerThreshold))
+		},
+	},
+	"tactics": {
+		defaultValue: []interface{}{},
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			output := make([]interface{}, 0)
+			for _, v := range pointer.From(p.Tactics) {
+				output = append(output, string(v))
+			}
+			return output
+		},
+	},
+	"techniques": {
+		defaultValue: []interface{}{},
+		fromTemplate: func(p alertruletemplates.ScheduledAlertRuleTemplateProperties) interface{} {
+			output := make([]interface{}, 0)
+			for _, v := range pointer.From(p.Techniques) {
+				output = append(output, v)
+			}
+			return output
+		},
+	},
+}
+
+// resourceSentinelAlertRuleScheduledCustomizeDiff sources any arguments which aren't specified in the configuration from the
+// alert rule template when `template_instantiation_enabled` is set, or from their defaults otherwise.
+//
+// A rule stays linked to the version of the template it was instantiated from - newer versions of the template installed in
+// the workspace are reported by the `azurerm_sentinel_content_template_updates` data source, and are applied by updating
+// `alert_rule_template_version` to the installed version.
+func resourceSentinelAlertRuleScheduledCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	unspecified := make([]string, 0)
+	for key := range scheduledAlertRuleTemplateFields {
+		if config.GetAttr(key).IsNull() {
+			unspecified = append(unspecified, key)
+		}
+	}
+	sort.Strings(unspecified)
+
+	if !d.Get("template_instantiation_enabled").(bool) {
+		for _, key := range unspecified {
+			field := scheduledAlertRuleTemplateFields[key]
+			if field.defaultValue == nil {
+				return fmt.Errorf("`%s` must be specified when `template_instantiation_enabled` is `false`", key)
+			}
+			if err := setScheduledAlertRuleFieldIfChanged(d, key, field.defaultValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !d.NewValueKnown("log_analytics_workspace_id") || !d.NewValueKnown("alert_rule_template_guid") || !d.NewValueKnown("alert_rule_template_version") {
+		return nil
+	}
+
+	configuredVersion := ""
+	if v := config.GetAttr("alert_rule_template_version"); !v.IsNull() {
+		configuredVersion = v.AsString()
+	}
+	existingVersion, _ := d.GetChange("alert_rule_template_version")
+	instantiating := d.Id() == "" || d.HasChange("template_instantiation_enabled") || d.HasChange("alert_rule_template_guid")
+	if !instantiating && len(unspecified) == 0 && (configuredVersion == "" || configuredVersion == existingVersion.(string)) {
+		return nil
+	}
+
+	workspaceId, err := alertruletemplates.ParseWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	templateId := alertruletemplates.NewAlertRuleTemplateID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, d.Get("alert_rule_template_guid").(string))
+
+	template, err := getScheduledAlertRuleTemplate(ctx, meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient, templateId)
+	if err != nil {
+		return err
+	}
+	installedVersion := pointer.From(template.Version)
+
+	version := configuredVersion
+	if version == "" {
+		version = existingVersion.(string)
+		if instantiating {
+			version = installedVersion
+		}
+	}
+
+	if version != installedVersion {
+		if instantiating {
+			return fmt.Errorf("version %q of %s isn't installed in the workspace, the installed version is %q", version, templateId, installedVersion)
+		}
+
+		// the rule is linked to a previous version of the template, the content of which is no longer available
+		return nil
+	}
+
+	if configuredVersion == "" && instantiating {
+		if err := d.SetNew("alert_rule_template_version", installedVersion); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range unspecified {
+		if err := setScheduledAlertRuleFieldIfChanged(d, key, scheduledAlertRuleTemplateFields[key].fromTemplate(*template)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setScheduledAlertRuleFieldIfChanged(d *pluginsdk.ResourceDiff, key string, value interface{}) error {
+	// the arguments are computed until set for a new resource, so these can only be compared for an existing resource
+	if d.Id() != "" {
+		existing := d.Get(key)
+		if set, ok := existing.(*pluginsdk.Set); ok {
+			if set.Equal(pluginsdk.NewSet(set.F, value.([]interface{}))) {
+				return nil
+			}
+		} else if existing == value {
+			return nil
+		}
+	}
+
+	if err := d.SetNew(key, value); err != nil {
+		return fmt.Errorf("setting `%s`: %+v", key, err)
+	}
+
+	return nil
+}
+
+// scheduledAlertRuleTemplates caches the alert rule templates retrieved when planning, since these are retrieved for
+// every rule which is instantiated from a template
+var scheduledAlertRuleTemplates sync.Map
+
+func getScheduledAlertRuleTemplate(ctx context.Context, client *alertruletemplates.AlertRuleTemplatesClient, id alertruletemplates.AlertRuleTemplateId) (*alertruletemplates.ScheduledAlertRuleTemplateProperties, error) {
+	if v, ok := scheduledAlertRuleTemplates.Load(id.ID()); ok {
+		return v.(*alertruletemplates.ScheduledAlertRuleTemplateProperties), nil
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	template, ok := resp.Model.(alertruletemplates.ScheduledAlertRuleTemplate)
+	if !ok || template.Properties == nil {
+		return nil, fmt.Errorf("%s is not a Scheduled Alert Rule Template", id)
+	}
+
+	scheduledAlertRuleTemplates.Store(id.ID(), template.Properties)
+	return template.Properties, nil
+}
@@ -129,6 +129,65 @@ func TestAccSentinelAlertRuleScheduled_withAlertRuleTemplateGuid(t *testing.T) {
 	})
 }
 
+func TestAccSentinelAlertRuleScheduled_templateInstantiation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateInstantiation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("display_name")),
+				check.That(data.ResourceName).Key("query").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.query")),
+				check.That(data.ResourceName).Key("severity").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.severity")),
+				check.That(data.ResourceName).Key("alert_rule_template_version").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("template_instantiation_enabled"),
+		{
+			Config:   r.templateInstantiation(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_templateInstantiationRemoveOverride(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateInstantiationOverrides(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Overridden Rule"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("10"),
+			),
+		},
+		data.ImportStep("template_instantiation_enabled"),
+		{
+			// removing the overrides must revert the rule to the values from the template
+			Config:             r.templateInstantiation(data),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.templateInstantiation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("display_name")),
+				check.That(data.ResourceName).Key("trigger_threshold").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.trigger_threshold")),
+			),
+		},
+		{
+			Config:   r.templateInstantiation(data),
+			PlanOnly: true,
+		},
+		data.ImportStep("template_instantiation_enabled"),
+	})
+}
+
 func TestAccSentinelAlertRuleScheduled_updateEventGroupingSetting(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
 	r := SentinelAlertRuleScheduledResource{}
@@ -312,6 +371,45 @@ resource "azurerm_sentinel_alert_rule_scheduled" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SentinelAlertRuleScheduledResource) templateInstantiation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                           = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id     = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  alert_rule_template_guid       = data.azurerm_sentinel_alert_rule_template.test.name
+  template_instantiation_enabled = true
+}
+`, r.templateInstantiationTemplate(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) templateInstantiationOverrides(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                           = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id     = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  alert_rule_template_guid       = data.azurerm_sentinel_alert_rule_template.test.name
+  template_instantiation_enabled = true
+  display_name                   = "Overridden Rule"
+  trigger_threshold              = 10
+}
+`, r.templateInstantiationTemplate(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) templateInstantiationTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_template" "test" {
+  display_name               = "Malware in the recycle bin"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+}
+`, r.template(data))
+}
+
 func (r SentinelAlertRuleScheduledResource) eventGroupingSetting(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -147,7 +147,7 @@ func dataSourceSentinelAlertRuleTemplate() *pluginsdk.Resource {
 }
 
 func dataSourceSentinelAlertRuleTemplateRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient
+	client := meta.(*clients.Client).Sentinel.LegacyAlertRuleTemplatesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -114,7 +114,7 @@ func (a AlertRuleThreatIntelligenceResource) Create() sdk.ResourceFunc {
 				return tf.ImportAsExistsError("azurerm_sentinel_alert_rule_threat_intelligence", id.ID())
 			}
 
-			template, err := fetchAlertRuleThreatIntelligenceTemplate(ctx, metadata.Client.Sentinel.AlertRuleTemplatesClient, *workspaceID, metaModel.TemplateName)
+			template, err := fetchAlertRuleThreatIntelligenceTemplate(ctx, metadata.Client.Sentinel.LegacyAlertRuleTemplatesClient, *workspaceID, metaModel.TemplateName)
 			if err != nil {
 				return fmt.Errorf("fetching severity from template: %+v", err)
 			}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproductpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproducttemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentPackageModel struct {
+	WorkspaceId       string                        `tfschema:"workspace_id"`
+	ContentId         string                        `tfschema:"content_id"`
+	Version           string                        `tfschema:"version"`
+	DisplayName       string                        `tfschema:"display_name"`
+	ContentKind       string                        `tfschema:"content_kind"`
+	ContentProductId  string                        `tfschema:"content_product_id"`
+	InstalledTemplate []ContentPackageTemplateModel `tfschema:"installed_template"`
+}
+
+type ContentPackageTemplateModel struct {
+	Name        string `tfschema:"name"`
+	ContentId   string `tfschema:"content_id"`
+	ContentKind string `tfschema:"content_kind"`
+	DisplayName string `tfschema:"display_name"`
+	Version     string `tfschema:"version"`
+}
+
+type ContentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentPackageResource{}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"content_product_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"installed_template": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"content_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"content_kind": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contentpackages.ValidateContentPackageID
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := contentpackages.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.ContentId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := installContentPackage(ctx, metadata.Client.Sentinel, id, model.Version); err != nil {
+				return fmt.Errorf("installing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContentPackageModel{
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ContentId:   id.PackageId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Version = pointer.From(props.Version)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.ContentKind = string(pointer.From(props.ContentKind))
+					state.ContentProductId = pointer.From(props.ContentProductId)
+				}
+			}
+
+			templates, err := listContentPackageTemplates(ctx, metadata.Client.Sentinel.ContentTemplatesClient, *id)
+			if err != nil {
+				return fmt.Errorf("listing templates installed by %s: %+v", id, err)
+			}
+			state.InstalledTemplate = make([]ContentPackageTemplateModel, 0)
+			for _, template := range templates {
+				if props := template.Properties; props != nil {
+					state.InstalledTemplate = append(state.InstalledTemplate, ContentPackageTemplateModel{
+						Name:        pointer.From(template.Name),
+						ContentId:   pointer.From(props.ContentId),
+						ContentKind: string(pointer.From(props.ContentKind)),
+						DisplayName: pointer.From(props.DisplayName),
+						Version:     pointer.From(props.Version),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				if err := installContentPackage(ctx, metadata.Client.Sentinel, *id, model.Version); err != nil {
+					return fmt.Errorf("updating %s to version %q: %+v", id, model.Version, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+			templatesClient := metadata.Client.Sentinel.ContentTemplatesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// uninstalling the package only removes the package itself, so the templates it installed are removed first
+			templates, err := listContentPackageTemplates(ctx, templatesClient, *id)
+			if err != nil {
+				return fmt.Errorf("listing templates installed by %s: %+v", id, err)
+			}
+			for _, template := range templates {
+				templateId := contenttemplates.NewContentTemplateID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, pointer.From(template.Name))
+				if resp, err := templatesClient.ContentTemplateDelete(ctx, templateId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", templateId, err)
+				}
+			}
+
+			if resp, err := client.ContentPackageUninstall(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("uninstalling %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// installContentPackage installs the templates of a Content Hub solution followed by the package itself, the Content Hub only
+// offers the latest version of a solution so the requested version (when specified) must match the version which is available.
+func installContentPackage(ctx context.Context, sentinelClient *client.Client, id contentpackages.ContentPackageId, version string) error {
+	productPackage, err := findContentProductPackage(ctx, sentinelClient.ContentProductPackagesClient, id)
+	if err != nil {
+		return err
+	}
+	if productPackage.Properties == nil {
+		return fmt.Errorf("retrieving the Content Hub package %q: `properties` was nil", id.PackageId)
+	}
+	if available := pointer.From(productPackage.Properties.Version); version != "" && !strings.EqualFold(available, version) {
+		return fmt.Errorf("version %q of %q is not available in the Content Hub, the available version is %q", version, id.PackageId, available)
+	}
+
+	productTemplatesClient := sentinelClient.ContentProductTemplatesClient
+	productTemplates, err := productTemplatesClient.ProductTemplatesListComplete(ctx, contentproducttemplates.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName), contentproducttemplates.ProductTemplatesListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/packageId eq '%s'", id.PackageId)),
+	})
+	if err != nil {
+		return fmt.Errorf("listing the Content Hub templates of %q: %+v", id.PackageId, err)
+	}
+
+	for _, item := range productTemplates.Items {
+		// the packaged content is only returned when retrieving an individual template
+		productTemplateId := contentproducttemplates.NewContentProductTemplateID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, pointer.From(item.Name))
+		productTemplate, err := productTemplatesClient.ProductTemplateGet(ctx, productTemplateId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", productTemplateId, err)
+		}
+		if productTemplate.Model == nil || productTemplate.Model.Properties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", productTemplateId)
+		}
+
+		templateProps := contenttemplates.TemplateProperties{}
+		if err := convertContentModel(productTemplate.Model.Properties, &templateProps); err != nil {
+			return fmt.Errorf("converting %s: %+v", productTemplateId, err)
+		}
+		templateProps.MainTemplate = productTemplate.Model.Properties.PackagedContent
+
+		templateId := contenttemplates.NewContentTemplateID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, pointer.From(item.Name))
+		if _, err := sentinelClient.ContentTemplatesClient.ContentTemplateInstall(ctx, templateId, contenttemplates.TemplateModel{Properties: &templateProps}); err != nil {
+			return fmt.Errorf("installing %s: %+v", templateId, err)
+		}
+	}
+
+	packageProps := contentpackages.PackageBaseProperties{}
+	if err := convertContentModel(productPackage.Properties, &packageProps); err != nil {
+		return fmt.Errorf("converting the Content Hub package %q: %+v", id.PackageId, err)
+	}
+
+	if _, err := sentinelClient.ContentPackagesClient.ContentPackageInstall(ctx, id, contentpackages.PackageModel{Properties: &packageProps}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findContentProductPackage(ctx context.Context, client *contentproductpackages.ContentProductPackagesClient, id contentpackages.ContentPackageId) (*contentproductpackages.ProductPackageModel, error) {
+	workspaceId := contentproductpackages.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	resp, err := client.ProductPackagesListComplete(ctx, workspaceId, contentproductpackages.ProductPackagesListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/contentId eq '%s'", id.PackageId)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing the Content Hub packages for %q: %+v", id.PackageId, err)
+	}
+
+	for _, item := range resp.Items {
+		if item.Properties != nil && strings.EqualFold(pointer.From(item.Properties.ContentId), id.PackageId) {
+			return &item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the package %q was not found in the Content Hub", id.PackageId)
+}
+
+func listContentPackageTemplates(ctx context.Context, client *contenttemplates.ContentTemplatesClient, id contentpackages.ContentPackageId) ([]contenttemplates.TemplateModel, error) {
+	workspaceId := contenttemplates.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	resp, err := client.ListComplete(ctx, workspaceId, contenttemplates.ListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/packageId eq '%s'", id.PackageId)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Items, nil
+}
+
+// convertContentModel converts between the Content Hub models, which share the same wire format across the
+// product (catalog) and installed variants but are defined separately in each SDK package.
+func convertContentModel(input interface{}, output interface{}) error {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, output)
+}
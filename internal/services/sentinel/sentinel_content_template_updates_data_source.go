@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproducttemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentTemplateUpdatesDataSourceModel struct {
+	WorkspaceId        string                                  `tfschema:"workspace_id"`
+	ContentKind        string                                  `tfschema:"content_kind"`
+	Templates          []ContentTemplateUpdateModel            `tfschema:"template"`
+	OutdatedAlertRules []ContentTemplateOutdatedAlertRuleModel `tfschema:"outdated_alert_rule"`
+}
+
+type ContentTemplateUpdateModel struct {
+	Name             string `tfschema:"name"`
+	ContentId        string `tfschema:"content_id"`
+	ContentKind      string `tfschema:"content_kind"`
+	DisplayName      string `tfschema:"display_name"`
+	PackageId        string `tfschema:"package_id"`
+	InstalledVersion string `tfschema:"installed_version"`
+	LatestVersion    string `tfschema:"latest_version"`
+}
+
+type ContentTemplateOutdatedAlertRuleModel struct {
+	Id                       string `tfschema:"id"`
+	DisplayName              string `tfschema:"display_name"`
+	AlertRuleTemplateGuid    string `tfschema:"alert_rule_template_guid"`
+	AlertRuleTemplateVersion string `tfschema:"alert_rule_template_version"`
+	InstalledVersion         string `tfschema:"installed_version"`
+}
+
+type ContentTemplateUpdatesDataSource struct{}
+
+var _ sdk.DataSource = ContentTemplateUpdatesDataSource{}
+
+func (d ContentTemplateUpdatesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_kind": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(contenttemplates.PossibleValuesForKind(), false),
+		},
+	}
+}
+
+func (d ContentTemplateUpdatesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"content_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"content_kind": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"package_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"installed_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"latest_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"outdated_alert_rule": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"alert_rule_template_guid": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"alert_rule_template_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"installed_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ContentTemplateUpdatesDataSource) ModelObject() interface{} {
+	return &ContentTemplateUpdatesDataSourceModel{}
+}
+
+func (d ContentTemplateUpdatesDataSource) ResourceType() string {
+	return "azurerm_sentinel_content_template_updates"
+}
+
+func (d ContentTemplateUpdatesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			templatesClient := metadata.Client.Sentinel.ContentTemplatesClient
+			productTemplatesClient := metadata.Client.Sentinel.ContentProductTemplatesClient
+			alertRulesClient := metadata.Client.Sentinel.AlertRulesClient
+
+			var state ContentTemplateUpdatesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(state.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			templatesOptions := contenttemplates.ListOperationOptions{}
+			productTemplatesOptions := contentproducttemplates.ProductTemplatesListOperationOptions{}
+			if state.ContentKind != "" {
+				templatesOptions.Filter = pointer.To(fmt.Sprintf("properties/contentKind eq '%s'", state.ContentKind))
+				productTemplatesOptions.Filter = templatesOptions.Filter
+			}
+
+			installed, err := templatesClient.ListComplete(ctx, contenttemplates.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName), templatesOptions)
+			if err != nil {
+				return fmt.Errorf("listing installed Content Templates in %s: %+v", workspaceId, err)
+			}
+
+			available, err := productTemplatesClient.ProductTemplatesListComplete(ctx, contentproducttemplates.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName), productTemplatesOptions)
+			if err != nil {
+				return fmt.Errorf("listing Content Hub templates for %s: %+v", workspaceId, err)
+			}
+
+			latestVersions := make(map[string]string)
+			for _, item := range available.Items {
+				if props := item.Properties; props != nil && props.ContentId != nil {
+					contentId := strings.ToLower(*props.ContentId)
+					if compareContentVersions(pointer.From(props.Version), latestVersions[contentId]) > 0 {
+						latestVersions[contentId] = pointer.From(props.Version)
+					}
+				}
+			}
+
+			installedVersions := make(map[string]string)
+			state.Templates = make([]ContentTemplateUpdateModel, 0)
+			for _, item := range installed.Items {
+				props := item.Properties
+				if props == nil || props.ContentId == nil {
+					continue
+				}
+
+				installedVersion := pointer.From(props.Version)
+				installedVersions[strings.ToLower(*props.ContentId)] = installedVersion
+
+				latestVersion, ok := latestVersions[strings.ToLower(*props.ContentId)]
+				if !ok || compareContentVersions(latestVersion, installedVersion) <= 0 {
+					continue
+				}
+
+				state.Templates = append(state.Templates, ContentTemplateUpdateModel{
+					Name:             pointer.From(item.Name),
+					ContentId:        *props.ContentId,
+					ContentKind:      string(pointer.From(props.ContentKind)),
+					DisplayName:      pointer.From(props.DisplayName),
+					PackageId:        pointer.From(props.PackageId),
+					InstalledVersion: installedVersion,
+					LatestVersion:    latestVersion,
+				})
+			}
+			sort.Slice(state.Templates, func(i, j int) bool {
+				return state.Templates[i].ContentId < state.Templates[j].ContentId
+			})
+
+			rules, err := alertRulesClient.ListComplete(ctx, alertrules.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName))
+			if err != nil {
+				return fmt.Errorf("listing Alert Rules in %s: %+v", workspaceId, err)
+			}
+
+			state.OutdatedAlertRules = make([]ContentTemplateOutdatedAlertRuleModel, 0)
+			for _, item := range rules.Items {
+				var id, displayName, templateName, templateVersion string
+				switch rule := item.(type) {
+				case alertrules.ScheduledAlertRule:
+					if rule.Properties == nil {
+						continue
+					}
+					id, displayName = pointer.From(rule.Id), rule.Properties.DisplayName
+					templateName, templateVersion = pointer.From(rule.Properties.AlertRuleTemplateName), pointer.From(rule.Properties.TemplateVersion)
+				case alertrules.NrtAlertRule:
+					if rule.Properties == nil {
+						continue
+					}
+					id, displayName = pointer.From(rule.Id), rule.Properties.DisplayName
+					templateName, templateVersion = pointer.From(rule.Properties.AlertRuleTemplateName), pointer.From(rule.Properties.TemplateVersion)
+				default:
+					continue
+				}
+
+				installedVersion, ok := installedVersions[strings.ToLower(templateName)]
+				if templateName == "" || !ok || compareContentVersions(installedVersion, templateVersion) <= 0 {
+					continue
+				}
+
+				state.OutdatedAlertRules = append(state.OutdatedAlertRules, ContentTemplateOutdatedAlertRuleModel{
+					Id:                       id,
+					DisplayName:              displayName,
+					AlertRuleTemplateGuid:    templateName,
+					AlertRuleTemplateVersion: templateVersion,
+					InstalledVersion:         installedVersion,
+				})
+			}
+
+			metadata.SetID(workspaceId)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// compareContentVersions compares two Content Hub versions in the format `a.b.c`, returning a positive number when `a` is
+// newer than `b`, a negative number when `a` is older than `b` and zero when they are the same.
+func compareContentVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		// missing trailing parts are treated as `0`, so `1.0` and `1.0.0` are the same version
+		aPart, bPart := "0", "0"
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+
+		aNum, aErr := strconv.Atoi(aPart)
+		bNum, bErr := strconv.Atoi(bPart)
+		if aErr == nil && bErr == nil {
+			if aNum != bNum {
+				return aNum - bNum
+			}
+			continue
+		}
+
+		if c := strings.Compare(aPart, bPart); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContentTemplateUpdatesDataSource struct{}
+
+func TestAccSentinelContentTemplateUpdatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_content_template_updates", "test")
+	r := ContentTemplateUpdatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("template.#").Exists(),
+				check.That(data.ResourceName).Key("outdated_alert_rule.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccSentinelContentTemplateUpdatesDataSource_contentKind(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_content_template_updates", "test")
+	r := ContentTemplateUpdatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.contentKind(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("content_kind").HasValue("AnalyticsRule"),
+			),
+		},
+	})
+}
+
+func (ContentTemplateUpdatesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_content_template_updates" "test" {
+  workspace_id = azurerm_sentinel_content_package.test.workspace_id
+}
+`, ContentPackageResource{}.basic(data))
+}
+
+func (ContentTemplateUpdatesDataSource) contentKind(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_content_template_updates" "test" {
+  workspace_id = azurerm_sentinel_content_package.test.workspace_id
+  content_kind = "AnalyticsRule"
+}
+`, ContentPackageResource{}.basic(data))
+}
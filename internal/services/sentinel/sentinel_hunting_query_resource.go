@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Sentinel Hunting Queries are stored as Saved Searches in the Log Analytics Workspace within a dedicated category,
+// with the description, tactics and techniques stored as tags on the Saved Search.
+const (
+	huntingQueryCategory       = "Hunting Queries"
+	huntingQueryTagDescription = "description"
+	huntingQueryTagTactics     = "tactics"
+	huntingQueryTagTechniques  = "techniques"
+)
+
+type HuntingQueryResource struct{}
+
+var _ sdk.ResourceWithUpdate = HuntingQueryResource{}
+
+type HuntingQueryModel struct {
+	Name                    string   `tfschema:"name"`
+	LogAnalyticsWorkspaceId string   `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string   `tfschema:"display_name"`
+	Query                   string   `tfschema:"query"`
+	Description             string   `tfschema:"description"`
+	Tactics                 []string `tfschema:"tactics"`
+	Techniques              []string `tfschema:"techniques"`
+}
+
+func (r HuntingQueryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tactics": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAttackTactic(), false),
+			},
+		},
+
+		"techniques": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r HuntingQueryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HuntingQueryResource) ModelObject() interface{} {
+	return &HuntingQueryModel{}
+}
+
+func (r HuntingQueryResource) ResourceType() string {
+	return "azurerm_sentinel_hunting_query"
+}
+
+func (r HuntingQueryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return savedsearches.ValidateSavedSearchID
+}
+
+func (r HuntingQueryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			var model HuntingQueryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := savedsearches.NewSavedSearchID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := savedsearches.SavedSearch{
+				Properties: expandHuntingQueryProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := HuntingQueryModel{
+				Name:                    id.SavedSearchId,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				if !strings.EqualFold(props.Category, huntingQueryCategory) {
+					return fmt.Errorf("%s is not a Sentinel Hunting Query, expected the category to be %q but got %q", id, huntingQueryCategory, props.Category)
+				}
+
+				state.DisplayName = props.DisplayName
+				state.Query = props.Query
+
+				if props.Tags != nil {
+					for _, tag := range *props.Tags {
+						switch tag.Name {
+						case huntingQueryTagDescription:
+							state.Description = tag.Value
+						case huntingQueryTagTactics:
+							state.Tactics = splitHuntingQueryTagValue(tag.Value)
+						case huntingQueryTagTechniques:
+							state.Techniques = splitHuntingQueryTagValue(tag.Value)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r HuntingQueryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model HuntingQueryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+
+			// the ETag has to be sent back to update an existing Saved Search
+			param := savedsearches.SavedSearch{
+				Etag:       existing.Model.Etag,
+				Properties: expandHuntingQueryProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandHuntingQueryProperties(model HuntingQueryModel) savedsearches.SavedSearchProperties {
+	tags := make([]savedsearches.Tag, 0)
+	if model.Description != "" {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagDescription,
+			Value: model.Description,
+		})
+	}
+	if len(model.Tactics) > 0 {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTactics,
+			Value: strings.Join(model.Tactics, ","),
+		})
+	}
+	if len(model.Techniques) > 0 {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTechniques,
+			Value: strings.Join(model.Techniques, ","),
+		})
+	}
+
+	return savedsearches.SavedSearchProperties{
+		Category:    huntingQueryCategory,
+		DisplayName: model.DisplayName,
+		Query:       model.Query,
+		Tags:        &tags,
+	}
+}
+
+func splitHuntingQueryTagValue(input string) []string {
+	result := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
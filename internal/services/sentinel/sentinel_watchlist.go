@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec used to detect changes to a Watchlist Item and not for security
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
+)
+
+// watchlistCSVRow is a single row of a Watchlist CSV, keyed by the value of the Watchlist's search key column
+type watchlistCSVRow struct {
+	values map[string]string
+	hash   string
+}
+
+// watchlistRemoteItem is a single Watchlist Item which exists in the Watchlist, keyed by the value of its search key
+type watchlistRemoteItem struct {
+	name string
+	hash string
+}
+
+// watchlistCSVContent returns the CSV content from either `items_csv` or the file referenced by `items_csv_file`.
+func watchlistCSVContent(itemsCSV, itemsCSVFile string) (string, error) {
+	if itemsCSVFile == "" {
+		return itemsCSV, nil
+	}
+
+	content, err := os.ReadFile(itemsCSVFile)
+	if err != nil {
+		return "", fmt.Errorf("reading %q: %+v", itemsCSVFile, err)
+	}
+	return string(content), nil
+}
+
+// parseWatchlistCSV parses the CSV content, where the first line contains the column names, into a map of the search
+// key value to the row, erroring if the search key column is missing or a search key value is empty or duplicated.
+func parseWatchlistCSV(content, searchKey string) (map[string]watchlistCSVRow, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("the CSV content must contain a header line with the column names")
+		}
+		return nil, fmt.Errorf("parsing the CSV header: %+v", err)
+	}
+
+	searchKeyIndex := -1
+	for i, column := range header {
+		if column == searchKey {
+			searchKeyIndex = i
+		}
+	}
+	if searchKeyIndex == -1 {
+		return nil, fmt.Errorf("the CSV header doesn't contain the `item_search_key` column %q", searchKey)
+	}
+
+	rows := make(map[string]watchlistCSVRow)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing the CSV content: %+v", err)
+		}
+
+		key := record[searchKeyIndex]
+		if key == "" {
+			line, _ := reader.FieldPos(searchKeyIndex)
+			return nil, fmt.Errorf("the value of the `item_search_key` column %q on line %d must not be empty", searchKey, line)
+		}
+		if _, exists := rows[key]; exists {
+			return nil, fmt.Errorf("the value %q of the `item_search_key` column %q is duplicated", key, searchKey)
+		}
+
+		values := make(map[string]string, len(header))
+		for i, column := range header {
+			values[column] = record[i]
+		}
+
+		hash, err := watchlistItemHash(values)
+		if err != nil {
+			return nil, err
+		}
+
+		rows[key] = watchlistCSVRow{
+			values: values,
+			hash:   hash,
+		}
+	}
+
+	return rows, nil
+}
+
+// watchlistItemHash returns the hex encoded MD5 of the column values of a Watchlist Item, which is used to detect which
+// rows have changed without storing the full content of the Watchlist in the state.
+func watchlistItemHash(values map[string]string) (string, error) {
+	// json.Marshal sorts the keys of the map, so the hash is independent of the order of the columns
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("marshalling Watchlist Item: %+v", err)
+	}
+
+	hash := md5.Sum(b) // nolint: gosec
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// listWatchlistRemoteItems returns a map of the search key value to each Watchlist Item in the Watchlist.
+func listWatchlistRemoteItems(ctx context.Context, client *watchlistitems.WatchlistItemsClient, id watchlists.WatchlistId, searchKey string) (map[string]watchlistRemoteItem, error) {
+	watchlistId := watchlistitems.NewWatchlistID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.WatchlistAlias)
+	resp, err := client.ListComplete(ctx, watchlistId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Items of %s: %+v", id, err)
+	}
+
+	items := make(map[string]watchlistRemoteItem)
+	for _, item := range resp.Items {
+		if item.Properties == nil || item.Name == nil {
+			continue
+		}
+
+		keyValues, ok := item.Properties.ItemsKeyValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := make(map[string]string, len(keyValues))
+		for k, v := range keyValues {
+			if s, ok := v.(string); ok {
+				values[k] = s
+			} else if v != nil {
+				values[k] = fmt.Sprint(v)
+			} else {
+				values[k] = ""
+			}
+		}
+
+		hash, err := watchlistItemHash(values)
+		if err != nil {
+			return nil, err
+		}
+
+		items[values[searchKey]] = watchlistRemoteItem{
+			name: *item.Name,
+			hash: hash,
+		}
+	}
+
+	return items, nil
+}
+
+// syncWatchlistItems creates, updates and deletes the Watchlist Items so that the Watchlist matches the CSV rows,
+// using the search key to match rows to the existing Watchlist Items.
+func syncWatchlistItems(ctx context.Context, client *watchlistitems.WatchlistItemsClient, id watchlists.WatchlistId, searchKey string, rows map[string]watchlistCSVRow) error {
+	remote, err := listWatchlistRemoteItems(ctx, client, id, searchKey)
+	if err != nil {
+		return err
+	}
+
+	for key, row := range rows {
+		name := uuid.New().String()
+		if existing, ok := remote[key]; ok {
+			if existing.hash == row.hash {
+				continue
+			}
+			name = existing.name
+		}
+
+		itemId := watchlistitems.NewWatchlistItemID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.WatchlistAlias, name)
+		param := watchlistitems.WatchlistItem{
+			Properties: &watchlistitems.WatchlistItemProperties{
+				ItemsKeyValue: row.values,
+			},
+		}
+		if _, err := client.CreateOrUpdate(ctx, itemId, param); err != nil {
+			return fmt.Errorf("creating/updating %s for the search key %q: %+v", itemId, key, err)
+		}
+	}
+
+	for key, existing := range remote {
+		if _, ok := rows[key]; ok {
+			continue
+		}
+
+		itemId := watchlistitems.NewWatchlistItemID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.WatchlistAlias, existing.name)
+		if _, err := client.Delete(ctx, itemId); err != nil {
+			return fmt.Errorf("deleting %s for the search key %q: %+v", itemId, key, err)
+		}
+	}
+
+	return nil
+}
+
+// watchlistUploadStatus returns the status of the bulk upload of the Watchlist's raw content.
+func watchlistUploadStatus(model *watchlists.Watchlist) string {
+	if model == nil || model.Properties == nil {
+		return ""
+	}
+	return pointer.From(model.Properties.UploadStatus)
+}
@@ -6,6 +6,7 @@ package sentinel
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -20,16 +21,22 @@ import (
 
 type WatchlistResource struct{}
 
-var _ sdk.Resource = WatchlistResource{}
+var (
+	_ sdk.ResourceWithUpdate        = WatchlistResource{}
+	_ sdk.ResourceWithCustomizeDiff = WatchlistResource{}
+)
 
 type WatchlistModel struct {
-	Name                    string   `tfschema:"name"`
-	LogAnalyticsWorkspaceId string   `tfschema:"log_analytics_workspace_id"`
-	DisplayName             string   `tfschema:"display_name"`
-	Description             string   `tfschema:"description"`
-	Labels                  []string `tfschema:"labels"`
-	DefaultDuration         string   `tfschema:"default_duration"`
-	ItemSearchKey           string   `tfschema:"item_search_key"`
+	Name                    string            `tfschema:"name"`
+	LogAnalyticsWorkspaceId string            `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string            `tfschema:"display_name"`
+	Description             string            `tfschema:"description"`
+	Labels                  []string          `tfschema:"labels"`
+	DefaultDuration         string            `tfschema:"default_duration"`
+	ItemSearchKey           string            `tfschema:"item_search_key"`
+	ItemsCSV                string            `tfschema:"items_csv"`
+	ItemsCSVFile            string            `tfschema:"items_csv_file"`
+	Items                   map[string]string `tfschema:"items"`
 }
 
 func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
@@ -79,11 +86,31 @@ func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: commonValidate.ISO8601Duration,
 		},
+		"items_csv": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"items_csv_file"},
+		},
+		"items_csv_file": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"items_csv"},
+		},
 	}
 }
 
 func (r WatchlistResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"items": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
 }
 
 func (r WatchlistResource) ResourceType() string {
@@ -146,10 +173,60 @@ func (r WatchlistResource) Create() sdk.ResourceFunc {
 				param.Properties.DefaultDuration = &model.DefaultDuration
 			}
 
+			bulk := model.ItemsCSV != "" || model.ItemsCSVFile != ""
+			if bulk {
+				content, err := watchlistCSVContent(model.ItemsCSV, model.ItemsCSVFile)
+				if err != nil {
+					return err
+				}
+				if _, err := parseWatchlistCSV(content, model.ItemSearchKey); err != nil {
+					return err
+				}
+
+				// the initial Items are uploaded in a single request, which is then processed asynchronously
+				param.Properties.Source = watchlists.SourceLocalFile
+				param.Properties.ContentType = pointer.To("text/csv")
+				param.Properties.NumberOfLinesToSkip = pointer.To(int64(0))
+				param.Properties.RawContent = pointer.To(content)
+			}
+
 			if _, err = client.CreateOrUpdate(ctx, id, param); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if bulk {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("context has no deadline")
+				}
+
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending: []string{"InProgress"},
+					Target:  []string{"Complete"},
+					Refresh: func() (interface{}, string, error) {
+						resp, err := client.Get(ctx, id)
+						if err != nil {
+							return resp, "", err
+						}
+
+						switch status := watchlistUploadStatus(resp.Model); {
+						case strings.EqualFold(status, "Complete"):
+							return resp, "Complete", nil
+						case strings.EqualFold(status, "Failed"):
+							return resp, "", fmt.Errorf("the upload of the Watchlist Items failed")
+						default:
+							return resp, "InProgress", nil
+						}
+					},
+					Timeout:    time.Until(deadline),
+					MinTimeout: 10 * time.Second,
+				}
+
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for the Items of %s to be uploaded: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -189,11 +266,114 @@ func (r WatchlistResource) Read() sdk.ResourceFunc {
 					watchList.DefaultDuration = pointer.From(props.DefaultDuration)
 				}
 			}
+
+			// the Items are only tracked when they're managed in bulk by this resource, since they can otherwise be
+			// managed individually using the `azurerm_sentinel_watchlist_item` resource
+			watchList.ItemsCSV = metadata.ResourceData.Get("items_csv").(string)
+			watchList.ItemsCSVFile = metadata.ResourceData.Get("items_csv_file").(string)
+			if watchList.ItemsCSV != "" || watchList.ItemsCSVFile != "" {
+				remote, err := listWatchlistRemoteItems(ctx, metadata.Client.Sentinel.WatchlistItemsClient, *id, watchList.ItemSearchKey)
+				if err != nil {
+					return err
+				}
+
+				watchList.Items = make(map[string]string, len(remote))
+				for key, item := range remote {
+					watchList.Items[key] = item.hash
+				}
+			}
+
 			return metadata.Encode(&watchList)
 		},
 	}
 }
 
+func (r WatchlistResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := watchlists.ParseWatchlistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WatchlistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// removing both `items_csv` and `items_csv_file` stops managing the Items, leaving the existing Items in place
+			if model.ItemsCSV == "" && model.ItemsCSVFile == "" {
+				return nil
+			}
+
+			content, err := watchlistCSVContent(model.ItemsCSV, model.ItemsCSVFile)
+			if err != nil {
+				return err
+			}
+			rows, err := parseWatchlistCSV(content, model.ItemSearchKey)
+			if err != nil {
+				return err
+			}
+
+			if err := syncWatchlistItems(ctx, metadata.Client.Sentinel.WatchlistItemsClient, *id, model.ItemSearchKey, rows); err != nil {
+				return fmt.Errorf("updating the Items of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WatchlistResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+			itemsCSV := diff.Get("items_csv").(string)
+			itemsCSVFile := diff.Get("items_csv_file").(string)
+			if !diff.NewValueKnown("items_csv") || !diff.NewValueKnown("items_csv_file") || !diff.NewValueKnown("item_search_key") {
+				return diff.SetNewComputed("items")
+			}
+			if itemsCSV == "" && itemsCSVFile == "" {
+				return nil
+			}
+
+			content, err := watchlistCSVContent(itemsCSV, itemsCSVFile)
+			if err != nil {
+				return err
+			}
+			rows, err := parseWatchlistCSV(content, diff.Get("item_search_key").(string))
+			if err != nil {
+				return err
+			}
+
+			// presenting the hash of each row keyed by the search key gives a diff of the Items which will be added,
+			// updated or removed, without storing the full content of the Watchlist in the state
+			items := make(map[string]interface{}, len(rows))
+			for key, row := range rows {
+				items[key] = row.hash
+			}
+
+			existing := diff.Get("items").(map[string]interface{})
+			if len(existing) == len(items) {
+				changed := false
+				for k, v := range items {
+					if existing[k] != v {
+						changed = true
+						break
+					}
+				}
+				if !changed {
+					return nil
+				}
+			}
+
+			return diff.SetNew("items", items)
+		},
+	}
+}
+
 func (r WatchlistResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -64,6 +64,48 @@ func TestAccWatchlist_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccWatchlist_itemsCSV(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.itemsCSV(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("items", "items_csv"),
+		{
+			Config: r.itemsCSVUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items.%").HasValue("3"),
+				check.That(data.ResourceName).Key("items.10.0.0.4").Exists(),
+				check.That(data.ResourceName).Key("items.10.0.0.2").DoesNotExist(),
+			),
+		},
+		data.ImportStep("items", "items_csv"),
+	})
+}
+
+func TestAccWatchlist_itemsCSVFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.itemsCSVFile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items.%").HasValue("4"),
+			),
+		},
+		data.ImportStep("items", "items_csv_file"),
+	})
+}
+
 func (r WatchlistResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.Sentinel.WatchlistsClient
 
@@ -128,6 +170,58 @@ resource "azurerm_sentinel_watchlist" "import" {
 `, template)
 }
 
+func (r WatchlistResource) itemsCSV(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "test"
+  item_search_key            = "IPAddress"
+  items_csv                  = <<CSV
+IPAddress,Description
+10.0.0.1,Gateway
+10.0.0.2,Jump Box
+10.0.0.3,Build Agent
+CSV
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WatchlistResource) itemsCSVUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "test"
+  item_search_key            = "IPAddress"
+  items_csv                  = <<CSV
+IPAddress,Description
+10.0.0.1,Gateway
+10.0.0.3,Build Agent (retired)
+10.0.0.4,Bastion
+CSV
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WatchlistResource) itemsCSVFile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "test"
+  item_search_key            = "Domain"
+  items_csv_file             = "testdata/watchlist_items.csv"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r WatchlistResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
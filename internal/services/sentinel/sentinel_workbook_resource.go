@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Sentinel Workbooks are Azure Monitor Workbooks in the `sentinel` category whose Source ID is the Log Analytics Workspace.
+const sentinelWorkbookCategory = "sentinel"
+
+type WorkbookResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkbookResource{}
+
+type WorkbookModel struct {
+	Name                    string            `tfschema:"name"`
+	LogAnalyticsWorkspaceId string            `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string            `tfschema:"display_name"`
+	DataJson                string            `tfschema:"data_json"`
+	Description             string            `tfschema:"description"`
+	Location                string            `tfschema:"location"`
+	Tags                    map[string]string `tfschema:"tags"`
+}
+
+func (r WorkbookResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.IsUUID,
+				validation.StringMatch(regexp.MustCompile(`^[^A-Z]*$`), "`name` must not contain upper case letters"),
+			),
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"data_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WorkbookResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+	}
+}
+
+func (r WorkbookResource) ModelObject() interface{} {
+	return &WorkbookModel{}
+}
+
+func (r WorkbookResource) ResourceType() string {
+	return "azurerm_sentinel_workbook"
+}
+
+func (r WorkbookResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workbooks.ValidateWorkbookID
+}
+
+func (r WorkbookResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+			workspaceClient := metadata.Client.LogAnalytics.WorkspaceClient
+
+			var model WorkbookModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			// the Workbook is created alongside the Workspace, so that it's listed in Sentinel for that Workspace
+			id := workbooks.NewWorkbookID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, model.Name)
+
+			existing, err := client.WorkbooksGet(ctx, id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(true)})
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			workspace, err := workspaceClient.Get(ctx, *workspaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+			}
+			if workspace.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", workspaceId)
+			}
+
+			sourceId := strings.ToLower(workspaceId.ID())
+			param := workbooks.Workbook{
+				Kind:     pointer.To(workbooks.WorkbookSharedTypeKindShared),
+				Location: location.Normalize(workspace.Model.Location),
+				Properties: &workbooks.WorkbookProperties{
+					Category:       sentinelWorkbookCategory,
+					DisplayName:    model.DisplayName,
+					SerializedData: model.DataJson,
+					SourceId:       pointer.To(sourceId),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.Description != "" {
+				param.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.WorkbooksCreateOrUpdate(ctx, id, param, workbooks.WorkbooksCreateOrUpdateOperationOptions{SourceId: pointer.To(sourceId)}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkbookResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(true)})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := WorkbookModel{
+				Name: id.WorkbookName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					if !strings.EqualFold(props.Category, sentinelWorkbookCategory) {
+						return fmt.Errorf("%s is not a Sentinel Workbook, expected the category to be %q but got %q", id, sentinelWorkbookCategory, props.Category)
+					}
+
+					workspaceId, err := workspaces.ParseWorkspaceIDInsensitively(pointer.From(props.SourceId))
+					if err != nil {
+						return fmt.Errorf("parsing the Source ID of %s as a Log Analytics Workspace ID: %+v", id, err)
+					}
+					state.LogAnalyticsWorkspaceId = workspaceId.ID()
+					// the Source ID is stored in lower case, so the casing from the configuration is kept when it matches
+					if v := metadata.ResourceData.Get("log_analytics_workspace_id").(string); strings.EqualFold(v, state.LogAnalyticsWorkspaceId) {
+						state.LogAnalyticsWorkspaceId = v
+					}
+
+					state.DisplayName = props.DisplayName
+					state.DataJson = props.SerializedData
+					state.Description = pointer.From(props.Description)
+				}
+
+				if model.Tags != nil {
+					// the service adds a `hidden-title` tag matching the `display_name`, which is removed to avoid a diff
+					delete(*model.Tags, "hidden-title")
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkbookResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkbookModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(true)})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			param := *existing.Model
+
+			if metadata.ResourceData.HasChange("display_name") {
+				param.Properties.DisplayName = model.DisplayName
+				if param.Tags != nil {
+					delete(*param.Tags, "hidden-title")
+				}
+			}
+
+			if metadata.ResourceData.HasChange("data_json") {
+				param.Properties.SerializedData = model.DataJson
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				param.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				param.Tags = pointer.To(model.Tags)
+			}
+
+			if _, err := client.WorkbooksCreateOrUpdate(ctx, *id, param, workbooks.WorkbooksCreateOrUpdateOperationOptions{SourceId: param.Properties.SourceId}); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkbookResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkbooksDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
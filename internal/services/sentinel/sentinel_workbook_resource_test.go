@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WorkbookResource struct{}
+
+func TestAccSentinelWorkbook_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workbook", "test")
+	r := WorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("location").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWorkbook_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workbook", "test")
+	r := WorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelWorkbook_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workbook", "test")
+	r := WorkbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkbookResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workbooks.ParseWorkbookID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppInsights.WorkbookClient.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r WorkbookResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workbook" "test" {
+  name                       = "7f4d2c1a-5b3e-4f6a-9c8d-0e1f2a3b4c5d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "acctest-%d"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "Test"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkbookResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workbook" "test" {
+  name                       = "7f4d2c1a-5b3e-4f6a-9c8d-0e1f2a3b4c5d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "acctest-updated-%d"
+  description                = "Sentinel workbook for acceptance tests"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "Updated"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false
+  })
+
+  tags = {
+    env = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkbookResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workbook" "import" {
+  name                       = azurerm_sentinel_workbook.test.name
+  log_analytics_workspace_id = azurerm_sentinel_workbook.test.log_analytics_workspace_id
+  display_name               = azurerm_sentinel_workbook.test.display_name
+  data_json                  = azurerm_sentinel_workbook.test.data_json
+}
+`, r.basic(data))
+}
+
+func (r WorkbookResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -38,6 +38,10 @@ type BlobUpload struct {
 	Source          string
 	SourceContent   string
 	SourceUri       string
+
+	// requestSlots optionally bounds the number of concurrent block requests (and so the number of
+	// block buffers held in memory) across all of the uploads which share it
+	requestSlots chan struct{}
 }
 
 func (sbu BlobUpload) Create(ctx context.Context) error {
@@ -179,6 +183,171 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	return nil
 }
 
+const (
+	// blocks are uploaded in 8MB chunks, files at or below this size are uploaded in a single request
+	blockBlobBlockSize int64 = 8 * 1024 * 1024
+
+	// the maximum number of blocks of a single file which are uploaded concurrently
+	blockBlobMaxWorkersPerFile = 4
+)
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+// uploadBlockBlobInBlocks uploads the Source file as a series of blocks in parallel, which are
+// then committed in order via a single Put Block List request.
+func (sbu BlobUpload) uploadBlockBlobInBlocks(ctx context.Context) error {
+	file, err := os.Open(sbu.Source)
+	if err != nil {
+		return fmt.Errorf("opening: %s", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	fileSize := info.Size()
+	if fileSize <= blockBlobBlockSize {
+		input := blobs.PutBlockBlobInput{
+			ContentType: pointer.To(sbu.ContentType),
+			MetaData:    sbu.MetaData,
+		}
+		if sbu.CacheControl != "" {
+			input.CacheControl = pointer.To(sbu.CacheControl)
+		}
+		if sbu.ContentMD5 != "" {
+			input.ContentMD5 = pointer.To(sbu.ContentMD5)
+		}
+		if sbu.EncryptionScope != "" {
+			input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+		}
+
+		sbu.acquireRequestSlot()
+		defer sbu.releaseRequestSlot()
+		if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
+			return fmt.Errorf("PutBlockBlobFromFile: %s", err)
+		}
+
+		return nil
+	}
+
+	blockCount := (fileSize + blockBlobBlockSize - 1) / blockBlobBlockSize
+	blockIds := make([]blobs.BlockID, 0, blockCount)
+	blocks := make(chan storageBlobBlock, blockCount)
+	for i := int64(0); i < blockCount; i++ {
+		// Block IDs must all be the same length within a Blob, and be Base64 encoded
+		blockId := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+		blockIds = append(blockIds, blobs.BlockID{Value: blockId})
+
+		offset := i * blockBlobBlockSize
+		length := blockBlobBlockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+		blocks <- storageBlobBlock{
+			id:      blockId,
+			section: io.NewSectionReader(file, offset, length),
+		}
+	}
+	close(blocks)
+
+	errors := make(chan error, blockCount)
+	wg := &sync.WaitGroup{}
+	wg.Add(int(blockCount))
+
+	workerCount := sbu.Parallelism
+	if workerCount > blockBlobMaxWorkersPerFile {
+		workerCount = blockBlobMaxWorkersPerFile
+	}
+	if int64(workerCount) > blockCount {
+		workerCount = int(blockCount)
+	}
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blocks, errors, wg)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: pointer.To(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.CacheControl != "" {
+		input.CacheControl = pointer.To(sbu.CacheControl)
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = pointer.To(sbu.ContentMD5)
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, blocks chan storageBlobBlock, errors chan error, wg *sync.WaitGroup) {
+	for block := range blocks {
+		if err := sbu.uploadBlock(ctx, block); err != nil {
+			errors <- err
+		}
+		wg.Done()
+	}
+}
+
+func (sbu BlobUpload) uploadBlock(ctx context.Context, block storageBlobBlock) error {
+	// the slot is held whilst the block is buffered, bounding the memory used across all uploads
+	sbu.acquireRequestSlot()
+	defer sbu.releaseRequestSlot()
+
+	chunk := make([]byte, block.section.Size())
+	if _, err := io.ReadFull(block.section, chunk); err != nil {
+		return fmt.Errorf("reading source file %q for block %q: %s", sbu.Source, block.id, err)
+	}
+
+	input := blobs.PutBlockInput{
+		BlockID: block.id,
+		Content: chunk,
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+
+	if _, err := sbu.Client.PutBlock(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) acquireRequestSlot() {
+	if sbu.requestSlots != nil {
+		sbu.requestSlots <- struct{}{}
+	}
+}
+
+func (sbu BlobUpload) releaseRequestSlot() {
+	if sbu.requestSlots != nil {
+		<-sbu.requestSlots
+	}
+}
+
 func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
 	if sbu.Size == 0 {
 		return errors.New("`size` cannot be zero for a page blob")
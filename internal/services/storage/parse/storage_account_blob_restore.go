@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageAccountBlobRestoreId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	BlobRestoreName    string
+}
+
+func NewStorageAccountBlobRestoreID(subscriptionId, resourceGroup, storageAccountName, blobRestoreName string) StorageAccountBlobRestoreId {
+	return StorageAccountBlobRestoreId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		BlobRestoreName:    blobRestoreName,
+	}
+}
+
+func (id StorageAccountBlobRestoreId) String() string {
+	segments := []string{
+		fmt.Sprintf("Blob Restore Name %q", id.BlobRestoreName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Blob Restore", segmentsStr)
+}
+
+func (id StorageAccountBlobRestoreId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobRestores/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobRestoreName)
+}
+
+// StorageAccountBlobRestoreID parses a StorageAccountBlobRestore ID into an StorageAccountBlobRestoreId struct
+func StorageAccountBlobRestoreID(input string) (*StorageAccountBlobRestoreId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageAccountBlobRestore ID: %+v", input, err)
+	}
+
+	resourceId := StorageAccountBlobRestoreId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobRestoreName, err = id.PopSegment("blobRestores"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = StorageBlobsId{}
+
+// StorageBlobsId is used by the plural resource azurerm_storage_blobs, which manages every Blob
+// within a Storage Container sharing the same (optionally empty) name prefix.
+type StorageBlobsId struct {
+	StorageContainerId commonids.StorageContainerId
+	Prefix             string
+}
+
+func NewStorageBlobsID(containerId commonids.StorageContainerId, prefix string) StorageBlobsId {
+	return StorageBlobsId{
+		StorageContainerId: containerId,
+		Prefix:             prefix,
+	}
+}
+
+func (id StorageBlobsId) String() string {
+	components := []string{
+		fmt.Sprintf("Prefix %q", id.Prefix),
+		id.StorageContainerId.String(),
+	}
+	return fmt.Sprintf("Storage Blobs (%s)", strings.Join(components, " / "))
+}
+
+func (id StorageBlobsId) ID() string {
+	return fmt.Sprintf("%s|%s", id.StorageContainerId.ID(), id.Prefix)
+}
+
+// StorageBlobsID parses a StorageBlobs ID into an StorageBlobsId struct
+func StorageBlobsID(input string) (*StorageBlobsId, error) {
+	segments := strings.SplitN(input, "|", 2)
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{storageContainerId}|{prefix}` but got %q", input)
+	}
+
+	containerId, err := commonids.ParseStorageContainerID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Storage Container ID: %+v", segments[0], err)
+	}
+
+	return &StorageBlobsId{
+		StorageContainerId: *containerId,
+		Prefix:             segments[1],
+	}, nil
+}
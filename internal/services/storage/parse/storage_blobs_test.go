@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageBlobsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageBlobsId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing prefix separator
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1",
+			Error: true,
+		},
+
+		{
+			// invalid container id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1|site/",
+			Error: true,
+		},
+
+		{
+			// empty prefix
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1|",
+			Expected: &StorageBlobsId{
+				Prefix: "",
+			},
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1|site/assets/",
+			Expected: &StorageBlobsId{
+				Prefix: "site/assets/",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageBlobsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.StorageContainerId.StorageAccountName != "storageAccount1" {
+			t.Fatalf("Expected %q but got %q for StorageAccountName", "storageAccount1", actual.StorageContainerId.StorageAccountName)
+		}
+		if actual.StorageContainerId.ContainerName != "container1" {
+			t.Fatalf("Expected %q but got %q for ContainerName", "container1", actual.StorageContainerId.ContainerName)
+		}
+		if actual.Prefix != v.Expected.Prefix {
+			t.Fatalf("Expected %q but got %q for Prefix", v.Expected.Prefix, actual.Prefix)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
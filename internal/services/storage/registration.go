@@ -82,10 +82,15 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		AccountBlobPropertiesResource{},
+		AccountFilePropertiesResource{},
 		AccountQueuePropertiesResource{},
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
+		StorageAccountBlobRestoreResource{},
+		StorageBlobsResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageContainerRestoreResource{},
 		SyncServerEndpointResource{},
 	}
 }
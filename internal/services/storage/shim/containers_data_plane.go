@@ -6,9 +6,12 @@ package shim
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/containers"
 )
 
@@ -69,6 +72,88 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, containerName
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, containerName string, prefix string) (*[]containers.BlobDetails, error) {
+	input := containers.ListBlobsInput{
+		MaxResults: pointer.To(5000),
+	}
+	if prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	results := make([]containers.BlobDetails, 0)
+	for {
+		resp, err := w.client.ListBlobs(ctx, containerName, input)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		results = append(results, resp.Blobs.Blobs...)
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return &results, nil
+}
+
+// Restore restores a soft-deleted container, this isn't supported by the Containers client so the request is built here.
+func (w DataPlaneStorageContainerWrapper) Restore(ctx context.Context, containerName string, deletedVersion string) error {
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: restoreContainerOptions{
+			containerName:  containerName,
+			deletedVersion: deletedVersion,
+		},
+		Path: fmt.Sprintf("/%s", containerName),
+	}
+
+	req, err := w.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if _, err := req.Execute(ctx); err != nil {
+		return fmt.Errorf("executing request: %+v", err)
+	}
+
+	return nil
+}
+
+var _ client.Options = restoreContainerOptions{}
+
+type restoreContainerOptions struct {
+	containerName  string
+	deletedVersion string
+}
+
+func (o restoreContainerOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-deleted-container-name", o.containerName)
+	headers.Append("x-ms-deleted-container-version", o.deletedVersion)
+	return headers
+}
+
+func (o restoreContainerOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o restoreContainerOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "container")
+	out.Append("comp", "undelete")
+	return out
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error {
 	input := containers.SetAccessControlInput{
 		AccessLevel: level,
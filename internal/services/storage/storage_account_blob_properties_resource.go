@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AccountBlobPropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountBlobPropertiesResource{}
+
+type AccountBlobPropertiesModel struct {
+	StorageAccountId               string                                                `tfschema:"storage_account_id"`
+	ChangeFeedEnabled              bool                                                  `tfschema:"change_feed_enabled"`
+	ChangeFeedRetentionInDays      int64                                                 `tfschema:"change_feed_retention_in_days"`
+	ContainerDeleteRetentionPolicy []AccountBlobPropertiesContainerDeleteRetentionPolicy `tfschema:"container_delete_retention_policy"`
+	CorsRule                       []AccountServicePropertiesCorsRule                    `tfschema:"cors_rule"`
+	DefaultServiceVersion          string                                                `tfschema:"default_service_version"`
+	DeleteRetentionPolicy          []AccountBlobPropertiesDeleteRetentionPolicy          `tfschema:"delete_retention_policy"`
+	LastAccessTimeEnabled          bool                                                  `tfschema:"last_access_time_enabled"`
+	RestorePolicy                  []AccountBlobPropertiesRestorePolicy                  `tfschema:"restore_policy"`
+	VersioningEnabled              bool                                                  `tfschema:"versioning_enabled"`
+}
+
+type AccountBlobPropertiesContainerDeleteRetentionPolicy struct {
+	Days int64 `tfschema:"days"`
+}
+
+type AccountBlobPropertiesDeleteRetentionPolicy struct {
+	Days                   int64 `tfschema:"days"`
+	PermanentDeleteEnabled bool  `tfschema:"permanent_delete_enabled"`
+}
+
+type AccountBlobPropertiesRestorePolicy struct {
+	Days int64 `tfschema:"days"`
+}
+
+func (r AccountBlobPropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := schemaStorageAccountBlobProperties("")
+	arguments["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: commonids.ValidateStorageAccountID,
+	}
+
+	return arguments
+}
+
+func (r AccountBlobPropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountBlobPropertiesResource) ModelObject() interface{} {
+	return &AccountBlobPropertiesModel{}
+}
+
+func (r AccountBlobPropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_blob_properties"
+}
+
+func (r AccountBlobPropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountBlobPropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			var model AccountBlobPropertiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			if !account.supportLevel.supportBlob {
+				return fmt.Errorf("Blob Properties aren't supported for account kind %q in sku tier %q", account.kind, account.tier)
+			}
+
+			payload, err := expandStorageAccountBlobProperties(model, *account)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.BlobService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("creating Blob Properties for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AccountBlobPropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := client.StorageAccounts.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			resp, err := client.BlobService.GetServiceProperties(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving Blob Properties for %s: %+v", *id, err)
+			}
+
+			state := AccountBlobPropertiesModel{
+				StorageAccountId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if changeFeed := props.ChangeFeed; changeFeed != nil {
+						state.ChangeFeedEnabled = pointer.From(changeFeed.Enabled)
+						state.ChangeFeedRetentionInDays = pointer.From(changeFeed.RetentionInDays)
+					}
+
+					if policy := props.ContainerDeleteRetentionPolicy; policy != nil && pointer.From(policy.Enabled) {
+						state.ContainerDeleteRetentionPolicy = []AccountBlobPropertiesContainerDeleteRetentionPolicy{
+							{
+								Days: pointer.From(policy.Days),
+							},
+						}
+					}
+
+					if cors := props.Cors; cors != nil && cors.CorsRules != nil {
+						for _, rule := range *cors.CorsRules {
+							allowedMethods := make([]string, 0)
+							for _, method := range rule.AllowedMethods {
+								allowedMethods = append(allowedMethods, string(method))
+							}
+
+							state.CorsRule = append(state.CorsRule, AccountServicePropertiesCorsRule{
+								AllowedHeaders: rule.AllowedHeaders,
+								AllowedMethods: allowedMethods,
+								AllowedOrigins: rule.AllowedOrigins,
+								ExposedHeaders: rule.ExposedHeaders,
+								MaxAgeSeconds:  rule.MaxAgeInSeconds,
+							})
+						}
+					}
+
+					state.DefaultServiceVersion = pointer.From(props.DefaultServiceVersion)
+
+					if policy := props.DeleteRetentionPolicy; policy != nil && pointer.From(policy.Enabled) {
+						state.DeleteRetentionPolicy = []AccountBlobPropertiesDeleteRetentionPolicy{
+							{
+								Days:                   pointer.From(policy.Days),
+								PermanentDeleteEnabled: pointer.From(policy.AllowPermanentDelete),
+							},
+						}
+					}
+
+					if policy := props.LastAccessTimeTrackingPolicy; policy != nil {
+						state.LastAccessTimeEnabled = policy.Enable
+					}
+
+					if policy := props.RestorePolicy; policy != nil && policy.Enabled {
+						state.RestorePolicy = []AccountBlobPropertiesRestorePolicy{
+							{
+								Days: pointer.From(policy.Days),
+							},
+						}
+					}
+
+					state.VersioningEnabled = pointer.From(props.IsVersioningEnabled)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AccountBlobPropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			var model AccountBlobPropertiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandStorageAccountBlobProperties(model, *account)
+			if err != nil {
+				return err
+			}
+
+			// The Restore Policy has to be disabled before the features it depends on (e.g. Versioning / Change Feed) can be
+			if metadata.ResourceData.HasChange("restore_policy") && len(model.RestorePolicy) == 0 {
+				if err := disableStorageAccountBlobRestorePolicy(ctx, client.BlobService, *id); err != nil {
+					return err
+				}
+			}
+
+			if _, err := client.BlobService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating Blob Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountBlobPropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			var model AccountBlobPropertiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			// Blob Properties can't be deleted, so we'll reset them back to the defaults instead
+			if len(model.RestorePolicy) > 0 {
+				if err := disableStorageAccountBlobRestorePolicy(ctx, client.BlobService, *id); err != nil {
+					return err
+				}
+			}
+
+			payload, err := expandAccountBlobServiceProperties(account.kind, []interface{}{})
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.BlobService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("resetting Blob Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageAccountBlobProperties(input AccountBlobPropertiesModel, account storageAccountForServicePropertiesResult) (*blobservice.BlobServiceProperties, error) {
+	props := blobservice.BlobServicePropertiesProperties{
+		ContainerDeleteRetentionPolicy: &blobservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(false),
+		},
+		Cors: &blobservice.CorsRules{
+			CorsRules: &[]blobservice.CorsRule{},
+		},
+		DeleteRetentionPolicy: &blobservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(false),
+		},
+	}
+
+	if len(input.ContainerDeleteRetentionPolicy) > 0 {
+		props.ContainerDeleteRetentionPolicy = &blobservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(true),
+			Days:    pointer.To(input.ContainerDeleteRetentionPolicy[0].Days),
+		}
+	}
+
+	for _, rule := range input.CorsRule {
+		allowedMethods := make([]blobservice.AllowedMethods, 0)
+		for _, method := range rule.AllowedMethods {
+			allowedMethods = append(allowedMethods, blobservice.AllowedMethods(method))
+		}
+
+		*props.Cors.CorsRules = append(*props.Cors.CorsRules, blobservice.CorsRule{
+			AllowedHeaders:  rule.AllowedHeaders,
+			AllowedMethods:  allowedMethods,
+			AllowedOrigins:  rule.AllowedOrigins,
+			ExposedHeaders:  rule.ExposedHeaders,
+			MaxAgeInSeconds: rule.MaxAgeSeconds,
+		})
+	}
+
+	if input.DefaultServiceVersion != "" {
+		props.DefaultServiceVersion = pointer.To(input.DefaultServiceVersion)
+	}
+
+	if len(input.DeleteRetentionPolicy) > 0 {
+		props.DeleteRetentionPolicy = &blobservice.DeleteRetentionPolicy{
+			Enabled:              pointer.To(true),
+			AllowPermanentDelete: pointer.To(input.DeleteRetentionPolicy[0].PermanentDeleteEnabled),
+			Days:                 pointer.To(input.DeleteRetentionPolicy[0].Days),
+		}
+	}
+
+	// `Storage` (v1) kind doesn't support Last Access Time Tracking, Change Feed, Versioning or the Restore Policy
+	if account.kind == storageaccounts.KindStorage {
+		unsupported := make([]string, 0)
+		if input.LastAccessTimeEnabled {
+			unsupported = append(unsupported, "`last_access_time_enabled`")
+		}
+		if input.ChangeFeedEnabled {
+			unsupported = append(unsupported, "`change_feed_enabled`")
+		}
+		if input.ChangeFeedRetentionInDays != 0 {
+			unsupported = append(unsupported, "`change_feed_retention_in_days`")
+		}
+		if len(input.RestorePolicy) > 0 {
+			unsupported = append(unsupported, "`restore_policy`")
+		}
+		if input.VersioningEnabled {
+			unsupported = append(unsupported, "`versioning_enabled`")
+		}
+		if len(unsupported) > 0 {
+			return nil, fmt.Errorf("%s can't be configured when the `kind` of the Storage Account is `Storage` (v1)", strings.Join(unsupported, ", "))
+		}
+
+		return &blobservice.BlobServiceProperties{
+			Properties: &props,
+		}, nil
+	}
+
+	props.ChangeFeed = &blobservice.ChangeFeed{
+		Enabled: pointer.To(input.ChangeFeedEnabled),
+	}
+	if input.ChangeFeedRetentionInDays != 0 {
+		props.ChangeFeed.RetentionInDays = pointer.To(input.ChangeFeedRetentionInDays)
+	}
+	props.IsVersioningEnabled = pointer.To(input.VersioningEnabled)
+	props.LastAccessTimeTrackingPolicy = &blobservice.LastAccessTimeTrackingPolicy{
+		Enable: input.LastAccessTimeEnabled,
+	}
+	props.RestorePolicy = &blobservice.RestorePolicyProperties{
+		Enabled: false,
+	}
+
+	// See: https://learn.microsoft.com/en-us/azure/storage/blobs/versioning-overview#how-blob-versioning-works
+	if input.VersioningEnabled && account.isHnsEnabled {
+		return nil, fmt.Errorf("`versioning_enabled` can't be true when `is_hns_enabled` is true for the Storage Account")
+	}
+
+	if len(input.RestorePolicy) > 0 {
+		// Ref: https://learn.microsoft.com/en-us/azure/storage/blobs/point-in-time-restore-overview#prerequisites-for-point-in-time-restore
+		if !input.ChangeFeedEnabled {
+			return nil, fmt.Errorf("`change_feed_enabled` must be `true` when `restore_policy` is set")
+		}
+		if !input.VersioningEnabled {
+			return nil, fmt.Errorf("`versioning_enabled` must be `true` when `restore_policy` is set")
+		}
+		// the restore policy feature is incompatible with partitioned DNS
+		if account.dnsEndpointType == storageaccounts.DnsEndpointTypeAzureDnsZone {
+			return nil, fmt.Errorf("`restore_policy` can't be set when `dns_endpoint_type` is set to `%s` for the Storage Account", storageaccounts.DnsEndpointTypeAzureDnsZone)
+		}
+
+		props.RestorePolicy = &blobservice.RestorePolicyProperties{
+			Enabled: true,
+			Days:    pointer.To(input.RestorePolicy[0].Days),
+		}
+	}
+
+	return &blobservice.BlobServiceProperties{
+		Properties: &props,
+	}, nil
+}
+
+func disableStorageAccountBlobRestorePolicy(ctx context.Context, client *blobservice.BlobServiceClient, id commonids.StorageAccountId) error {
+	payload := blobservice.BlobServiceProperties{
+		Properties: &blobservice.BlobServicePropertiesProperties{
+			RestorePolicy: &blobservice.RestorePolicyProperties{
+				Enabled: false,
+			},
+		},
+	}
+	if _, err := client.SetServiceProperties(ctx, id, payload); err != nil {
+		return fmt.Errorf("disabling `restore_policy` for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+type storageAccountForServicePropertiesResult struct {
+	kind            storageaccounts.Kind
+	tier            storageaccounts.SkuTier
+	isHnsEnabled    bool
+	dnsEndpointType storageaccounts.DnsEndpointType
+	supportLevel    storageAccountServiceSupportLevel
+}
+
+// storageAccountForServiceProperties retrieves the details of the Storage Account required to determine which
+// Service Properties are supported, for the resources managing these outside of `azurerm_storage_account`.
+func storageAccountForServiceProperties(ctx context.Context, client *storageaccounts.StorageAccountsClient, id commonids.StorageAccountId) (*storageAccountForServicePropertiesResult, error) {
+	resp, err := client.GetProperties(ctx, id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s was not found", id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	model := resp.Model
+	if model.Sku.Tier == nil || string(model.Sku.Name) == "" {
+		return nil, fmt.Errorf("could not read SKU details for %s", id)
+	}
+
+	replicationTypeParts := strings.Split(string(model.Sku.Name), "_")
+	if len(replicationTypeParts) != 2 {
+		return nil, fmt.Errorf("could not read SKU replication type for %s", id)
+	}
+
+	result := storageAccountForServicePropertiesResult{
+		kind: pointer.From(model.Kind),
+		tier: pointer.From(model.Sku.Tier),
+	}
+	if props := model.Properties; props != nil {
+		result.isHnsEnabled = pointer.From(props.IsHnsEnabled)
+		result.dnsEndpointType = pointer.From(props.DnsEndpointType)
+	}
+	result.supportLevel = availableFunctionalityForAccount(result.kind, result.tier, replicationTypeParts[1])
+
+	return &result, nil
+}
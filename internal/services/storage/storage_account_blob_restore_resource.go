@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageAccountBlobRestoreResource struct{}
+
+var _ sdk.Resource = StorageAccountBlobRestoreResource{}
+
+type StorageAccountBlobRestoreModel struct {
+	StorageAccountId string                           `tfschema:"storage_account_id"`
+	TimeToRestore    string                           `tfschema:"time_to_restore"`
+	BlobRange        []StorageAccountBlobRestoreRange `tfschema:"blob_range"`
+	Triggers         map[string]string                `tfschema:"triggers"`
+	RestoreId        string                           `tfschema:"restore_id"`
+	Status           string                           `tfschema:"status"`
+	FailureReason    string                           `tfschema:"failure_reason"`
+}
+
+type StorageAccountBlobRestoreRange struct {
+	StartRange string `tfschema:"start_range"`
+	EndRange   string `tfschema:"end_range"`
+}
+
+func (r StorageAccountBlobRestoreResource) ResourceType() string {
+	return "azurerm_storage_account_blob_restore"
+}
+
+func (r StorageAccountBlobRestoreResource) ModelObject() interface{} {
+	return &StorageAccountBlobRestoreModel{}
+}
+
+func (r StorageAccountBlobRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountBlobRestoreID
+}
+
+func (r StorageAccountBlobRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"time_to_restore": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"blob_range": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_range": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"end_range": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageAccountBlobRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restore_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"failure_reason": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageAccountBlobRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			var model StorageAccountBlobRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			payload := storageaccounts.BlobRestoreParameters{
+				BlobRanges:    make([]storageaccounts.BlobRestoreRange, 0),
+				TimeToRestore: model.TimeToRestore,
+			}
+			for _, v := range model.BlobRange {
+				payload.BlobRanges = append(payload.BlobRanges, storageaccounts.BlobRestoreRange{
+					StartRange: v.StartRange,
+					EndRange:   v.EndRange,
+				})
+			}
+
+			result, err := client.RestoreBlobRanges(ctx, *accountId, payload)
+			if err != nil {
+				return fmt.Errorf("restoring Blob Ranges for %s: %+v", *accountId, err)
+			}
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the restore of Blob Ranges for %s: %+v", *accountId, err)
+			}
+
+			// the status is taken from the result of this operation, since the Storage Account only exposes the most recent restore
+			var status storageaccounts.BlobRestoreStatus
+			if err := result.Poller.FinalResult(&status); err != nil {
+				return fmt.Errorf("retrieving the result of the restore of Blob Ranges for %s: %+v", *accountId, err)
+			}
+			if pointer.From(status.RestoreId) == "" {
+				return fmt.Errorf("retrieving the result of the restore of Blob Ranges for %s: `restoreId` was nil", *accountId)
+			}
+
+			id := parse.NewStorageAccountBlobRestoreID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, *status.RestoreId)
+
+			if pointer.From(status.Status) == storageaccounts.BlobRestoreProgressStatusFailed {
+				return fmt.Errorf("restoring Blob Ranges for %s: restore %q failed: %s", *accountId, id.BlobRestoreName, pointer.From(status.FailureReason))
+			}
+
+			model.RestoreId = id.BlobRestoreName
+			model.Status = string(pointer.From(status.Status))
+			model.FailureReason = pointer.From(status.FailureReason)
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageAccountBlobRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			id, err := parse.StorageAccountBlobRestoreID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+			var state StorageAccountBlobRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := storageaccounts.GetPropertiesOperationOptions{
+				Expand: pointer.To(storageaccounts.StorageAccountExpandBlobRestoreStatus),
+			}
+			resp, err := client.GetProperties(ctx, accountId, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+
+			state.StorageAccountId = accountId.ID()
+			state.RestoreId = id.BlobRestoreName
+
+			// only the most recent restore is returned, so the details of older restores are retained from the state
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if status := model.Properties.BlobRestoreStatus; status != nil && pointer.From(status.RestoreId) == id.BlobRestoreName {
+					state.Status = string(pointer.From(status.Status))
+					state.FailureReason = pointer.From(status.FailureReason)
+
+					// the API may normalise the timestamp, so this is only populated when importing
+					if params := status.Parameters; params != nil && state.TimeToRestore == "" {
+						state.TimeToRestore = params.TimeToRestore
+						state.BlobRange = make([]StorageAccountBlobRestoreRange, 0)
+						for _, v := range params.BlobRanges {
+							state.BlobRange = append(state.BlobRange, StorageAccountBlobRestoreRange{
+								StartRange: v.StartRange,
+								EndRange:   v.EndRange,
+							})
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountBlobRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountBlobRestoreID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a Blob Restore can't be undone, so this only removes the resource from the state
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+
+			return nil
+		},
+	}
+}
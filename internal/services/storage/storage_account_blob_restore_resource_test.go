@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageAccountBlobRestoreResource struct{}
+
+func TestAccStorageAccountBlobRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_blob_restore", "test")
+	r := StorageAccountBlobRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restore_id").IsSet(),
+				check.That(data.ResourceName).Key("status").HasValue(string(storageaccounts.BlobRestoreProgressStatusComplete)),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r StorageAccountBlobRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountBlobRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+	options := storageaccounts.GetPropertiesOperationOptions{
+		Expand: pointer.To(storageaccounts.StorageAccountExpandBlobRestoreStatus),
+	}
+	resp, err := client.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, accountId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.BlobRestoreStatus == nil {
+		return pointer.To(false), nil
+	}
+
+	return pointer.To(pointer.From(resp.Model.Properties.BlobRestoreStatus.RestoreId) == id.BlobRestoreName), nil
+}
+
+func (r StorageAccountBlobRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    restore_policy {
+      days = 6
+    }
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "hello world"
+}
+
+resource "time_sleep" "before" {
+  create_duration = "2m"
+
+  depends_on = [azurerm_storage_blob.test]
+}
+
+resource "time_static" "test" {
+  depends_on = [time_sleep.before]
+}
+
+resource "time_sleep" "after" {
+  create_duration = "2m"
+
+  depends_on = [time_static.test]
+}
+
+resource "azurerm_storage_account_blob_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  time_to_restore    = time_static.test.rfc3339
+
+  blob_range {
+    start_range = "test/"
+    end_range   = "test/z"
+  }
+
+  triggers = {
+    drill = "1"
+  }
+
+  depends_on = [time_sleep.after]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
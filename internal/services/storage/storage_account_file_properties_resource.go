@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AccountFilePropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountFilePropertiesResource{}
+
+type AccountFilePropertiesModel struct {
+	StorageAccountId string                                 `tfschema:"storage_account_id"`
+	CorsRule         []AccountServicePropertiesCorsRule     `tfschema:"cors_rule"`
+	RetentionPolicy  []AccountFilePropertiesRetentionPolicy `tfschema:"retention_policy"`
+	Smb              []AccountFilePropertiesSmb             `tfschema:"smb"`
+}
+
+type AccountFilePropertiesRetentionPolicy struct {
+	Days int64 `tfschema:"days"`
+}
+
+type AccountFilePropertiesSmb struct {
+	AuthenticationTypes          []string `tfschema:"authentication_types"`
+	ChannelEncryptionType        []string `tfschema:"channel_encryption_type"`
+	KerberosTicketEncryptionType []string `tfschema:"kerberos_ticket_encryption_type"`
+	MultichannelEnabled          bool     `tfschema:"multichannel_enabled"`
+	Versions                     []string `tfschema:"versions"`
+}
+
+func (r AccountFilePropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := schemaStorageAccountShareProperties()
+	arguments["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: commonids.ValidateStorageAccountID,
+	}
+
+	return arguments
+}
+
+func (r AccountFilePropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountFilePropertiesResource) ModelObject() interface{} {
+	return &AccountFilePropertiesModel{}
+}
+
+func (r AccountFilePropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_file_properties"
+}
+
+func (r AccountFilePropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountFilePropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			var model AccountFilePropertiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			if !account.supportLevel.supportShare {
+				return fmt.Errorf("File Properties aren't supported for account kind %q in sku tier %q", account.kind, account.tier)
+			}
+
+			payload, err := expandStorageAccountFileProperties(model, account.tier)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.FileService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("creating File Properties for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AccountFilePropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := client.StorageAccounts.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			resp, err := client.FileService.GetServiceProperties(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving File Properties for %s: %+v", *id, err)
+			}
+
+			state := AccountFilePropertiesModel{
+				StorageAccountId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if cors := props.Cors; cors != nil && cors.CorsRules != nil {
+						for _, rule := range *cors.CorsRules {
+							allowedMethods := make([]string, 0)
+							for _, method := range rule.AllowedMethods {
+								allowedMethods = append(allowedMethods, string(method))
+							}
+
+							state.CorsRule = append(state.CorsRule, AccountServicePropertiesCorsRule{
+								AllowedHeaders: rule.AllowedHeaders,
+								AllowedMethods: allowedMethods,
+								AllowedOrigins: rule.AllowedOrigins,
+								ExposedHeaders: rule.ExposedHeaders,
+								MaxAgeSeconds:  rule.MaxAgeInSeconds,
+							})
+						}
+					}
+
+					if policy := props.ShareDeleteRetentionPolicy; policy != nil && pointer.From(policy.Enabled) {
+						state.RetentionPolicy = []AccountFilePropertiesRetentionPolicy{
+							{
+								Days: pointer.From(policy.Days),
+							},
+						}
+					}
+
+					if settings := props.ProtocolSettings; settings != nil && settings.Smb != nil {
+						state.Smb = flattenStorageAccountFilePropertiesSmb(*settings.Smb)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AccountFilePropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			var model AccountFilePropertiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandStorageAccountFileProperties(model, account.tier)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.FileService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating File Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountFilePropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageAccountForServiceProperties(ctx, client.StorageAccounts, *id)
+			if err != nil {
+				return err
+			}
+
+			// File Properties can't be deleted, so we'll reset them back to the defaults instead
+			payload, err := expandStorageAccountFileProperties(AccountFilePropertiesModel{}, account.tier)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.FileService.SetServiceProperties(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("resetting File Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageAccountFileProperties(input AccountFilePropertiesModel, tier storageaccounts.SkuTier) (*fileservice.FileServiceProperties, error) {
+	props := fileservice.FileServicePropertiesProperties{
+		Cors: &fileservice.CorsRules{
+			CorsRules: &[]fileservice.CorsRule{},
+		},
+		ShareDeleteRetentionPolicy: &fileservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(false),
+		},
+		ProtocolSettings: &fileservice.ProtocolSettings{
+			Smb: &fileservice.SmbSetting{
+				AuthenticationMethods:    pointer.To(""),
+				ChannelEncryption:        pointer.To(""),
+				KerberosTicketEncryption: pointer.To(""),
+				Versions:                 pointer.To(""),
+			},
+		},
+	}
+
+	for _, rule := range input.CorsRule {
+		allowedMethods := make([]fileservice.AllowedMethods, 0)
+		for _, method := range rule.AllowedMethods {
+			allowedMethods = append(allowedMethods, fileservice.AllowedMethods(method))
+		}
+
+		*props.Cors.CorsRules = append(*props.Cors.CorsRules, fileservice.CorsRule{
+			AllowedHeaders:  rule.AllowedHeaders,
+			AllowedMethods:  allowedMethods,
+			AllowedOrigins:  rule.AllowedOrigins,
+			ExposedHeaders:  rule.ExposedHeaders,
+			MaxAgeInSeconds: rule.MaxAgeSeconds,
+		})
+	}
+
+	if len(input.RetentionPolicy) > 0 {
+		props.ShareDeleteRetentionPolicy = &fileservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(true),
+			Days:    pointer.To(input.RetentionPolicy[0].Days),
+		}
+	}
+
+	multichannelEnabled := false
+	if len(input.Smb) > 0 {
+		smb := input.Smb[0]
+		multichannelEnabled = smb.MultichannelEnabled
+		props.ProtocolSettings.Smb = &fileservice.SmbSetting{
+			AuthenticationMethods:    pointer.To(strings.Join(smb.AuthenticationTypes, ";")),
+			ChannelEncryption:        pointer.To(strings.Join(smb.ChannelEncryptionType, ";")),
+			KerberosTicketEncryption: pointer.To(strings.Join(smb.KerberosTicketEncryptionType, ";")),
+			Versions:                 pointer.To(strings.Join(smb.Versions, ";")),
+		}
+	}
+
+	// The API complains if any multichannel info is sent on non premium fileshares. Even if multichannel is set to false
+	if tier == storageaccounts.SkuTierPremium {
+		props.ProtocolSettings.Smb.Multichannel = &fileservice.Multichannel{
+			Enabled: pointer.To(multichannelEnabled),
+		}
+	} else if multichannelEnabled {
+		return nil, fmt.Errorf("`multichannel_enabled` isn't supported for Standard tier Storage accounts")
+	}
+
+	return &fileservice.FileServiceProperties{
+		Properties: &props,
+	}, nil
+}
+
+func flattenStorageAccountFilePropertiesSmb(input fileservice.SmbSetting) []AccountFilePropertiesSmb {
+	splitSetting := func(input *string) []string {
+		output := make([]string, 0)
+		if v := pointer.From(input); v != "" {
+			output = strings.Split(strings.Trim(v, ";"), ";")
+		}
+		return output
+	}
+
+	output := AccountFilePropertiesSmb{
+		AuthenticationTypes:          splitSetting(input.AuthenticationMethods),
+		ChannelEncryptionType:        splitSetting(input.ChannelEncryption),
+		KerberosTicketEncryptionType: splitSetting(input.KerberosTicketEncryption),
+		Versions:                     splitSetting(input.Versions),
+	}
+
+	if input.Multichannel != nil {
+		output.MultichannelEnabled = pointer.From(input.Multichannel.Enabled)
+	}
+
+	if len(output.AuthenticationTypes) == 0 && len(output.ChannelEncryptionType) == 0 && len(output.KerberosTicketEncryptionType) == 0 && len(output.Versions) == 0 && (input.Multichannel == nil || input.Multichannel.Enabled == nil) {
+		return []AccountFilePropertiesSmb{}
+	}
+
+	return []AccountFilePropertiesSmb{output}
+}
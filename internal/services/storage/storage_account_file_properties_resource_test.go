@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageAccountFilePropertiesResource struct{}
+
+func TestAccStorageAccountFileProperties_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_file_properties", "test")
+	r := StorageAccountFilePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountFileProperties_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_file_properties", "test")
+	r := StorageAccountFilePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("retention_policy.0.days").HasValue("14"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountFileProperties_premiumMultichannel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_file_properties", "test")
+	r := StorageAccountFilePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premiumMultichannel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("smb.0.multichannel_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountFilePropertiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.FileService.GetServiceProperties(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving File Properties for %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StorageAccountFilePropertiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_file_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  retention_policy {
+    days = 7
+  }
+}
+`, r.template(data, "Standard", "StorageV2"))
+}
+
+func (r StorageAccountFilePropertiesResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_file_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  cors_rule {
+    allowed_origins    = ["http://www.example.com"]
+    exposed_headers    = ["x-tempo-*"]
+    allowed_headers    = ["x-tempo-*"]
+    allowed_methods    = ["GET", "PUT"]
+    max_age_in_seconds = "500"
+  }
+
+  retention_policy {
+    days = 14
+  }
+
+  smb {
+    versions                        = ["SMB3.0"]
+    authentication_types            = ["NTLMv2"]
+    kerberos_ticket_encryption_type = ["AES-256"]
+    channel_encryption_type         = ["AES-128-CCM"]
+  }
+}
+`, r.template(data, "Standard", "StorageV2"))
+}
+
+func (r StorageAccountFilePropertiesResource) premiumMultichannel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_file_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  smb {
+    multichannel_enabled = true
+  }
+}
+`, r.template(data, "Premium", "FileStorage"))
+}
+
+func (r StorageAccountFilePropertiesResource) template(data acceptance.TestData, tier, kind string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "%[5]s"
+  account_tier             = "%[4]s"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, tier, kind)
+}
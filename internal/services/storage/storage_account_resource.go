@@ -424,101 +424,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
-			"blob_properties": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"change_feed_enabled": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"change_feed_retention_in_days": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 146000),
-						},
-
-						"container_delete_retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										Default:      7,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
-
-						"cors_rule": helpers.SchemaStorageAccountCorsRule(true),
-
-						"default_service_version": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validate.BlobPropertiesDefaultServiceVersion,
-						},
-
-						"delete_retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										Default:      7,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-									"permanent_delete_enabled": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-								},
-							},
-						},
-						"last_access_time_enabled": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"restore_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-							RequiredWith: []string{"blob_properties.0.delete_retention_policy"},
-						},
-
-						"versioning_enabled": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-					},
-				},
-			},
-
 			"routing": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -548,99 +453,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
-			"share_properties": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"cors_rule": helpers.SchemaStorageAccountCorsRule(true),
-
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										Default:      7,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
-
-						"smb": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"authentication_types": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"Kerberos",
-												"NTLMv2",
-											}, false),
-										},
-									},
-
-									"channel_encryption_type": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"AES-128-CCM",
-												"AES-128-GCM",
-												"AES-256-GCM",
-											}, false),
-										},
-									},
-
-									"kerberos_ticket_encryption_type": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"AES-256",
-												"RC4-HMAC",
-											}, false),
-										},
-									},
-
-									"multichannel_enabled": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-
-									"versions": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"SMB2.1",
-												"SMB3.0",
-												"SMB3.1.1",
-											}, false),
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-
 			"queue_encryption_key_type": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -1147,6 +959,28 @@ func resourceStorageAccount() *pluginsdk.Resource {
 	}
 
 	if !features.FivePointOhBeta() {
+		resource.Schema["blob_properties"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: schemaStorageAccountBlobProperties("blob_properties.0."),
+			},
+			Deprecated: "this block has been deprecated and superseded by the `azurerm_storage_account_blob_properties` resource and will be removed in v5.0 of the AzureRM provider",
+		}
+
+		resource.Schema["share_properties"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: schemaStorageAccountShareProperties(),
+			},
+			Deprecated: "this block has been deprecated and superseded by the `azurerm_storage_account_file_properties` resource and will be removed in v5.0 of the AzureRM provider",
+		}
+
 		// lintignore:XS003
 		resource.Schema["static_website"] = &pluginsdk.Schema{
 			Type:     pluginsdk.TypeList,
@@ -1539,7 +1373,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if val, ok := d.GetOk("blob_properties"); ok {
+	if val, ok := d.GetOk("blob_properties"); ok && !features.FivePointOhBeta() {
 		if !supportLevel.supportBlob {
 			return fmt.Errorf("`blob_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
@@ -1592,7 +1426,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if val, ok := d.GetOk("share_properties"); ok {
+	if val, ok := d.GetOk("share_properties"); ok && !features.FivePointOhBeta() {
 		if !supportLevel.supportShare {
 			return fmt.Errorf("`share_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
@@ -1865,7 +1699,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	// Followings are updates to the sub-services
 	supportLevel := availableFunctionalityForAccount(accountKind, accountTier, replicationType)
 
-	if d.HasChange("blob_properties") {
+	if !features.FivePointOhBeta() && d.HasChange("blob_properties") {
 		if !supportLevel.supportBlob {
 			return fmt.Errorf("`blob_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
@@ -1963,7 +1797,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("share_properties") {
+	if !features.FivePointOhBeta() && d.HasChange("share_properties") {
 		if !supportLevel.supportShare {
 			return fmt.Errorf("`share_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
@@ -2207,30 +2041,32 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	keysAndConnectionStrings := flattenAccountAccessKeysAndConnectionStrings(id.StorageAccountName, *storageDomainSuffix, storageAccountKeys, endpoints)
 	keysAndConnectionStrings.set(d)
 
-	blobProperties := make([]interface{}, 0)
-	if supportLevel.supportBlob {
-		blobProps, err := storageClient.BlobService.GetServiceProperties(ctx, *id)
-		if err != nil {
-			return fmt.Errorf("reading blob properties for %s: %+v", *id, err)
+	if !features.FivePointOhBeta() {
+		blobProperties := make([]interface{}, 0)
+		if supportLevel.supportBlob {
+			blobProps, err := storageClient.BlobService.GetServiceProperties(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("reading blob properties for %s: %+v", *id, err)
+			}
+
+			blobProperties = flattenAccountBlobServiceProperties(blobProps.Model)
+		}
+		if err := d.Set("blob_properties", blobProperties); err != nil {
+			return fmt.Errorf("setting `blob_properties` for %s: %+v", *id, err)
 		}
 
-		blobProperties = flattenAccountBlobServiceProperties(blobProps.Model)
-	}
-	if err := d.Set("blob_properties", blobProperties); err != nil {
-		return fmt.Errorf("setting `blob_properties` for %s: %+v", *id, err)
-	}
+		shareProperties := make([]interface{}, 0)
+		if supportLevel.supportShare {
+			shareProps, err := storageClient.FileService.GetServiceProperties(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving share properties for %s: %+v", *id, err)
+			}
 
-	shareProperties := make([]interface{}, 0)
-	if supportLevel.supportShare {
-		shareProps, err := storageClient.FileService.GetServiceProperties(ctx, *id)
-		if err != nil {
-			return fmt.Errorf("retrieving share properties for %s: %+v", *id, err)
+			shareProperties = flattenAccountShareProperties(shareProps.Model)
+		}
+		if err := d.Set("share_properties", shareProperties); err != nil {
+			return fmt.Errorf("setting `share_properties` for %s: %+v", *id, err)
 		}
-
-		shareProperties = flattenAccountShareProperties(shareProps.Model)
-	}
-	if err := d.Set("share_properties", shareProperties); err != nil {
-		return fmt.Errorf("setting `share_properties` for %s: %+v", *id, err)
 	}
 
 	if !features.FivePointOhBeta() && dataPlaneAvailable {
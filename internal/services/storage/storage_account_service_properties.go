@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// schemaStorageAccountBlobProperties returns the schema for the Blob Service Properties, which is used both by the
+// `blob_properties` block within `azurerm_storage_account` and at the top-level of `azurerm_storage_account_blob_properties`.
+// The `prefix` is the path to these fields within the resource (e.g. `blob_properties.0.`) and is used for cross-field references.
+func schemaStorageAccountBlobProperties(prefix string) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"change_feed_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"change_feed_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 146000),
+		},
+
+		"container_delete_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"cors_rule": helpers.SchemaStorageAccountCorsRule(true),
+
+		"default_service_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.BlobPropertiesDefaultServiceVersion,
+		},
+
+		"delete_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+					"permanent_delete_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"last_access_time_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"restore_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+			RequiredWith: []string{prefix + "delete_retention_policy"},
+		},
+
+		"versioning_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// schemaStorageAccountShareProperties returns the schema for the File Service Properties, which is used both by the
+// `share_properties` block within `azurerm_storage_account` and at the top-level of `azurerm_storage_account_file_properties`.
+func schemaStorageAccountShareProperties() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cors_rule": helpers.SchemaStorageAccountCorsRule(true),
+
+		"retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"smb": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"authentication_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Kerberos",
+								"NTLMv2",
+							}, false),
+						},
+					},
+
+					"channel_encryption_type": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AES-128-CCM",
+								"AES-128-GCM",
+								"AES-256-GCM",
+							}, false),
+						},
+					},
+
+					"kerberos_ticket_encryption_type": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AES-256",
+								"RC4-HMAC",
+							}, false),
+						},
+					},
+
+					"multichannel_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"versions": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SMB2.1",
+								"SMB3.0",
+								"SMB3.1.1",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// AccountServicePropertiesCorsRule is the typed representation of the `cors_rule` block, as used by the resources
+// managing the Blob and File Service Properties.
+type AccountServicePropertiesCorsRule struct {
+	AllowedHeaders []string `tfschema:"allowed_headers"`
+	AllowedMethods []string `tfschema:"allowed_methods"`
+	AllowedOrigins []string `tfschema:"allowed_origins"`
+	ExposedHeaders []string `tfschema:"exposed_headers"`
+	MaxAgeSeconds  int64    `tfschema:"max_age_in_seconds"`
+}
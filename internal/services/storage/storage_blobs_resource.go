@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec used for the Content-MD5 of the Blob and not for security
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobsResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageBlobsResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageBlobsResource{}
+)
+
+type StorageBlobsResourceModel struct {
+	StorageContainerId   string            `tfschema:"storage_container_id"`
+	SourceDirectory      string            `tfschema:"source_directory"`
+	Prefix               string            `tfschema:"prefix"`
+	CacheControl         string            `tfschema:"cache_control"`
+	ContentTypeOverrides map[string]string `tfschema:"content_type_overrides"`
+	DeleteOrphans        bool              `tfschema:"delete_orphans"`
+	Parallelism          int64             `tfschema:"parallelism"`
+	Files                map[string]string `tfschema:"files"`
+}
+
+func (r StorageBlobsResource) ModelObject() interface{} {
+	return &StorageBlobsResourceModel{}
+}
+
+func (r StorageBlobsResource) ResourceType() string {
+	return "azurerm_storage_blobs"
+}
+
+func (r StorageBlobsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobsID
+}
+
+func (r StorageBlobsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageBlobsPrefix,
+		},
+
+		"cache_control": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_type_overrides": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"delete_orphans": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntBetween(1, 64),
+		},
+	}
+}
+
+func (r StorageBlobsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"files": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageBlobsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageBlobsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(model.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageBlobsID(*containerId, model.Prefix)
+
+			if err := r.sync(ctx, metadata, id, model, true); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.StorageContainerId.SubscriptionId, id.StorageContainerId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for %s: %+v", id.StorageContainerId.StorageAccountName, *id, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for %s - assuming removed & removing from state!", id.StorageContainerId.StorageAccountName, *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			remote, err := r.listRemoteFiles(ctx, storageClient, *account, *id)
+			if err != nil {
+				return err
+			}
+			if remote == nil {
+				log.Printf("[DEBUG] Container %q was not found - assuming removed & removing %s from state!", id.StorageContainerId.ContainerName, *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			// unless orphans are being removed only the Blobs managed by this resource are tracked, so that other
+			// Blobs sharing the same prefix don't show up as a diff - when there's nothing tracked yet (e.g. on import)
+			// all Blobs sharing the prefix are tracked.
+			files := *remote
+			if !state.DeleteOrphans && len(state.Files) > 0 {
+				files = make(map[string]string)
+				for name := range state.Files {
+					if v, ok := (*remote)[name]; ok {
+						files[name] = v
+					}
+				}
+			}
+
+			state.StorageContainerId = id.StorageContainerId.ID()
+			state.Prefix = id.Prefix
+			state.Files = files
+
+			if state.Parallelism == 0 {
+				// not set when importing, so default this
+				state.Parallelism = 8
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageBlobsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Content-Type and Cache-Control are set when uploading, so changing these requires re-uploading every file
+			uploadAll := metadata.ResourceData.HasChanges("cache_control", "content_type_overrides")
+
+			if err := r.sync(ctx, metadata, *id, model, uploadAll); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.StorageContainerId.SubscriptionId, id.StorageContainerId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for %s: %+v", id.StorageContainerId.StorageAccountName, *id, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %v", err)
+			}
+
+			names := make([]string, 0, len(state.Files))
+			for name := range state.Files {
+				names = append(names, name)
+			}
+
+			if err := deleteStorageBlobs(ctx, blobsClient, id.StorageContainerId.ContainerName, names, int(state.Parallelism)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if !metadata.ResourceDiff.NewValueKnown("source_directory") || !metadata.ResourceDiff.NewValueKnown("prefix") {
+				return metadata.ResourceDiff.SetNewComputed("files")
+			}
+
+			local, err := storageBlobsLocalFiles(metadata.ResourceDiff.Get("source_directory").(string), metadata.ResourceDiff.Get("prefix").(string))
+			if err != nil {
+				return err
+			}
+
+			// presenting the full set of files as a single attribute gives one aggregated diff of the Blobs which will be
+			// added, replaced or (when `delete_orphans` is enabled) removed.
+			files := make(map[string]interface{}, len(local))
+			for name, file := range local {
+				files[name] = file.md5
+			}
+
+			existing := metadata.ResourceDiff.Get("files").(map[string]interface{})
+			if len(existing) == len(files) {
+				changed := false
+				for k, v := range files {
+					if existing[k] != v {
+						changed = true
+						break
+					}
+				}
+				if !changed {
+					return nil
+				}
+			}
+
+			return metadata.ResourceDiff.SetNew("files", files)
+		},
+	}
+}
+
+func (r StorageBlobsResource) sync(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageBlobsId, model StorageBlobsResourceModel, uploadAll bool) error {
+	storageClient := metadata.Client.Storage
+
+	account, err := storageClient.FindAccount(ctx, id.StorageContainerId.SubscriptionId, id.StorageContainerId.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %+v", id.StorageContainerId.StorageAccountName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", id.StorageContainerId.StorageAccountName)
+	}
+
+	blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %v", err)
+	}
+
+	local, err := storageBlobsLocalFiles(model.SourceDirectory, model.Prefix)
+	if err != nil {
+		return err
+	}
+
+	remote, err := r.listRemoteFiles(ctx, storageClient, *account, id)
+	if err != nil {
+		return err
+	}
+	if remote == nil {
+		return fmt.Errorf("%s was not found", id.StorageContainerId)
+	}
+
+	uploads := make([]BlobUpload, 0)
+	for name, file := range local {
+		if !uploadAll && (*remote)[name] == file.md5 {
+			continue
+		}
+
+		contentMD5, err := convertHexToBase64Encoding(file.md5)
+		if err != nil {
+			return err
+		}
+
+		uploads = append(uploads, BlobUpload{
+			Client:        blobsClient,
+			AccountName:   id.StorageContainerId.StorageAccountName,
+			ContainerName: id.StorageContainerId.ContainerName,
+			BlobName:      name,
+
+			BlobType:     "Block",
+			CacheControl: model.CacheControl,
+			ContentType:  storageBlobsContentType(file.path, model.ContentTypeOverrides),
+			ContentMD5:   contentMD5,
+			Parallelism:  int(model.Parallelism),
+			Source:       file.path,
+		})
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files to %s..", len(uploads), len(local), id)
+	if err := uploadStorageBlobs(ctx, uploads, int(model.Parallelism)); err != nil {
+		return err
+	}
+
+	if model.DeleteOrphans {
+		orphans := make([]string, 0)
+		for name := range *remote {
+			if _, ok := local[name]; !ok {
+				orphans = append(orphans, name)
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting %d orphaned Blobs from %s..", len(orphans), id)
+		if err := deleteStorageBlobs(ctx, blobsClient, id.StorageContainerId.ContainerName, orphans, int(model.Parallelism)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listRemoteFiles returns a map of Blob Name to the hex encoded MD5 of each Blob sharing the prefix, or nil when the Container doesn't exist
+func (r StorageBlobsResource) listRemoteFiles(ctx context.Context, storageClient *client.Client, account client.AccountDetails, id parse.StorageBlobsId) (*map[string]string, error) {
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %v", err)
+	}
+
+	blobList, err := containersClient.ListBlobs(ctx, id.StorageContainerId.ContainerName, id.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs for %s: %v", id, err)
+	}
+	if blobList == nil {
+		return nil, nil
+	}
+
+	files := make(map[string]string)
+	for _, blob := range *blobList {
+		contentMD5 := ""
+		if props := blob.Properties; props != nil && pointer.From(props.ContentMD5) != "" {
+			contentMD5, err = convertBase64ToHexEncoding(*props.ContentMD5)
+			if err != nil {
+				return nil, err
+			}
+		}
+		files[blob.Name] = contentMD5
+	}
+
+	return &files, nil
+}
+
+type storageBlobsLocalFile struct {
+	path string
+	md5  string
+}
+
+// storageBlobsLocalFiles returns the files within the source directory keyed by the name of the Blob they'll be uploaded to
+func storageBlobsLocalFiles(sourceDirectory, prefix string) (map[string]storageBlobsLocalFile, error) {
+	files := make(map[string]storageBlobsLocalFile)
+
+	err := filepath.WalkDir(sourceDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, path)
+		if err != nil {
+			return err
+		}
+
+		hash, err := storageBlobsFileMD5(path)
+		if err != nil {
+			return err
+		}
+
+		files[prefix+filepath.ToSlash(relativePath)] = storageBlobsLocalFile{
+			path: path,
+			md5:  hash,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading `source_directory` %q: %+v", sourceDirectory, err)
+	}
+
+	return files, nil
+}
+
+func storageBlobsFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() // nolint: gosec
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("computing MD5 of %q: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// storageBlobsContentType infers the Content-Type of a file from its extension, unless this has been overridden
+func storageBlobsContentType(path string, overrides map[string]string) string {
+	extension := strings.ToLower(filepath.Ext(path))
+	for k, v := range overrides {
+		if strings.EqualFold(k, extension) || strings.EqualFold("."+k, extension) {
+			return v
+		}
+	}
+
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+
+	return "application/octet-stream"
+}
+
+func uploadStorageBlobs(ctx context.Context, uploads []BlobUpload, parallelism int) error {
+	if len(uploads) == 0 {
+		return nil
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	// a single set of slots is shared by every upload so that at most `parallelism` requests (and block
+	// buffers) are in flight at once, regardless of how many blocks each file is split into
+	requestSlots := make(chan struct{}, parallelism)
+
+	queue := make(chan BlobUpload, len(uploads))
+	for _, upload := range uploads {
+		upload.requestSlots = requestSlots
+		queue <- upload
+	}
+	close(queue)
+
+	errors := make(chan error, len(uploads))
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for upload := range queue {
+				if err := upload.uploadBlockBlobInBlocks(ctx); err != nil {
+					errors <- fmt.Errorf("uploading %q to Blob %q: %+v", upload.Source, upload.BlobName, err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%d of %d files failed to upload, the first error was: %+v", len(errors), len(uploads), <-errors)
+	}
+
+	return nil
+}
+
+func deleteStorageBlobs(ctx context.Context, client *blobs.Client, containerName string, names []string, parallelism int) error {
+	if len(names) == 0 {
+		return nil
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	queue := make(chan string, len(names))
+	for _, name := range names {
+		queue <- name
+	}
+	close(queue)
+
+	errors := make(chan error, len(names))
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range queue {
+				input := blobs.DeleteInput{
+					DeleteSnapshots: true,
+				}
+				if resp, err := client.Delete(ctx, containerName, name, input); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					errors <- fmt.Errorf("deleting Blob %q: %+v", name, err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%d of %d Blobs failed to delete, the first error was: %+v", len(errors), len(names), <-errors)
+	}
+
+	return nil
+}
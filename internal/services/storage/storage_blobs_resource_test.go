@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageBlobsResource struct{}
+
+func TestAccStorageBlobs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+	sourceDirectory := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_directory"),
+	})
+}
+
+func TestAccStorageBlobs_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+	sourceDirectory := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.prefix(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.site/index.html").IsSet(),
+				check.That(data.ResourceName).Key("files.site/assets/app.js").IsSet(),
+			),
+		},
+		data.ImportStep("source_directory"),
+	})
+}
+
+func TestAccStorageBlobs_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+	sourceDirectory := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory"),
+		{
+			PreConfig: func() {
+				r.writeFile(t, sourceDirectory, "index.html", "<html><body>updated</body></html>")
+				r.writeFile(t, sourceDirectory, "assets/site.css", "body { color: red; }")
+			},
+			Config: r.complete(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("4"),
+			),
+		},
+		data.ImportStep("source_directory", "cache_control", "content_type_overrides"),
+	})
+}
+
+func TestAccStorageBlobs_deleteOrphans(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+	sourceDirectory := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteOrphans(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_directory"),
+		{
+			PreConfig: func() {
+				if err := os.Remove(filepath.Join(sourceDirectory, "robots.txt")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.deleteOrphans(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+				check.That(data.ResourceName).Key("files.robots.txt").DoesNotExist(),
+			),
+		},
+		data.ImportStep("source_directory"),
+	})
+}
+
+func (r StorageBlobsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageBlobsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.StorageContainerId.SubscriptionId, id.StorageContainerId.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for %s: %+v", id.StorageContainerId.StorageAccountName, *id, err)
+	}
+	if account == nil {
+		return pointer.To(false), nil
+	}
+
+	containersClient, err := client.Storage.ContainersDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	blobs, err := containersClient.ListBlobs(ctx, id.StorageContainerId.ContainerName, id.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs for %s: %+v", *id, err)
+	}
+
+	return pointer.To(blobs != nil && len(*blobs) > 0), nil
+}
+
+func (r StorageBlobsResource) sourceDirectory(t *testing.T) string {
+	sourceDirectory := t.TempDir()
+
+	r.writeFile(t, sourceDirectory, "index.html", "<html><body>hello world</body></html>")
+	r.writeFile(t, sourceDirectory, "assets/app.js", "console.log('hello world');")
+	r.writeFile(t, sourceDirectory, "robots.txt", "User-agent: *\nDisallow:")
+
+	return sourceDirectory
+}
+
+func (r StorageBlobsResource) writeFile(t *testing.T, sourceDirectory, name, content string) {
+	path := filepath.Join(sourceDirectory, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", path, err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %q: %+v", path, err)
+	}
+}
+
+func (r StorageBlobsResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageBlobsResource) prefix(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+  prefix               = "site/"
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageBlobsResource) complete(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+  cache_control        = "public, max-age=3600"
+  parallelism          = 4
+
+  content_type_overrides = {
+    ".txt" = "text/plain; charset=utf-8"
+  }
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageBlobsResource) deleteOrphans(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+  delete_orphans       = true
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageBlobsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
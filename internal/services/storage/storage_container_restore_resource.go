@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageContainerRestoreResource struct{}
+
+var _ sdk.Resource = StorageContainerRestoreResource{}
+
+type StorageContainerRestoreModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	ContainerName    string `tfschema:"container_name"`
+	DeletedVersion   string `tfschema:"deleted_version"`
+}
+
+func (r StorageContainerRestoreResource) ResourceType() string {
+	return "azurerm_storage_container_restore"
+}
+
+func (r StorageContainerRestoreResource) ModelObject() interface{} {
+	return &StorageContainerRestoreModel{}
+}
+
+func (r StorageContainerRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageContainerID
+}
+
+func (r StorageContainerRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+
+		"deleted_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageContainerRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var model StorageContainerRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := commonids.NewStorageContainerID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, model.ContainerName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			deletedVersion, err := findDeletedStorageContainerVersion(ctx, client, *accountId, model.ContainerName, model.DeletedVersion)
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, accountId.SubscriptionId, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating %s", *accountId)
+			}
+
+			containersDataPlaneClient, err := metadata.Client.Storage.ContainersDataPlaneClient(ctx, *account, metadata.Client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			if err := containersDataPlaneClient.Restore(ctx, model.ContainerName, *deletedVersion); err != nil {
+				return fmt.Errorf("restoring %s from version %q: %+v", id, *deletedVersion, err)
+			}
+
+			metadata.SetID(id)
+
+			if err := metadata.ResourceData.Set("deleted_version", *deletedVersion); err != nil {
+				return fmt.Errorf("setting `deleted_version`: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageContainerRestoreModel{
+				StorageAccountId: commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+				ContainerName:    id.ContainerName,
+				// the version the Container was restored from isn't returned by the API
+				DeletedVersion: metadata.ResourceData.Get("deleted_version").(string),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the restored Container is intentionally retained, so this only removes the resource from the state
+			log.Printf("[DEBUG] retaining restored %s - removing from state", *id)
+
+			return nil
+		},
+	}
+}
+
+// findDeletedStorageContainerVersion returns the version of the soft-deleted Container to restore, when no version is
+// specified the most recently deleted version is used.
+func findDeletedStorageContainerVersion(ctx context.Context, client *blobcontainers.BlobContainersClient, accountId commonids.StorageAccountId, containerName string, version string) (*string, error) {
+	options := blobcontainers.ListOperationOptions{
+		Include: pointer.To(blobcontainers.ListContainersIncludeDeleted),
+	}
+	containers, err := client.ListComplete(ctx, accountId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing deleted Containers for %s: %+v", accountId, err)
+	}
+
+	var latest *blobcontainers.ContainerProperties
+	var latestDeletedTime time.Time
+	for _, item := range containers.Items {
+		if pointer.From(item.Name) != containerName || item.Properties == nil || !pointer.From(item.Properties.Deleted) {
+			continue
+		}
+
+		props := item.Properties
+		if version != "" {
+			if pointer.From(props.Version) == version {
+				return props.Version, nil
+			}
+			continue
+		}
+
+		deletedTime, err := props.GetDeletedTimeAsTime()
+		if err != nil || deletedTime == nil {
+			continue
+		}
+		if latest == nil || deletedTime.After(latestDeletedTime) {
+			latest = props
+			latestDeletedTime = *deletedTime
+		}
+	}
+
+	if version != "" {
+		return nil, fmt.Errorf("a deleted Container named %q with version %q was not found in %s", containerName, version, accountId)
+	}
+	if latest == nil || pointer.From(latest.Version) == "" {
+		return nil, fmt.Errorf("a deleted Container named %q was not found in %s", containerName, accountId)
+	}
+
+	return latest.Version, nil
+}
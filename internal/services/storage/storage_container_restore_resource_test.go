@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageContainerRestoreResource struct{}
+
+func TestAccStorageContainerRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_restore", "test")
+	r := StorageContainerRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// create the Container so that it can be soft-deleted in the next step
+			Config: r.template(data, true),
+		},
+		{
+			Config: r.template(data, false),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deleted_version").IsSet(),
+			),
+		},
+		data.ImportStep("deleted_version"),
+	})
+}
+
+func (r StorageContainerRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageContainerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.BlobContainers.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageContainerRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = "restored"
+}
+`, r.template(data, false))
+}
+
+func (r StorageContainerRestoreResource) template(data acceptance.TestData, withContainer bool) string {
+	container := ""
+	if withContainer {
+		container = `
+resource "azurerm_storage_container" "test" {
+  name                  = "restored"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    container_delete_retention_policy {
+      days = 7
+    }
+  }
+}
+%[4]s
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, container)
+}
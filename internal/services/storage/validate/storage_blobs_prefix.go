@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+func StorageBlobsPrefix(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if strings.HasPrefix(v, "/") {
+		errors = append(errors, fmt.Errorf("%q must not start with a `/`", k))
+	}
+
+	if strings.Contains(v, "\\") {
+		errors = append(errors, fmt.Errorf("%q must use `/` as a path separator", k))
+	}
+
+	if len(v) > 1024 {
+		errors = append(errors, fmt.Errorf("%q must be at most 1024 characters long", k))
+	}
+
+	return
+}
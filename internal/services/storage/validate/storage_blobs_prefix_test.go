@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageBlobsPrefix(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"/site", true},
+		{"site\\assets", true},
+		{strings.Repeat("a", 1025), true},
+		{"site", false},
+		{"site/", false},
+		{"site/assets/", false},
+		{"models/v1.2/", false},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageBlobsPrefix(test.input, "prefix")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating prefix %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating prefix %q to pass", test.input)
+		}
+	}
+}
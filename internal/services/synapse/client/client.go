@@ -183,6 +183,56 @@ func (client Client) LinkedServiceClient(workspaceName, synapseEndpointSuffix st
 	return &linkedServiceClient, nil
 }
 
+func (client Client) DataFlowClient(workspaceName, synapseEndpointSuffix string) (*artifacts.DataFlowClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, errors.New("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	dataFlowClient := artifacts.NewDataFlowClient(endpoint)
+	dataFlowClient.Client.Authorizer = client.synapseAuthorizer
+	return &dataFlowClient, nil
+}
+
+func (client Client) NotebookClient(workspaceName, synapseEndpointSuffix string) (*artifacts.NotebookClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, errors.New("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	notebookClient := artifacts.NewNotebookClient(endpoint)
+	notebookClient.Client.Authorizer = client.synapseAuthorizer
+	return &notebookClient, nil
+}
+
+func (client Client) PipelineClient(workspaceName, synapseEndpointSuffix string) (*artifacts.PipelineClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, errors.New("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	pipelineClient := artifacts.NewPipelineClient(endpoint)
+	pipelineClient.Client.Authorizer = client.synapseAuthorizer
+	return &pipelineClient, nil
+}
+
+func (client Client) SQLScriptClient(workspaceName, synapseEndpointSuffix string) (*artifacts.SQLScriptClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, errors.New("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	sqlScriptClient := artifacts.NewSQLScriptClient(endpoint)
+	sqlScriptClient.Client.Authorizer = client.synapseAuthorizer
+	return &sqlScriptClient, nil
+}
+
+func (client Client) TriggerClient(workspaceName, synapseEndpointSuffix string) (*artifacts.TriggerClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, errors.New("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	triggerClient := artifacts.NewTriggerClient(endpoint)
+	triggerClient.Client.Authorizer = client.synapseAuthorizer
+	return &triggerClient, nil
+}
+
 func buildEndpoint(workspaceName string, synapseEndpointSuffix string) string {
 	return fmt.Sprintf("https://%s.%s", workspaceName, synapseEndpointSuffix)
 }
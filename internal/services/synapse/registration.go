@@ -38,11 +38,14 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_synapse_data_flow":                                  resourceSynapseDataFlow(),
 		"azurerm_synapse_firewall_rule":                              resourceSynapseFirewallRule(),
 		"azurerm_synapse_integration_runtime_azure":                  resourceSynapseIntegrationRuntimeAzure(),
 		"azurerm_synapse_integration_runtime_self_hosted":            resourceSynapseIntegrationRuntimeSelfHosted(),
 		"azurerm_synapse_linked_service":                             resourceSynapseLinkedService(),
 		"azurerm_synapse_managed_private_endpoint":                   resourceSynapseManagedPrivateEndpoint(),
+		"azurerm_synapse_notebook":                                   resourceSynapseNotebook(),
+		"azurerm_synapse_pipeline":                                   resourceSynapsePipeline(),
 		"azurerm_synapse_private_link_hub":                           resourceSynapsePrivateLinkHub(),
 		"azurerm_synapse_role_assignment":                            resourceSynapseRoleAssignment(),
 		"azurerm_synapse_spark_pool":                                 resourceSynapseSparkPool(),
@@ -53,6 +56,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_synapse_sql_pool_vulnerability_assessment_baseline": resourceSynapseSqlPoolVulnerabilityAssessmentBaseline(),
 		"azurerm_synapse_sql_pool_workload_classifier":               resourceSynapseSQLPoolWorkloadClassifier(),
 		"azurerm_synapse_sql_pool_workload_group":                    resourceSynapseSQLPoolWorkloadGroup(),
+		"azurerm_synapse_sql_script":                                 resourceSynapseSqlScript(),
+		"azurerm_synapse_trigger":                                    resourceSynapseTrigger(),
 		"azurerm_synapse_workspace":                                  resourceSynapseWorkspace(),
 		"azurerm_synapse_workspace_aad_admin":                        resourceSynapseWorkspaceAADAdmin(),
 		"azurerm_synapse_workspace_extended_auditing_policy":         resourceSynapseWorkspaceExtendedAuditingPolicy(),
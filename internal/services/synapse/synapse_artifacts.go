@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+// expandSynapseArtifact unmarshals the name and properties of an Artifact into the (polymorphic) SDK model
+// specified in `target`, so that the `*_json` arguments can be sent to the API as-is
+func expandSynapseArtifact(name string, properties map[string]interface{}, target interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"name":       name,
+		"properties": properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(payload, target)
+}
+
+// flattenSynapseArtifactProperties returns the properties of an Artifact keyed by their name within the API
+func flattenSynapseArtifactProperties(input interface{}) (map[string]*json.RawMessage, error) {
+	output := make(map[string]*json.RawMessage)
+	if input == nil {
+		return output, nil
+	}
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(payload, &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// flattenSynapseArtifactProperty unmarshals the property `key` into `target`, leaving `target` untouched when the
+// property isn't returned by the API
+func flattenSynapseArtifactProperty(properties map[string]*json.RawMessage, key string, target interface{}) error {
+	v, ok := properties[key]
+	if !ok || v == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(*v, target); err != nil {
+		return fmt.Errorf("unmarshaling `%s`: %+v", key, err)
+	}
+
+	return nil
+}
+
+// flattenSynapseArtifactJsonProperty returns the normalised JSON of the property `key`, or an empty string when the
+// property isn't returned by the API
+func flattenSynapseArtifactJsonProperty(properties map[string]*json.RawMessage, key string) string {
+	v, ok := properties[key]
+	if !ok || v == nil || string(*v) == "null" {
+		return ""
+	}
+
+	return utils.NormalizeJson(string(*v))
+}
+
+func flattenSynapseArtifactFolder(properties map[string]*json.RawMessage) (string, error) {
+	folder := struct {
+		Name string `json:"name"`
+	}{}
+	if err := flattenSynapseArtifactProperty(properties, "folder", &folder); err != nil {
+		return "", err
+	}
+
+	return folder.Name, nil
+}
+
+func expandSynapseArtifactFolder(input string) map[string]interface{} {
+	if input == "" {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name": input,
+	}
+}
+
+// expandSynapseArtifactJsonProperty unmarshals one of the `*_json` arguments so that it can be embedded within the
+// properties of an Artifact
+func expandSynapseArtifactJsonProperty(input string) (interface{}, error) {
+	var output interface{}
+	if input == "" {
+		return output, nil
+	}
+
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandSynapseVariables(input map[string]interface{}) map[string]*artifacts.VariableSpecification {
+	output := make(map[string]*artifacts.VariableSpecification)
+
+	for k, v := range input {
+		output[k] = &artifacts.VariableSpecification{
+			Type:         artifacts.VariableTypeString,
+			DefaultValue: v.(string),
+		}
+	}
+
+	return output
+}
+
+func flattenSynapseVariables(input map[string]*artifacts.VariableSpecification) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		if v != nil {
+			// we only support string variables at this time
+			if val, ok := v.DefaultValue.(string); ok {
+				output[k] = val
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+func resourceSynapseDataFlow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseDataFlowCreateUpdate,
+		Read:   resourceSynapseDataFlowRead,
+		Update: resourceSynapseDataFlowCreateUpdate,
+		Delete: resourceSynapseDataFlowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataFlowID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ArtifactName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"script": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: []string{"script", "script_lines"},
+			},
+
+			"script_lines": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"script", "script_lines"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"sinks_json": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"sources_json": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"transformations_json": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceSynapseDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.DataFlowClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDataFlowID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetDataFlow(ctx, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_data_flow", id.ID())
+		}
+	}
+
+	typeProperties := make(map[string]interface{})
+	for key, argument := range map[string]string{
+		"sinks":           "sinks_json",
+		"sources":         "sources_json",
+		"transformations": "transformations_json",
+	} {
+		v, err := expandSynapseArtifactJsonProperty(d.Get(argument).(string))
+		if err != nil {
+			return fmt.Errorf("expanding `%s`: %+v", argument, err)
+		}
+		if v != nil {
+			typeProperties[key] = v
+		}
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		typeProperties["script"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("script_lines"); ok {
+		typeProperties["scriptLines"] = v.([]interface{})
+	}
+
+	props := map[string]interface{}{
+		"annotations":    d.Get("annotations").([]interface{}),
+		"description":    d.Get("description").(string),
+		"type":           string(artifacts.TypeBasicDataFlowTypeMappingDataFlow),
+		"typeProperties": typeProperties,
+	}
+
+	if folder := expandSynapseArtifactFolder(d.Get("folder").(string)); folder != nil {
+		props["folder"] = folder
+	}
+
+	dataFlow := artifacts.DataFlowResource{}
+	if err := expandSynapseArtifact(id.Name, props, &dataFlow); err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdateDataFlow(ctx, id.Name, dataFlow, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation/update for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseDataFlowRead(d, meta)
+}
+
+func resourceSynapseDataFlowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.DataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.DataFlowClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDataFlow(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	props, err := flattenSynapseArtifactProperties(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	annotations := make([]interface{}, 0)
+	if err := flattenSynapseArtifactProperty(props, "annotations", &annotations); err != nil {
+		return err
+	}
+	d.Set("annotations", annotations)
+
+	description := ""
+	if err := flattenSynapseArtifactProperty(props, "description", &description); err != nil {
+		return err
+	}
+	d.Set("description", description)
+
+	folder, err := flattenSynapseArtifactFolder(props)
+	if err != nil {
+		return err
+	}
+	d.Set("folder", folder)
+
+	typeProperties := make(map[string]*json.RawMessage)
+	if err := flattenSynapseArtifactProperty(props, "typeProperties", &typeProperties); err != nil {
+		return err
+	}
+
+	script := ""
+	if err := flattenSynapseArtifactProperty(typeProperties, "script", &script); err != nil {
+		return err
+	}
+	d.Set("script", script)
+
+	scriptLines := make([]interface{}, 0)
+	if err := flattenSynapseArtifactProperty(typeProperties, "scriptLines", &scriptLines); err != nil {
+		return err
+	}
+	d.Set("script_lines", scriptLines)
+
+	d.Set("sinks_json", flattenSynapseArtifactJsonProperty(typeProperties, "sinks"))
+	d.Set("sources_json", flattenSynapseArtifactJsonProperty(typeProperties, "sources"))
+	d.Set("transformations_json", flattenSynapseArtifactJsonProperty(typeProperties, "transformations"))
+
+	return nil
+}
+
+func resourceSynapseDataFlowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.DataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.DataFlowClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteDataFlow(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
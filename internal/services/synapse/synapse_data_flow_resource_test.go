@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataFlowResource struct{}
+
+func TestAccSynapseDataFlow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_data_flow", "test")
+	r := DataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseDataFlow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_data_flow", "test")
+	r := DataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseDataFlow_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_data_flow", "test")
+	r := DataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseDataFlow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_data_flow", "test")
+	r := DataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataFlowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataFlowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := clients.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", clients.Account.Environment.Name)
+	}
+
+	client, err := clients.Synapse.DataFlowClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetDataFlow(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r DataFlowResource) basic(data acceptance.TestData) string {
+	// nolint: dupword
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_data_flow" "test" {
+  name                 = "acctestdf%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  sources_json = <<JSON
+[
+  {
+    "name": "source1",
+    "linkedService": {
+      "referenceName": "${azurerm_synapse_linked_service.test.name}",
+      "type": "LinkedServiceReference"
+    }
+  }
+]
+JSON
+
+  sinks_json = <<JSON
+[
+  {
+    "name": "sink1",
+    "linkedService": {
+      "referenceName": "${azurerm_synapse_linked_service.test.name}",
+      "type": "LinkedServiceReference"
+    }
+  }
+]
+JSON
+
+  script = <<EOT
+source(
+  allowSchemaDrift: true, 
+  validateSchema: false, 
+  limit: 100, 
+  ignoreNoFilesFound: false, 
+  documentForm: 'documentPerLine') ~> source1 
+source1 sink(
+  allowSchemaDrift: true, 
+  validateSchema: false, 
+  skipDuplicateMapInputs: true, 
+  skipDuplicateMapOutputs: true) ~> sink1
+EOT
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataFlowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_data_flow" "import" {
+  name                 = azurerm_synapse_data_flow.test.name
+  synapse_workspace_id = azurerm_synapse_data_flow.test.synapse_workspace_id
+  sources_json         = azurerm_synapse_data_flow.test.sources_json
+  sinks_json           = azurerm_synapse_data_flow.test.sinks_json
+  script               = azurerm_synapse_data_flow.test.script
+}
+`, r.basic(data))
+}
+
+func (r DataFlowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_data_flow" "test" {
+  name                 = "acctestdf%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  description          = "description for data flow"
+  annotations          = ["anno1", "anno2"]
+  folder               = "folder1"
+
+  sources_json = <<JSON
+[
+  {
+    "name": "source1",
+    "linkedService": {
+      "referenceName": "${azurerm_synapse_linked_service.test.name}",
+      "type": "LinkedServiceReference"
+    }
+  }
+]
+JSON
+
+  sinks_json = <<JSON
+[
+  {
+    "name": "sink1",
+    "linkedService": {
+      "referenceName": "${azurerm_synapse_linked_service.test.name}",
+      "type": "LinkedServiceReference"
+    }
+  }
+]
+JSON
+
+  transformations_json = <<JSON
+[
+  {
+    "name": "filter1"
+  }
+]
+JSON
+
+  script_lines = [
+    "source(allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  limit: 100,",
+    "  ignoreNoFilesFound: false,",
+    "  documentForm: 'documentPerLine') ~> source1",
+    "source1 filter(true()) ~> filter1",
+    "filter1 sink(allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  skipDuplicateMapInputs: true,",
+    "  skipDuplicateMapOutputs: true) ~> sink1",
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (DataFlowResource) template(data acceptance.TestData) string {
+	return LinkedServiceResource{}.basic(data)
+}
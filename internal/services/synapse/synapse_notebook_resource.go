@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+func resourceSynapseNotebook() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseNotebookCreateUpdate,
+		Read:   resourceSynapseNotebookRead,
+		Update: resourceSynapseNotebookCreateUpdate,
+		Delete: resourceSynapseNotebookDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NotebookID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ArtifactName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"cells_json": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"language": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "python",
+				ValidateFunc: validation.StringInSlice([]string{
+					"csharp",
+					"python",
+					"scala",
+					"sql",
+				}, false),
+			},
+
+			"spark_pool_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.SparkPoolName,
+				RequiredWith: []string{"session"},
+			},
+
+			"session": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"spark_pool_name"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"driver_cores": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"driver_memory": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"executor_cores": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"executor_count": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"executor_memory": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSynapseNotebookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.NotebookClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNotebookID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetNotebook(ctx, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_notebook", id.ID())
+		}
+	}
+
+	cells, err := expandSynapseArtifactJsonProperty(d.Get("cells_json").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `cells_json`: %+v", err)
+	}
+
+	props := map[string]interface{}{
+		"cells":       cells,
+		"description": d.Get("description").(string),
+		"metadata": map[string]interface{}{
+			"language_info": map[string]interface{}{
+				"name": d.Get("language").(string),
+			},
+		},
+		"nbformat":       4,
+		"nbformat_minor": 2,
+	}
+
+	if v, ok := d.GetOk("spark_pool_name"); ok {
+		props["bigDataPool"] = map[string]interface{}{
+			"referenceName": v.(string),
+			"type":          "BigDataPoolReference",
+		}
+	}
+
+	if session := expandSynapseNotebookSession(d.Get("session").([]interface{})); session != nil {
+		props["sessionProperties"] = session
+	}
+
+	if folder := expandSynapseArtifactFolder(d.Get("folder").(string)); folder != nil {
+		props["folder"] = folder
+	}
+
+	notebook := artifacts.NotebookResource{}
+	if err := expandSynapseArtifact(id.Name, props, &notebook); err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdateNotebook(ctx, id.Name, notebook, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation/update for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseNotebookRead(d, meta)
+}
+
+func resourceSynapseNotebookRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.NotebookID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.NotebookClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetNotebook(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	props, err := flattenSynapseArtifactProperties(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	d.Set("cells_json", flattenSynapseArtifactJsonProperty(props, "cells"))
+
+	description := ""
+	if err := flattenSynapseArtifactProperty(props, "description", &description); err != nil {
+		return err
+	}
+	d.Set("description", description)
+
+	folder, err := flattenSynapseArtifactFolder(props)
+	if err != nil {
+		return err
+	}
+	d.Set("folder", folder)
+
+	metadata := struct {
+		LanguageInfo struct {
+			Name string `json:"name"`
+		} `json:"language_info"`
+	}{}
+	if err := flattenSynapseArtifactProperty(props, "metadata", &metadata); err != nil {
+		return err
+	}
+	d.Set("language", metadata.LanguageInfo.Name)
+
+	bigDataPool := struct {
+		ReferenceName string `json:"referenceName"`
+	}{}
+	if err := flattenSynapseArtifactProperty(props, "bigDataPool", &bigDataPool); err != nil {
+		return err
+	}
+	d.Set("spark_pool_name", bigDataPool.ReferenceName)
+
+	var session *artifacts.NotebookSessionProperties
+	if err := flattenSynapseArtifactProperty(props, "sessionProperties", &session); err != nil {
+		return err
+	}
+	if err := d.Set("session", flattenSynapseNotebookSession(session)); err != nil {
+		return fmt.Errorf("setting `session`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceSynapseNotebookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.NotebookID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.NotebookClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteNotebook(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandSynapseNotebookSession(input []interface{}) *artifacts.NotebookSessionProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &artifacts.NotebookSessionProperties{
+		DriverCores:    utils.Int32(int32(v["driver_cores"].(int))),
+		DriverMemory:   utils.String(v["driver_memory"].(string)),
+		ExecutorCores:  utils.Int32(int32(v["executor_cores"].(int))),
+		ExecutorMemory: utils.String(v["executor_memory"].(string)),
+		NumExecutors:   utils.Int32(int32(v["executor_count"].(int))),
+	}
+}
+
+func flattenSynapseNotebookSession(input *artifacts.NotebookSessionProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	driverCores := 0
+	if input.DriverCores != nil {
+		driverCores = int(*input.DriverCores)
+	}
+
+	driverMemory := ""
+	if input.DriverMemory != nil {
+		driverMemory = *input.DriverMemory
+	}
+
+	executorCores := 0
+	if input.ExecutorCores != nil {
+		executorCores = int(*input.ExecutorCores)
+	}
+
+	executorMemory := ""
+	if input.ExecutorMemory != nil {
+		executorMemory = *input.ExecutorMemory
+	}
+
+	executorCount := 0
+	if input.NumExecutors != nil {
+		executorCount = int(*input.NumExecutors)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"driver_cores":    driverCores,
+			"driver_memory":   driverMemory,
+			"executor_cores":  executorCores,
+			"executor_count":  executorCount,
+			"executor_memory": executorMemory,
+		},
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NotebookResource struct{}
+
+func TestAccSynapseNotebook_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_notebook", "test")
+	r := NotebookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseNotebook_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_notebook", "test")
+	r := NotebookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseNotebook_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_notebook", "test")
+	r := NotebookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseNotebook_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_notebook", "test")
+	r := NotebookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t NotebookResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NotebookID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := clients.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", clients.Account.Environment.Name)
+	}
+
+	client, err := clients.Synapse.NotebookClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetNotebook(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r NotebookResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_notebook" "test" {
+  name                 = "acctestnb%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  cells_json           = <<JSON
+[
+  {
+    "cell_type": "code",
+    "metadata": {},
+    "source": [
+      "print('hello')"
+    ],
+    "outputs": [],
+    "execution_count": null
+  }
+]
+JSON
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NotebookResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_notebook" "import" {
+  name                 = azurerm_synapse_notebook.test.name
+  synapse_workspace_id = azurerm_synapse_notebook.test.synapse_workspace_id
+  cells_json           = azurerm_synapse_notebook.test.cells_json
+}
+`, r.basic(data))
+}
+
+func (r NotebookResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+  spark_version        = "3.4"
+}
+
+resource "azurerm_synapse_notebook" "test" {
+  name                 = "acctestnb%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  description          = "test description"
+  folder               = "test-folder"
+  language             = "scala"
+  spark_pool_name      = azurerm_synapse_spark_pool.test.name
+  cells_json           = <<JSON
+[
+  {
+    "cell_type": "markdown",
+    "metadata": {},
+    "source": [
+      "# Test"
+    ]
+  },
+  {
+    "cell_type": "code",
+    "metadata": {},
+    "source": [
+      "println(\"hello\")"
+    ],
+    "outputs": [],
+    "execution_count": null
+  }
+]
+JSON
+
+  session {
+    driver_cores    = 4
+    driver_memory   = "28g"
+    executor_cores  = 4
+    executor_count  = 2
+    executor_memory = "28g"
+  }
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
+func (NotebookResource) template(data acceptance.TestData) string {
+	return LinkedServiceResource{}.template(data)
+}
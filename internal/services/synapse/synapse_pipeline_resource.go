@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+func resourceSynapsePipeline() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapsePipelineCreateUpdate,
+		Read:   resourceSynapsePipelineRead,
+		Update: resourceSynapsePipelineCreateUpdate,
+		Delete: resourceSynapsePipelineDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PipelineID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ArtifactName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"activities_json": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"concurrency": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSynapsePipelineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.PipelineClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewPipelineID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetPipeline(ctx, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_pipeline", id.ID())
+		}
+	}
+
+	activities, err := expandSynapseArtifactJsonProperty(d.Get("activities_json").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `activities_json`: %+v", err)
+	}
+	if activities == nil {
+		activities = make([]interface{}, 0)
+	}
+
+	props := map[string]interface{}{
+		"activities":  activities,
+		"annotations": d.Get("annotations").([]interface{}),
+		"description": d.Get("description").(string),
+		"parameters":  expandSynapseParameters(d.Get("parameters").(map[string]interface{})),
+		"variables":   expandSynapseVariables(d.Get("variables").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("concurrency"); ok {
+		props["concurrency"] = v.(int)
+	}
+
+	if folder := expandSynapseArtifactFolder(d.Get("folder").(string)); folder != nil {
+		props["folder"] = folder
+	}
+
+	pipeline := artifacts.PipelineResource{}
+	if err := expandSynapseArtifact(id.Name, props, &pipeline); err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdatePipeline(ctx, id.Name, pipeline, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation/update for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapsePipelineRead(d, meta)
+}
+
+func resourceSynapsePipelineRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.PipelineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.PipelineClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetPipeline(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	props, err := flattenSynapseArtifactProperties(resp.Pipeline)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	d.Set("activities_json", flattenSynapseArtifactJsonProperty(props, "activities"))
+
+	annotations := make([]interface{}, 0)
+	if err := flattenSynapseArtifactProperty(props, "annotations", &annotations); err != nil {
+		return err
+	}
+	d.Set("annotations", annotations)
+
+	concurrency := 0
+	if err := flattenSynapseArtifactProperty(props, "concurrency", &concurrency); err != nil {
+		return err
+	}
+	d.Set("concurrency", concurrency)
+
+	description := ""
+	if err := flattenSynapseArtifactProperty(props, "description", &description); err != nil {
+		return err
+	}
+	d.Set("description", description)
+
+	folder, err := flattenSynapseArtifactFolder(props)
+	if err != nil {
+		return err
+	}
+	d.Set("folder", folder)
+
+	parameters := make(map[string]*artifacts.ParameterSpecification)
+	if err := flattenSynapseArtifactProperty(props, "parameters", &parameters); err != nil {
+		return err
+	}
+	if err := d.Set("parameters", flattenSynapseParameters(parameters)); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+
+	variables := make(map[string]*artifacts.VariableSpecification)
+	if err := flattenSynapseArtifactProperty(props, "variables", &variables); err != nil {
+		return err
+	}
+	if err := d.Set("variables", flattenSynapseVariables(variables)); err != nil {
+		return fmt.Errorf("setting `variables`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceSynapsePipelineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.PipelineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.PipelineClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeletePipeline(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
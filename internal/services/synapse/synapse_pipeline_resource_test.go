@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PipelineResource struct{}
+
+func TestAccSynapsePipeline_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapsePipeline_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapsePipeline_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapsePipeline_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t PipelineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PipelineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := clients.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", clients.Account.Environment.Name)
+	}
+
+	client, err := clients.Synapse.PipelineClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetPipeline(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r PipelineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_pipeline" "test" {
+  name                 = "acctestpipeline%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  activities_json      = <<JSON
+[
+  {
+    "name": "Wait1",
+    "type": "Wait",
+    "typeProperties": {
+      "waitTimeInSeconds": 5
+    }
+  }
+]
+JSON
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PipelineResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_pipeline" "import" {
+  name                 = azurerm_synapse_pipeline.test.name
+  synapse_workspace_id = azurerm_synapse_pipeline.test.synapse_workspace_id
+  activities_json      = azurerm_synapse_pipeline.test.activities_json
+}
+`, r.basic(data))
+}
+
+func (r PipelineResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_pipeline" "test" {
+  name                 = "acctestpipeline%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  description          = "test description"
+  concurrency          = 30
+  folder               = "test-folder"
+  activities_json      = <<JSON
+[
+  {
+    "name": "Append variable1",
+    "type": "AppendVariable",
+    "dependsOn": [],
+    "userProperties": [],
+    "typeProperties": {
+      "variableName": "bob",
+      "value": "something"
+    }
+  }
+]
+JSON
+
+  annotations = ["test1", "test2"]
+
+  parameters = {
+    test = "testparameter"
+  }
+
+  variables = {
+    bob = "item1"
+  }
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (PipelineResource) template(data acceptance.TestData) string {
+	return LinkedServiceResource{}.template(data)
+}
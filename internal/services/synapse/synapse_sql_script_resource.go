@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+// the name of the connection used by SQL Scripts which run against the serverless SQL Pool
+const synapseSqlScriptBuiltInPoolName = "Built-in"
+
+func resourceSynapseSqlScript() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseSqlScriptCreateUpdate,
+		Read:   resourceSynapseSqlScriptRead,
+		Update: resourceSynapseSqlScriptCreateUpdate,
+		Delete: resourceSynapseSqlScriptDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SqlScriptID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ArtifactName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"result_limit": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  5000,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(1, 5000),
+				),
+			},
+
+			// when omitted the SQL Script runs against the serverless (Built-in) SQL Pool
+			"sql_pool_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.SqlPoolName,
+			},
+		},
+	}
+}
+
+func resourceSynapseSqlScriptCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.SQLScriptClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewSqlScriptID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetSQLScript(ctx, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_sql_script", id.ID())
+		}
+	}
+
+	connection := map[string]interface{}{
+		"name": synapseSqlScriptBuiltInPoolName,
+		"type": string(artifacts.SQLConnectionTypeSQLOnDemand),
+	}
+	if v, ok := d.GetOk("sql_pool_name"); ok {
+		connection["name"] = v.(string)
+		connection["poolName"] = v.(string)
+		connection["type"] = string(artifacts.SQLConnectionTypeSQLPool)
+	}
+	if v, ok := d.GetOk("database_name"); ok {
+		connection["databaseName"] = v.(string)
+	}
+
+	props := map[string]interface{}{
+		"content": map[string]interface{}{
+			"currentConnection": connection,
+			"metadata": map[string]interface{}{
+				"language": "sql",
+			},
+			"query":       d.Get("query").(string),
+			"resultLimit": d.Get("result_limit").(int),
+		},
+		"description": d.Get("description").(string),
+		"type":        string(artifacts.SQLScriptTypeSQLQuery),
+	}
+
+	if folder := expandSynapseArtifactFolder(d.Get("folder").(string)); folder != nil {
+		props["folder"] = folder
+	}
+
+	sqlScript := artifacts.SQLScriptResource{}
+	if err := expandSynapseArtifact(id.Name, props, &sqlScript); err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdateSQLScript(ctx, id.Name, sqlScript, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation/update for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseSqlScriptRead(d, meta)
+}
+
+func resourceSynapseSqlScriptRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.SqlScriptID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.SQLScriptClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSQLScript(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	props, err := flattenSynapseArtifactProperties(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	description := ""
+	if err := flattenSynapseArtifactProperty(props, "description", &description); err != nil {
+		return err
+	}
+	d.Set("description", description)
+
+	folder, err := flattenSynapseArtifactFolder(props)
+	if err != nil {
+		return err
+	}
+	d.Set("folder", folder)
+
+	content := struct {
+		CurrentConnection struct {
+			DatabaseName string `json:"databaseName"`
+			Name         string `json:"name"`
+			PoolName     string `json:"poolName"`
+			Type         string `json:"type"`
+		} `json:"currentConnection"`
+		Query       string `json:"query"`
+		ResultLimit int    `json:"resultLimit"`
+	}{}
+	if err := flattenSynapseArtifactProperty(props, "content", &content); err != nil {
+		return err
+	}
+
+	d.Set("database_name", content.CurrentConnection.DatabaseName)
+	d.Set("query", content.Query)
+	d.Set("result_limit", content.ResultLimit)
+
+	sqlPoolName := ""
+	if strings.EqualFold(content.CurrentConnection.Type, string(artifacts.SQLConnectionTypeSQLPool)) {
+		sqlPoolName = content.CurrentConnection.PoolName
+		if sqlPoolName == "" {
+			sqlPoolName = content.CurrentConnection.Name
+		}
+	}
+	d.Set("sql_pool_name", sqlPoolName)
+
+	return nil
+}
+
+func resourceSynapseSqlScriptDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.SqlScriptID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.SQLScriptClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteSQLScript(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SqlScriptResource struct{}
+
+func TestAccSynapseSqlScript_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_sql_script", "test")
+	r := SqlScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseSqlScript_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_sql_script", "test")
+	r := SqlScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseSqlScript_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_sql_script", "test")
+	r := SqlScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseSqlScript_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_sql_script", "test")
+	r := SqlScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t SqlScriptResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SqlScriptID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := clients.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", clients.Account.Environment.Name)
+	}
+
+	client, err := clients.Synapse.SQLScriptClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSQLScript(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SqlScriptResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_script" "test" {
+  name                 = "acctestss%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  query                = "SELECT 1"
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SqlScriptResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_script" "import" {
+  name                 = azurerm_synapse_sql_script.test.name
+  synapse_workspace_id = azurerm_synapse_sql_script.test.synapse_workspace_id
+  query                = azurerm_synapse_sql_script.test.query
+}
+`, r.basic(data))
+}
+
+func (r SqlScriptResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_script" "test" {
+  name                 = "acctestss%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  database_name        = "master"
+  description          = "test description"
+  folder               = "test-folder"
+  result_limit         = 100
+  query                = <<QUERY
+SELECT TOP 10 *
+FROM sys.objects
+QUERY
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SqlScriptResource) template(data acceptance.TestData) string {
+	return LinkedServiceResource{}.template(data)
+}
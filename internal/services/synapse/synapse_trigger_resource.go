@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
+)
+
+func resourceSynapseTrigger() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseTriggerCreate,
+		Read:   resourceSynapseTriggerRead,
+		Update: resourceSynapseTriggerUpdate,
+		Delete: resourceSynapseTriggerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TriggerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ArtifactName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(artifacts.TypeBasicTriggerTypeBlobEventsTrigger),
+					string(artifacts.TypeBasicTriggerTypeCustomEventsTrigger),
+					string(artifacts.TypeBasicTriggerTypeScheduleTrigger),
+				}, false),
+			},
+
+			"type_properties_json": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+			},
+
+			"activated": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"pipeline": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ArtifactName,
+						},
+
+						"parameters": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSynapseTriggerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.TriggerClient(workspaceId.Name, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewTriggerID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	existing, err := client.GetTrigger(ctx, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_synapse_trigger", id.ID())
+	}
+
+	if err := createOrUpdateSynapseTrigger(ctx, client, id, d); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("activated").(bool) {
+		if err := startSynapseTrigger(ctx, client, id, d.Get("type").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSynapseTriggerRead(d, meta)
+}
+
+func resourceSynapseTriggerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.TriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.TriggerClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetTrigger(ctx, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	started, err := synapseTriggerIsStarted(existing)
+	if err != nil {
+		return fmt.Errorf("retrieving the runtime state of %s: %+v", id, err)
+	}
+
+	// started triggers cannot be updated - so we stop the trigger and start it again (if required) once it's updated
+	if started {
+		if err := stopSynapseTrigger(ctx, client, *id); err != nil {
+			return err
+		}
+	}
+
+	if err := createOrUpdateSynapseTrigger(ctx, client, *id, d); err != nil {
+		return err
+	}
+
+	if d.Get("activated").(bool) {
+		if err := startSynapseTrigger(ctx, client, *id, d.Get("type").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSynapseTriggerRead(d, meta)
+}
+
+func resourceSynapseTriggerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.TriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.TriggerClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetTrigger(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	props, err := flattenSynapseArtifactProperties(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	t := ""
+	if err := flattenSynapseArtifactProperty(props, "type", &t); err != nil {
+		return err
+	}
+	d.Set("type", t)
+
+	d.Set("type_properties_json", flattenSynapseArtifactJsonProperty(props, "typeProperties"))
+
+	runtimeState := ""
+	if err := flattenSynapseArtifactProperty(props, "runtimeState", &runtimeState); err != nil {
+		return err
+	}
+	d.Set("activated", runtimeState == string(artifacts.TriggerRuntimeStateStarted))
+
+	annotations := make([]interface{}, 0)
+	if err := flattenSynapseArtifactProperty(props, "annotations", &annotations); err != nil {
+		return err
+	}
+	d.Set("annotations", annotations)
+
+	description := ""
+	if err := flattenSynapseArtifactProperty(props, "description", &description); err != nil {
+		return err
+	}
+	d.Set("description", description)
+
+	pipelines := make([]synapseTriggerPipelineReference, 0)
+	if err := flattenSynapseArtifactProperty(props, "pipelines", &pipelines); err != nil {
+		return err
+	}
+	if err := d.Set("pipeline", flattenSynapseTriggerPipelines(pipelines)); err != nil {
+		return fmt.Errorf("setting `pipeline`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceSynapseTriggerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.TriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.TriggerClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetTrigger(ctx, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	started, err := synapseTriggerIsStarted(existing)
+	if err != nil {
+		return fmt.Errorf("retrieving the runtime state of %s: %+v", id, err)
+	}
+
+	// started triggers cannot be deleted
+	if started {
+		if err := stopSynapseTrigger(ctx, client, *id); err != nil {
+			return err
+		}
+	}
+
+	if synapseTriggerIsEventBased(d.Get("type").(string)) {
+		log.Printf("[DEBUG] Unsubscribing %s from events..", id)
+		future, err := client.UnsubscribeTriggerFromEvents(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("unsubscribing %s from events: %+v", id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be unsubscribed from events: %+v", id, err)
+		}
+	}
+
+	future, err := client.DeleteTrigger(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
+
+type synapseTriggerPipelineReference struct {
+	PipelineReference struct {
+		ReferenceName string `json:"referenceName"`
+	} `json:"pipelineReference"`
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+func createOrUpdateSynapseTrigger(ctx context.Context, client *artifacts.TriggerClient, id parse.TriggerId, d *pluginsdk.ResourceData) error {
+	typeProperties, err := expandSynapseArtifactJsonProperty(d.Get("type_properties_json").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `type_properties_json`: %+v", err)
+	}
+
+	props := map[string]interface{}{
+		"annotations":    d.Get("annotations").([]interface{}),
+		"description":    d.Get("description").(string),
+		"pipelines":      expandSynapseTriggerPipelines(d.Get("pipeline").([]interface{})),
+		"type":           d.Get("type").(string),
+		"typeProperties": typeProperties,
+	}
+
+	trigger := artifacts.TriggerResource{}
+	if err := expandSynapseArtifact(id.Name, props, &trigger); err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdateTrigger(ctx, id.Name, trigger, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation/update for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func startSynapseTrigger(ctx context.Context, client *artifacts.TriggerClient, id parse.TriggerId, triggerType string) error {
+	// event based triggers must be subscribed to the events before they can be started
+	if synapseTriggerIsEventBased(triggerType) {
+		log.Printf("[DEBUG] Subscribing %s to events..", id)
+		future, err := client.SubscribeTriggerToEvents(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("subscribing %s to events: %+v", id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be subscribed to events: %+v", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Starting %s..", id)
+	future, err := client.StartTrigger(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to start: %+v", id, err)
+	}
+
+	return nil
+}
+
+func stopSynapseTrigger(ctx context.Context, client *artifacts.TriggerClient, id parse.TriggerId) error {
+	log.Printf("[DEBUG] Stopping %s..", id)
+	future, err := client.StopTrigger(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to stop: %+v", id, err)
+	}
+
+	return nil
+}
+
+func synapseTriggerIsStarted(input artifacts.TriggerResource) (bool, error) {
+	props, err := flattenSynapseArtifactProperties(input.Properties)
+	if err != nil {
+		return false, err
+	}
+
+	runtimeState := ""
+	if err := flattenSynapseArtifactProperty(props, "runtimeState", &runtimeState); err != nil {
+		return false, err
+	}
+
+	return runtimeState == string(artifacts.TriggerRuntimeStateStarted), nil
+}
+
+func synapseTriggerIsEventBased(triggerType string) bool {
+	return triggerType == string(artifacts.TypeBasicTriggerTypeBlobEventsTrigger) || triggerType == string(artifacts.TypeBasicTriggerTypeCustomEventsTrigger)
+}
+
+func expandSynapseTriggerPipelines(input []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		pipeline := v.(map[string]interface{})
+		output = append(output, map[string]interface{}{
+			"pipelineReference": map[string]interface{}{
+				"referenceName": pipeline["name"].(string),
+				"type":          "PipelineReference",
+			},
+			"parameters": pipeline["parameters"].(map[string]interface{}),
+		})
+	}
+
+	return output
+}
+
+func flattenSynapseTriggerPipelines(input []synapseTriggerPipelineReference) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, v := range input {
+		parameters := make(map[string]interface{})
+		for k, p := range v.Parameters {
+			// we only support string parameters at this time
+			val, ok := p.(string)
+			if !ok {
+				log.Printf("[DEBUG] Skipping parameter %q since it's not a string", k)
+				continue
+			}
+			parameters[k] = val
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":       v.PipelineReference.ReferenceName,
+			"parameters": parameters,
+		})
+	}
+
+	return output
+}
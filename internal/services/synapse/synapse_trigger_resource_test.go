@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TriggerResource struct{}
+
+func TestAccSynapseTrigger_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_trigger", "test")
+	r := TriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseTrigger_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_trigger", "test")
+	r := TriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseTrigger_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_trigger", "test")
+	r := TriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseTrigger_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_trigger", "test")
+	r := TriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t TriggerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.TriggerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := clients.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", clients.Account.Environment.Name)
+	}
+
+	client, err := clients.Synapse.TriggerClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetTrigger(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r TriggerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_trigger" "test" {
+  name                 = "acctesttrigger%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "ScheduleTrigger"
+  activated            = false
+  type_properties_json = <<JSON
+{
+  "recurrence": {
+    "frequency": "Hour",
+    "interval": 1,
+    "startTime": "2022-09-21T00:00:00Z",
+    "timeZone": "UTC"
+  }
+}
+JSON
+
+  pipeline {
+    name = azurerm_synapse_pipeline.test.name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r TriggerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_trigger" "import" {
+  name                 = azurerm_synapse_trigger.test.name
+  synapse_workspace_id = azurerm_synapse_trigger.test.synapse_workspace_id
+  type                 = azurerm_synapse_trigger.test.type
+  activated            = azurerm_synapse_trigger.test.activated
+  type_properties_json = azurerm_synapse_trigger.test.type_properties_json
+
+  pipeline {
+    name = azurerm_synapse_pipeline.test.name
+  }
+}
+`, r.basic(data))
+}
+
+func (r TriggerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_trigger" "test" {
+  name                 = "acctesttrigger%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "ScheduleTrigger"
+  description          = "test description"
+  annotations          = ["test1", "test2"]
+  type_properties_json = <<JSON
+{
+  "recurrence": {
+    "frequency": "Day",
+    "interval": 1,
+    "startTime": "2022-09-21T00:00:00Z",
+    "timeZone": "UTC",
+    "schedule": {
+      "hours": [4],
+      "minutes": [30]
+    }
+  }
+}
+JSON
+
+  pipeline {
+    name = azurerm_synapse_pipeline.test.name
+    parameters = {
+      test = "testparameter"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (TriggerResource) template(data acceptance.TestData) string {
+	return PipelineResource{}.complete(data)
+}
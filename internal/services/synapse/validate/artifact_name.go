@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ArtifactName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The name attribute rules are :
+	// 1. Must start with a letter, number or underscore.
+	// 2. Can't contain '.,+,?,/,<,>,*,%,&,:,\,#'.
+	// 3. The value must be between 1 and 140 characters long
+
+	if !regexp.MustCompile(`^[\p{L}0-9_][^.+?/<>*%&:\\#]{0,139}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must start with a letter, number or underscore, can't contain '.,+,?,/,<,>,*,%%,&,:,\\,#', and must be between 1 and 140 characters long", k))
+		return
+	}
+
+	return warnings, errors
+}
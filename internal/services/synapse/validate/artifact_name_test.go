@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestArtifactName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "abc123",
+			expected: true,
+		},
+		{
+			// can start with an underscore
+			input:    "_aBc_123",
+			expected: true,
+		},
+		{
+			// can contain hyphens and spaces
+			input:    "ab-c d",
+			expected: true,
+		},
+		{
+			// can't start with a hyphen
+			input:    "-abc",
+			expected: false,
+		},
+		{
+			// can't contain `.`
+			input:    "abc.def",
+			expected: false,
+		},
+		{
+			// can't contain `/`
+			input:    "abc/def",
+			expected: false,
+		},
+		{
+			// 140 chars
+			input:    strings.Repeat("a", 140),
+			expected: true,
+		},
+		{
+			// 141 chars
+			input:    strings.Repeat("a", 141),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ArtifactName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"strings"
+)
+
+// DefaultTags is the set of Tags configured within the `default_tags` block in the Provider
+// which are applied to every resource supporting Tags
+type DefaultTags struct {
+	// Tags is the set of Tags which should be applied to every resource supporting Tags
+	Tags map[string]string
+
+	// IgnoreKeys is a list of Tag Keys which should be ignored when reading the Tags for a resource
+	// (for example, where these are managed by an Azure Policy or outside of Terraform)
+	IgnoreKeys []string
+}
+
+// ExpandDefaultTags expands the `default_tags` block from the Provider configuration
+func ExpandDefaultTags(input []interface{}) DefaultTags {
+	output := DefaultTags{
+		Tags:       map[string]string{},
+		IgnoreKeys: []string{},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["tags"].(map[string]interface{}); ok {
+		for key, value := range v {
+			val, _ := TagValueToString(value)
+			output.Tags[key] = val
+		}
+	}
+
+	if v, ok := raw["ignore_keys"].([]interface{}); ok {
+		for _, key := range v {
+			if key == nil {
+				continue
+			}
+			output.IgnoreKeys = append(output.IgnoreKeys, key.(string))
+		}
+	}
+
+	return output
+}
+
+// HasTags returns whether any Default Tags or Tag Keys to ignore have been configured
+func (d DefaultTags) HasTags() bool {
+	return len(d.Tags) > 0 || len(d.IgnoreKeys) > 0
+}
+
+// Merge returns the Default Tags merged with the Tags defined on the resource, where the
+// Tags defined on the resource take precedence over the Default Tags
+func (d DefaultTags) Merge(resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(d.Tags)+len(resourceTags))
+
+	for key, value := range d.Tags {
+		output[key] = value
+	}
+
+	for key, value := range resourceTags {
+		val, _ := TagValueToString(value)
+		output[key] = val
+	}
+
+	return output
+}
+
+// RemoveIgnored returns the Tags with any Tag Keys which should be ignored (compared case-insensitively) removed
+func (d DefaultTags) RemoveIgnored(input map[string]interface{}) map[string]interface{} {
+	ignored := make(map[string]struct{}, len(d.IgnoreKeys))
+	for _, key := range d.IgnoreKeys {
+		ignored[strings.ToLower(key)] = struct{}{}
+	}
+
+	output := make(map[string]interface{}, len(input))
+	for key, value := range input {
+		if _, ok := ignored[strings.ToLower(key)]; ok {
+			continue
+		}
+		output[key] = value
+	}
+
+	return output
+}
+
+// IgnoredTags returns only the Tags whose Tag Keys should be ignored (compared case-insensitively), so
+// that these can be retained when the Tags for a resource are updated
+func (d DefaultTags) IgnoredTags(input map[string]interface{}) map[string]interface{} {
+	ignored := make(map[string]struct{}, len(d.IgnoreKeys))
+	for _, key := range d.IgnoreKeys {
+		ignored[strings.ToLower(key)] = struct{}{}
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range input {
+		if _, ok := ignored[strings.ToLower(key)]; ok {
+			output[key] = value
+		}
+	}
+
+	return output
+}
+
+// ResourceTags returns the Tags which should be set into the `tags` field of the resource, from the
+// Tags returned from Azure - that is the Tags without any ignored keys, and without any Default Tags
+// which have not been explicitly configured on the resource itself
+func (d DefaultTags) ResourceTags(remoteTags map[string]interface{}, configuredTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(remoteTags))
+
+	for key, value := range d.RemoveIgnored(remoteTags) {
+		if _, configured := configuredTags[key]; !configured {
+			if defaultValue, isDefault := d.Tags[key]; isDefault {
+				if val, _ := TagValueToString(value); val == defaultValue {
+					continue
+				}
+			}
+		}
+
+		output[key] = value
+	}
+
+	return output
+}
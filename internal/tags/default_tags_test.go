@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected DefaultTags
+	}{
+		{
+			Name:  "Empty",
+			Input: []interface{}{},
+			Expected: DefaultTags{
+				Tags:       map[string]string{},
+				IgnoreKeys: []string{},
+			},
+		},
+		{
+			Name: "Tags and Ignore Keys",
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"environment": "production",
+					},
+					"ignore_keys": []interface{}{
+						"CreatedBy",
+					},
+				},
+			},
+			Expected: DefaultTags{
+				Tags: map[string]string{
+					"environment": "production",
+				},
+				IgnoreKeys: []string{
+					"CreatedBy",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := ExpandDefaultTags(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDefaultTagsMerge(t *testing.T) {
+	testData := []struct {
+		Name         string
+		DefaultTags  DefaultTags
+		ResourceTags map[string]interface{}
+		Expected     map[string]interface{}
+	}{
+		{
+			Name:         "No Default Tags",
+			DefaultTags:  DefaultTags{},
+			ResourceTags: map[string]interface{}{"hello": "world"},
+			Expected:     map[string]interface{}{"hello": "world"},
+		},
+		{
+			Name: "No Resource Tags",
+			DefaultTags: DefaultTags{
+				Tags: map[string]string{"environment": "production"},
+			},
+			ResourceTags: map[string]interface{}{},
+			Expected:     map[string]interface{}{"environment": "production"},
+		},
+		{
+			Name: "Resource Tags take precedence",
+			DefaultTags: DefaultTags{
+				Tags: map[string]string{
+					"environment": "production",
+					"team":        "platform",
+				},
+			},
+			ResourceTags: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "world",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "world",
+				"team":        "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := v.DefaultTags.Merge(v.ResourceTags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDefaultTagsIgnoredTags(t *testing.T) {
+	defaultTags := DefaultTags{
+		IgnoreKeys: []string{"createdby"},
+	}
+
+	input := map[string]interface{}{
+		"CreatedBy": "someone",
+		"hello":     "world",
+	}
+	expected := map[string]interface{}{
+		"CreatedBy": "someone",
+	}
+
+	if actual := defaultTags.IgnoredTags(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := (DefaultTags{}).IgnoredTags(input); len(actual) != 0 {
+		t.Fatalf("Expected no Tags but got %+v", actual)
+	}
+}
+
+func TestDefaultTagsResourceTags(t *testing.T) {
+	defaultTags := DefaultTags{
+		Tags: map[string]string{
+			"environment": "production",
+			"team":        "platform",
+		},
+		IgnoreKeys: []string{"createdby"},
+	}
+
+	testData := []struct {
+		Name       string
+		Remote     map[string]interface{}
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name: "Default Tags are removed",
+			Remote: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+				"hello":       "world",
+			},
+			Configured: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		{
+			Name: "Configured Default Tags are retained",
+			Remote: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+			Configured: map[string]interface{}{
+				"environment": "production",
+			},
+			Expected: map[string]interface{}{
+				"environment": "production",
+			},
+		},
+		{
+			Name: "Default Tags with a different value are retained",
+			Remote: map[string]interface{}{
+				"environment": "staging",
+			},
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+		},
+		{
+			Name: "Ignored Keys are removed",
+			Remote: map[string]interface{}{
+				"CreatedBy": "someone",
+				"hello":     "world",
+			},
+			Configured: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := defaultTags.ResourceTags(v.Remote, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
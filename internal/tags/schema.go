@@ -65,3 +65,15 @@ func SchemaEnforceLowerCaseKeys() *pluginsdk.Schema {
 		},
 	}
 }
+
+// SchemaTagsAll returns the Schema used for the computed `tags_all` field, which contains the Tags
+// defined on the resource merged with any Default Tags defined in the Provider block
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}